@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"FinTechPorto/internal/database"
+	"FinTechPorto/internal/outbox"
+)
+
+const usage = `usage: deadletter <command> [arguments]
+
+Inspects outbox events the broker kept rejecting.
+
+commands:
+  list [-limit n]   list dead-lettered events, oldest first
+  show <id>         print an event with its headers and payload
+  replay <id>...    hand events back to the outbox relay
+  drop <id>...      give up on events; they are kept but never published
+`
+
+func main() {
+	// Setup simple logging
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	// Initialize DB (uses env vars or fallback DSN)
+	if err := database.Connect(); err != nil {
+		slog.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	cmd, args := os.Args[1], os.Args[2:]
+	var err error
+	switch cmd {
+	case "list":
+		err = list(ctx, args)
+	case "show":
+		err = show(ctx, args)
+	case "replay":
+		err = each(args, func(id uint64) error { return outbox.ReplayDeadLetter(ctx, database.DB, id) }, "replayed")
+	case "drop":
+		err = each(args, func(id uint64) error { return outbox.DropDeadLetter(ctx, database.DB, id) }, "dropped")
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		slog.Error(cmd+" failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// list prints a table of dead-lettered events.
+func list(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	limit := fs.Int("limit", 100, "maximum number of events to list")
+	_ = fs.Parse(args)
+
+	evs, err := outbox.ListDeadLetters(ctx, database.DB, *limit)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tEVENT TYPE\tAGGREGATE\tATTEMPTS\tDEAD-LETTERED\tLAST ERROR")
+	for _, ev := range evs {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%s\t%s\n", ev.ID, ev.EventType, ev.AggregateID, ev.Attempts, ev.DeadLetteredAt.Format(time.RFC3339), ev.LastError)
+	}
+	return tw.Flush()
+}
+
+// show prints one dead-lettered event.
+func show(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("show takes one event ID")
+	}
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid event ID %q", args[0])
+	}
+	ev, err := outbox.GetDeadLetter(ctx, database.DB, id)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("id: %d\nevent_type: %s\naggregate_id: %s\nattempts: %d\ncreated_at: %s\ndead_lettered_at: %s\nlast_error: %s\n",
+		ev.ID, ev.EventType, ev.AggregateID, ev.Attempts, ev.CreatedAt.Format(time.RFC3339), ev.DeadLetteredAt.Format(time.RFC3339), ev.LastError)
+	names := slices.Sorted(maps.Keys(ev.Headers))
+	for _, name := range names {
+		fmt.Printf("header %s: %s\n", name, ev.Headers[name])
+	}
+	fmt.Printf("payload: %s\n", ev.Payload)
+	return nil
+}
+
+// each applies fn to every event ID in args, reporting each as done.
+func each(args []string, fn func(uint64) error, done string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no event IDs given")
+	}
+	for _, arg := range args {
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid event ID %q", arg)
+		}
+		if err := fn(id); err != nil {
+			return fmt.Errorf("event %d: %w", id, err)
+		}
+		fmt.Printf("%s event %d\n", done, id)
+	}
+	return nil
+}
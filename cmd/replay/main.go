@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"FinTechPorto/internal/broker"
+	"FinTechPorto/internal/database"
+	"FinTechPorto/internal/events"
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/projection"
+
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+)
+
+// schemaName restricts -schema to plain lower-case identifiers.
+var schemaName = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// replayConsumer is the consumer name replayed events are recorded under in the scratch
+// schema.
+const replayConsumer = "replay"
+
+func main() {
+	file := flag.String("file", "", "read events from this JSON-lines export instead of KAFKA_TOPIC")
+	offset := flag.Int64("offset", kafka.FirstOffset, "offset to start from in every partition; -2 is the first retained message")
+	since := flag.String("since", "", "start at events from this RFC 3339 time on")
+	schema := flag.String("schema", "replay", "scratch schema the projection is rebuilt in; it is dropped and recreated")
+	export := flag.String("export", "", "also write every event read to this JSON-lines file")
+	all := flag.Bool("all", false, "list every account, not only those that differ")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), `usage: replay [flags]
+
+Rebuilds the account activity projection from the event topic, or an export of it,
+in a scratch schema and compares the net movement of every account with its live
+balance and its live projection. Balances are only moved by events from transfers,
+refunds and reversals, so opening balances, overdraft interest and any history
+before the starting point show up as a difference in LIVE-REPLAYED.
+
+flags:
+`)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	// Setup simple logging
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+
+	if !schemaName.MatchString(*schema) || *schema == "public" {
+		slog.Error("invalid scratch schema", "schema", *schema)
+		os.Exit(2)
+	}
+	var from time.Time
+	if *since != "" {
+		t, err := time.Parse(time.RFC3339, *since)
+		if err != nil {
+			slog.Error("invalid -since", "value", *since, "error", err)
+			os.Exit(2)
+		}
+		from = t
+	}
+
+	// Initialize DB (uses env vars or fallback DSN)
+	if err := database.Connect(); err != nil {
+		slog.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var out *bufio.Writer
+	if *export != "" {
+		f, err := os.Create(*export)
+		if err != nil {
+			slog.Error("failed to create export", "error", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		out = bufio.NewWriter(f)
+		defer out.Flush()
+	}
+
+	var applied, skipped int
+	err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := scratch(tx, *schema); err != nil {
+			return err
+		}
+		apply := func(ctx context.Context, value []byte) error {
+			env, err := events.Parse(value)
+			if err != nil {
+				slog.Warn("skipping message that is not an event", "error", err)
+				skipped++
+				return nil
+			}
+			if !from.IsZero() && env.GetOccurredAt().AsTime().Before(from) {
+				return nil
+			}
+			if out != nil {
+				if _, err := fmt.Fprintf(out, "%s\n", value); err != nil {
+					return fmt.Errorf("failed to write export: %w", err)
+				}
+			}
+			applied++
+			return projection.Apply(tx, replayConsumer, env)
+		}
+		if *file != "" {
+			return readFile(ctx, *file, apply)
+		}
+		return readTopic(ctx, broker.Position{Offset: *offset, Time: from}, apply)
+	})
+	if err != nil {
+		slog.Error("replay failed", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("replay completed", "events", applied, "skipped", skipped, "schema", *schema)
+
+	if err := diff(ctx, database.DB, *schema, *all); err != nil {
+		slog.Error("diff failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// scratch recreates schema with empty projection tables and points tx at it.
+func scratch(tx *gorm.DB, schema string) error {
+	for _, stmt := range []string{
+		"DROP SCHEMA IF EXISTS " + schema + " CASCADE",
+		"CREATE SCHEMA " + schema,
+		"SET LOCAL search_path TO " + schema,
+	} {
+		if err := tx.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to prepare scratch schema: %w", err)
+		}
+	}
+	if err := tx.AutoMigrate(&models.ProcessedEvent{}, &models.AccountActivity{}, &models.AccountActivitySummary{}); err != nil {
+		return fmt.Errorf("failed to create scratch tables: %w", err)
+	}
+	return nil
+}
+
+// readTopic passes the events of KAFKA_TOPIC from from on to apply.
+func readTopic(ctx context.Context, from broker.Position, apply func(context.Context, []byte) error) error {
+	brokersEnv := os.Getenv("KAFKA_BROKERS")
+	topic := os.Getenv("KAFKA_TOPIC")
+	if brokersEnv == "" || topic == "" {
+		return fmt.Errorf("KAFKA_BROKERS and KAFKA_TOPIC are required without -file")
+	}
+	return broker.ReadTopic(ctx, strings.Split(brokersEnv, ","), topic, from, func(ctx context.Context, msg broker.Message) error {
+		return apply(ctx, msg.Value)
+	})
+}
+
+// readFile passes every line of the export at path on to apply.
+func readFile(ctx context.Context, path string, apply func(context.Context, []byte) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open export: %w", err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		line := sc.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		if err := apply(ctx, line); err != nil {
+			return err
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return fmt.Errorf("failed to read export: %w", err)
+	}
+	return nil
+}
+
+// summary is the net movement of an account in a projection.
+type summary struct {
+	AccountID string
+	Currency  string
+	Net       int64
+}
+
+// diff prints, per customer account, the live balance, the net movement replayed into
+// schema and the net movement of the live projection. System accounts are left out as
+// events do not report their side of a transfer.
+func diff(ctx context.Context, db *gorm.DB, schema string, all bool) error {
+	var accounts []models.Account
+	if err := db.WithContext(ctx).Where("user_id NOT LIKE ?", "system:%").Order("id").Find(&accounts).Error; err != nil {
+		return fmt.Errorf("failed to load accounts: %w", err)
+	}
+	replayed, err := nets(ctx, db.Table(schema+".account_activity_summaries"))
+	if err != nil {
+		return err
+	}
+	live, err := nets(ctx, db.Model(&models.AccountActivitySummary{}))
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "ACCOUNT\tCURRENCY\tLIVE\tREPLAYED\tLIVE-REPLAYED\tPROJECTION\tPROJECTION-REPLAYED\t")
+	var differ int
+	for _, acc := range accounts {
+		r, p := replayed[acc.ID], live[acc.ID]
+		if acc.Balance == r.Net && p.Net == r.Net && !all {
+			continue
+		}
+		if acc.Balance != r.Net || p.Net != r.Net {
+			differ++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t\n", acc.ID, acc.Currency, acc.Balance, r.Net, acc.Balance-r.Net, p.Net, p.Net-r.Net)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("%d of %d accounts differ\n", differ, len(accounts))
+	return nil
+}
+
+// nets loads the net movement per account from the summaries table q is scoped to.
+func nets(ctx context.Context, q *gorm.DB) (map[string]summary, error) {
+	var rows []summary
+	if err := q.WithContext(ctx).Select("account_id, currency, total_credits - total_debits AS net").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load activity summaries: %w", err)
+	}
+	out := make(map[string]summary, len(rows))
+	for _, row := range rows {
+		out[row.AccountID] = row
+	}
+	return out, nil
+}
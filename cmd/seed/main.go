@@ -6,9 +6,12 @@ import (
 	"os"
 
 	"FinTechPorto/internal/database"
+	"FinTechPorto/internal/ledger"
 	"FinTechPorto/internal/models"
 
 	"log/slog"
+
+	"gorm.io/gorm"
 )
 
 func main() {
@@ -30,11 +33,26 @@ func main() {
 
 	for i := range accounts {
 		acc := &accounts[i]
-		res := database.DB.WithContext(ctx).Create(acc)
-		if res.Error != nil {
-			slog.Error("failed to create account", "error", res.Error)
+		// Accounts start empty; the opening balance is posted to the journal so it
+		// can be derived from entries like every other movement.
+		opening := acc.Balance
+		acc.Balance = 0
+		err := database.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(acc).Error; err != nil {
+				return err
+			}
+			equity, err := ledger.SystemAccount(tx, ledger.PurposeOpeningBalance, acc.Currency)
+			if err != nil {
+				return err
+			}
+			_, err = ledger.Post(tx, acc.ID, "opening balance", ledger.Transfer(equity.ID, acc.ID, acc.Currency, opening)...)
+			return err
+		})
+		if err != nil {
+			slog.Error("failed to create account", "error", err)
 			os.Exit(1)
 		}
+		acc.Balance = opening
 		fmt.Printf("Created account: user_id=%s id=%s balance=%d currency=%s\n", acc.UserID, acc.ID, acc.Balance, acc.Currency)
 	}
 
@@ -0,0 +1,1918 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/account/v1/account.proto
+
+package accountv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccountStatus represents the lifecycle state of an account.
+type AccountStatus int32
+
+const (
+	// Default unspecified value.
+	AccountStatus_ACCOUNT_STATUS_UNSPECIFIED AccountStatus = 0
+	// The account can send and receive funds.
+	AccountStatus_ACCOUNT_STATUS_ACTIVE AccountStatus = 1
+	// The account was closed and can no longer move funds. Closing is final.
+	AccountStatus_ACCOUNT_STATUS_CLOSED AccountStatus = 2
+	// The account can neither send nor receive funds, for example while it is investigated.
+	AccountStatus_ACCOUNT_STATUS_FROZEN AccountStatus = 3
+	// The account can receive but not send funds.
+	AccountStatus_ACCOUNT_STATUS_DEBIT_BLOCKED AccountStatus = 4
+	// The account can send but not receive funds.
+	AccountStatus_ACCOUNT_STATUS_CREDIT_BLOCKED AccountStatus = 5
+)
+
+// Enum value maps for AccountStatus.
+var (
+	AccountStatus_name = map[int32]string{
+		0: "ACCOUNT_STATUS_UNSPECIFIED",
+		1: "ACCOUNT_STATUS_ACTIVE",
+		2: "ACCOUNT_STATUS_CLOSED",
+		3: "ACCOUNT_STATUS_FROZEN",
+		4: "ACCOUNT_STATUS_DEBIT_BLOCKED",
+		5: "ACCOUNT_STATUS_CREDIT_BLOCKED",
+	}
+	AccountStatus_value = map[string]int32{
+		"ACCOUNT_STATUS_UNSPECIFIED":    0,
+		"ACCOUNT_STATUS_ACTIVE":         1,
+		"ACCOUNT_STATUS_CLOSED":         2,
+		"ACCOUNT_STATUS_FROZEN":         3,
+		"ACCOUNT_STATUS_DEBIT_BLOCKED":  4,
+		"ACCOUNT_STATUS_CREDIT_BLOCKED": 5,
+	}
+)
+
+func (x AccountStatus) Enum() *AccountStatus {
+	p := new(AccountStatus)
+	*p = x
+	return p
+}
+
+func (x AccountStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_account_v1_account_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountStatus) Type() protoreflect.EnumType {
+	return &file_api_account_v1_account_proto_enumTypes[0]
+}
+
+func (x AccountStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountStatus.Descriptor instead.
+func (AccountStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{0}
+}
+
+// LimitScope is what a transfer limit is attached to.
+type LimitScope int32
+
+const (
+	// Default unspecified value.
+	LimitScope_LIMIT_SCOPE_UNSPECIFIED LimitScope = 0
+	// One account, by account ID. Usage is counted per account.
+	LimitScope_LIMIT_SCOPE_ACCOUNT LimitScope = 1
+	// Every account of a user in the limit's currency, by user ID. Usage is counted across them.
+	LimitScope_LIMIT_SCOPE_USER LimitScope = 2
+	// Every account of a tier, by tier name. Usage is counted per account.
+	LimitScope_LIMIT_SCOPE_TIER LimitScope = 3
+)
+
+// Enum value maps for LimitScope.
+var (
+	LimitScope_name = map[int32]string{
+		0: "LIMIT_SCOPE_UNSPECIFIED",
+		1: "LIMIT_SCOPE_ACCOUNT",
+		2: "LIMIT_SCOPE_USER",
+		3: "LIMIT_SCOPE_TIER",
+	}
+	LimitScope_value = map[string]int32{
+		"LIMIT_SCOPE_UNSPECIFIED": 0,
+		"LIMIT_SCOPE_ACCOUNT":     1,
+		"LIMIT_SCOPE_USER":        2,
+		"LIMIT_SCOPE_TIER":        3,
+	}
+)
+
+func (x LimitScope) Enum() *LimitScope {
+	p := new(LimitScope)
+	*p = x
+	return p
+}
+
+func (x LimitScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LimitScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_account_v1_account_proto_enumTypes[1].Descriptor()
+}
+
+func (LimitScope) Type() protoreflect.EnumType {
+	return &file_api_account_v1_account_proto_enumTypes[1]
+}
+
+func (x LimitScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LimitScope.Descriptor instead.
+func (LimitScope) EnumDescriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{1}
+}
+
+// Account is a wallet owned by a user and denominated in a single currency.
+type Account struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Balance in minor units (e.g., cents).
+	Balance int64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Currency as an ISO 4217 code, for example "USD" or "EUR".
+	Currency  string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status    AccountStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=account.v1.AccountStatus" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Tier selects the TIER-scoped transfer limits that apply to the account.
+	Tier string `protobuf:"bytes,8,opt,name=tier,proto3" json:"tier,omitempty"`
+	// Legal name of the account holder, screened against the sanctions list.
+	HolderName string `protobuf:"bytes,9,opt,name=holder_name,json=holderName,proto3" json:"holder_name,omitempty"`
+	// How far below zero the balance may go, in minor units.
+	OverdraftLimit int64 `protobuf:"varint,10,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	// Annual interest on a negative balance in basis points, accrued daily.
+	OverdraftRateBps int64 `protobuf:"varint,11,opt,name=overdraft_rate_bps,json=overdraftRateBps,proto3" json:"overdraft_rate_bps,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_api_account_v1_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Account) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Account) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Account) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Account) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Account) GetStatus() AccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+func (x *Account) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Account) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *Account) GetHolderName() string {
+	if x != nil {
+		return x.HolderName
+	}
+	return ""
+}
+
+func (x *Account) GetOverdraftLimit() int64 {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return 0
+}
+
+func (x *Account) GetOverdraftRateBps() int64 {
+	if x != nil {
+		return x.OverdraftRateBps
+	}
+	return 0
+}
+
+// CreateAccountRequest opens a new, empty account for a user.
+type CreateAccountRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Currency as an ISO 4217 code, for example "USD" or "EUR".
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Legal name of the account holder, screened against the sanctions list.
+	HolderName    string `protobuf:"bytes,3,opt,name=holder_name,json=holderName,proto3" json:"holder_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetHolderName() string {
+	if x != nil {
+		return x.HolderName
+	}
+	return ""
+}
+
+// CreateAccountResponse returns the created account.
+type CreateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// GetAccountRequest is used to fetch an account by its ID.
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// GetAccountResponse returns the requested account.
+type GetAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// ListAccountsByUserRequest is used to list every account owned by a user.
+type ListAccountsByUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsByUserRequest) Reset() {
+	*x = ListAccountsByUserRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsByUserRequest) ProtoMessage() {}
+
+func (x *ListAccountsByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsByUserRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsByUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAccountsByUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ListAccountsByUserResponse returns the user's accounts ordered by creation time.
+type ListAccountsByUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsByUserResponse) Reset() {
+	*x = ListAccountsByUserResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsByUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsByUserResponse) ProtoMessage() {}
+
+func (x *ListAccountsByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsByUserResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsByUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAccountsByUserResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// GetBalanceRequest is used to query the balance of an account.
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBalanceRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// GetBalanceResponse returns the balance of an account.
+type GetBalanceResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Ledger balance in minor units (e.g., cents).
+	Balance  int64  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Amount reserved by active authorization holds.
+	HeldAmount int64 `protobuf:"varint,4,opt,name=held_amount,json=heldAmount,proto3" json:"held_amount,omitempty"`
+	// Balance that can be spent: the ledger balance minus held_amount plus
+	// overdraft_limit.
+	AvailableBalance int64 `protobuf:"varint,5,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	// How far below zero the balance may go.
+	OverdraftLimit int64 `protobuf:"varint,6,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBalanceResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetBalanceResponse) GetHeldAmount() int64 {
+	if x != nil {
+		return x.HeldAmount
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetAvailableBalance() int64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetOverdraftLimit() int64 {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return 0
+}
+
+// CloseAccountRequest closes an account. The balance must be zero.
+type CloseAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseAccountRequest) Reset() {
+	*x = CloseAccountRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAccountRequest) ProtoMessage() {}
+
+func (x *CloseAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAccountRequest.ProtoReflect.Descriptor instead.
+func (*CloseAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CloseAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// CloseAccountResponse returns the closed account.
+type CloseAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseAccountResponse) Reset() {
+	*x = CloseAccountResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAccountResponse) ProtoMessage() {}
+
+func (x *CloseAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAccountResponse.ProtoReflect.Descriptor instead.
+func (*CloseAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CloseAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// SetAccountStatusRequest changes the status of an account.
+type SetAccountStatusRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Status    AccountStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=account.v1.AccountStatus" json:"status,omitempty"`
+	// Why the status changes; required and kept in the status history.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Who made the change, for the status history.
+	ChangedBy     string `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountStatusRequest) Reset() {
+	*x = SetAccountStatusRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountStatusRequest) ProtoMessage() {}
+
+func (x *SetAccountStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetAccountStatusRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SetAccountStatusRequest) GetStatus() AccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+func (x *SetAccountStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SetAccountStatusRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+// SetAccountStatusResponse returns the updated account.
+type SetAccountStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountStatusResponse) Reset() {
+	*x = SetAccountStatusResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountStatusResponse) ProtoMessage() {}
+
+func (x *SetAccountStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetAccountStatusResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// AccountStatusChange is one entry of an account's status history.
+type AccountStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    AccountStatus          `protobuf:"varint,1,opt,name=from_status,json=fromStatus,proto3,enum=account.v1.AccountStatus" json:"from_status,omitempty"`
+	ToStatus      AccountStatus          `protobuf:"varint,2,opt,name=to_status,json=toStatus,proto3,enum=account.v1.AccountStatus" json:"to_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,4,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountStatusChange) Reset() {
+	*x = AccountStatusChange{}
+	mi := &file_api_account_v1_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatusChange) ProtoMessage() {}
+
+func (x *AccountStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatusChange.ProtoReflect.Descriptor instead.
+func (*AccountStatusChange) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AccountStatusChange) GetFromStatus() AccountStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+func (x *AccountStatusChange) GetToStatus() AccountStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+func (x *AccountStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccountStatusChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *AccountStatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// ListAccountStatusChangesRequest is used to fetch the status history of an account.
+type ListAccountStatusChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountStatusChangesRequest) Reset() {
+	*x = ListAccountStatusChangesRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountStatusChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountStatusChangesRequest) ProtoMessage() {}
+
+func (x *ListAccountStatusChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountStatusChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountStatusChangesRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAccountStatusChangesRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// ListAccountStatusChangesResponse returns the status history, oldest first.
+type ListAccountStatusChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*AccountStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountStatusChangesResponse) Reset() {
+	*x = ListAccountStatusChangesResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountStatusChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountStatusChangesResponse) ProtoMessage() {}
+
+func (x *ListAccountStatusChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountStatusChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountStatusChangesResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAccountStatusChangesResponse) GetChanges() []*AccountStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// TransferLimit caps outgoing transfers in one currency. Daily and monthly windows are
+// calendar days and months in UTC. A zero cap means no limit for that dimension.
+type TransferLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Scope LimitScope             `protobuf:"varint,1,opt,name=scope,proto3,enum=account.v1.LimitScope" json:"scope,omitempty"`
+	// Account ID, user ID or tier name, depending on scope.
+	ScopeId string `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// Currency as an ISO 4217 code, for example "USD" or "EUR".
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Largest single transfer, in minor units.
+	PerTransaction int64 `protobuf:"varint,4,opt,name=per_transaction,json=perTransaction,proto3" json:"per_transaction,omitempty"`
+	// Total that may be sent per day, in minor units.
+	DailyAmount int64 `protobuf:"varint,5,opt,name=daily_amount,json=dailyAmount,proto3" json:"daily_amount,omitempty"`
+	// Total that may be sent per month, in minor units.
+	MonthlyAmount int64 `protobuf:"varint,6,opt,name=monthly_amount,json=monthlyAmount,proto3" json:"monthly_amount,omitempty"`
+	// Number of transfers that may be sent per day.
+	DailyCount    int32 `protobuf:"varint,7,opt,name=daily_count,json=dailyCount,proto3" json:"daily_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLimit) Reset() {
+	*x = TransferLimit{}
+	mi := &file_api_account_v1_account_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLimit) ProtoMessage() {}
+
+func (x *TransferLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLimit.ProtoReflect.Descriptor instead.
+func (*TransferLimit) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TransferLimit) GetScope() LimitScope {
+	if x != nil {
+		return x.Scope
+	}
+	return LimitScope_LIMIT_SCOPE_UNSPECIFIED
+}
+
+func (x *TransferLimit) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *TransferLimit) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransferLimit) GetPerTransaction() int64 {
+	if x != nil {
+		return x.PerTransaction
+	}
+	return 0
+}
+
+func (x *TransferLimit) GetDailyAmount() int64 {
+	if x != nil {
+		return x.DailyAmount
+	}
+	return 0
+}
+
+func (x *TransferLimit) GetMonthlyAmount() int64 {
+	if x != nil {
+		return x.MonthlyAmount
+	}
+	return 0
+}
+
+func (x *TransferLimit) GetDailyCount() int32 {
+	if x != nil {
+		return x.DailyCount
+	}
+	return 0
+}
+
+// TransferLimitStatus is a limit that applies to an account with its current usage.
+// Remaining values are zero for dimensions the limit does not cap.
+type TransferLimitStatus struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Limit                  *TransferLimit         `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	DailyAmountUsed        int64                  `protobuf:"varint,2,opt,name=daily_amount_used,json=dailyAmountUsed,proto3" json:"daily_amount_used,omitempty"`
+	MonthlyAmountUsed      int64                  `protobuf:"varint,3,opt,name=monthly_amount_used,json=monthlyAmountUsed,proto3" json:"monthly_amount_used,omitempty"`
+	DailyCountUsed         int32                  `protobuf:"varint,4,opt,name=daily_count_used,json=dailyCountUsed,proto3" json:"daily_count_used,omitempty"`
+	DailyAmountRemaining   int64                  `protobuf:"varint,5,opt,name=daily_amount_remaining,json=dailyAmountRemaining,proto3" json:"daily_amount_remaining,omitempty"`
+	MonthlyAmountRemaining int64                  `protobuf:"varint,6,opt,name=monthly_amount_remaining,json=monthlyAmountRemaining,proto3" json:"monthly_amount_remaining,omitempty"`
+	DailyCountRemaining    int32                  `protobuf:"varint,7,opt,name=daily_count_remaining,json=dailyCountRemaining,proto3" json:"daily_count_remaining,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TransferLimitStatus) Reset() {
+	*x = TransferLimitStatus{}
+	mi := &file_api_account_v1_account_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLimitStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLimitStatus) ProtoMessage() {}
+
+func (x *TransferLimitStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLimitStatus.ProtoReflect.Descriptor instead.
+func (*TransferLimitStatus) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TransferLimitStatus) GetLimit() *TransferLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *TransferLimitStatus) GetDailyAmountUsed() int64 {
+	if x != nil {
+		return x.DailyAmountUsed
+	}
+	return 0
+}
+
+func (x *TransferLimitStatus) GetMonthlyAmountUsed() int64 {
+	if x != nil {
+		return x.MonthlyAmountUsed
+	}
+	return 0
+}
+
+func (x *TransferLimitStatus) GetDailyCountUsed() int32 {
+	if x != nil {
+		return x.DailyCountUsed
+	}
+	return 0
+}
+
+func (x *TransferLimitStatus) GetDailyAmountRemaining() int64 {
+	if x != nil {
+		return x.DailyAmountRemaining
+	}
+	return 0
+}
+
+func (x *TransferLimitStatus) GetMonthlyAmountRemaining() int64 {
+	if x != nil {
+		return x.MonthlyAmountRemaining
+	}
+	return 0
+}
+
+func (x *TransferLimitStatus) GetDailyCountRemaining() int32 {
+	if x != nil {
+		return x.DailyCountRemaining
+	}
+	return 0
+}
+
+// SetTransferLimitRequest creates or replaces the limit for its scope, scope_id and currency.
+type SetTransferLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *TransferLimit         `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransferLimitRequest) Reset() {
+	*x = SetTransferLimitRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransferLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransferLimitRequest) ProtoMessage() {}
+
+func (x *SetTransferLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransferLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetTransferLimitRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetTransferLimitRequest) GetLimit() *TransferLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+// SetTransferLimitResponse returns the stored limit.
+type SetTransferLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *TransferLimit         `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransferLimitResponse) Reset() {
+	*x = SetTransferLimitResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransferLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransferLimitResponse) ProtoMessage() {}
+
+func (x *SetTransferLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransferLimitResponse.ProtoReflect.Descriptor instead.
+func (*SetTransferLimitResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetTransferLimitResponse) GetLimit() *TransferLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+// GetTransferLimitsRequest is used to fetch the limits that bind an account.
+type GetTransferLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferLimitsRequest) Reset() {
+	*x = GetTransferLimitsRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferLimitsRequest) ProtoMessage() {}
+
+func (x *GetTransferLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTransferLimitsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// GetTransferLimitsResponse returns the account's own, user and tier limits in its currency.
+type GetTransferLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        []*TransferLimitStatus `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferLimitsResponse) Reset() {
+	*x = GetTransferLimitsResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferLimitsResponse) ProtoMessage() {}
+
+func (x *GetTransferLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransferLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTransferLimitsResponse) GetLimits() []*TransferLimitStatus {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// SetAccountTierRequest moves an account to another tier.
+type SetAccountTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Tier          string                 `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountTierRequest) Reset() {
+	*x = SetAccountTierRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountTierRequest) ProtoMessage() {}
+
+func (x *SetAccountTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountTierRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountTierRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetAccountTierRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SetAccountTierRequest) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+// SetAccountTierResponse returns the updated account.
+type SetAccountTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountTierResponse) Reset() {
+	*x = SetAccountTierResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountTierResponse) ProtoMessage() {}
+
+func (x *SetAccountTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountTierResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountTierResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetAccountTierResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// SetOverdraftRequest sets an account's overdraft. A zero limit removes it.
+type SetOverdraftRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// How far below zero the balance may go, in minor units.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Annual interest on a negative balance in basis points, accrued daily.
+	RateBps       int64 `protobuf:"varint,3,opt,name=rate_bps,json=rateBps,proto3" json:"rate_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOverdraftRequest) Reset() {
+	*x = SetOverdraftRequest{}
+	mi := &file_api_account_v1_account_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOverdraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOverdraftRequest) ProtoMessage() {}
+
+func (x *SetOverdraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOverdraftRequest.ProtoReflect.Descriptor instead.
+func (*SetOverdraftRequest) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetOverdraftRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SetOverdraftRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SetOverdraftRequest) GetRateBps() int64 {
+	if x != nil {
+		return x.RateBps
+	}
+	return 0
+}
+
+// SetOverdraftResponse returns the updated account.
+type SetOverdraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetOverdraftResponse) Reset() {
+	*x = SetOverdraftResponse{}
+	mi := &file_api_account_v1_account_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOverdraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOverdraftResponse) ProtoMessage() {}
+
+func (x *SetOverdraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_account_v1_account_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOverdraftResponse.ProtoReflect.Descriptor instead.
+func (*SetOverdraftResponse) Descriptor() ([]byte, []int) {
+	return file_api_account_v1_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetOverdraftResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+var File_api_account_v1_account_proto protoreflect.FileDescriptor
+
+const file_api_account_v1_account_proto_rawDesc = "" +
+	"\n" +
+	"\x1capi/account/v1/account.proto\x12\n" +
+	"account.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x03\n" +
+	"\aAccount\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x121\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x19.account.v1.AccountStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04tier\x18\b \x01(\tR\x04tier\x12\x1f\n" +
+	"\vholder_name\x18\t \x01(\tR\n" +
+	"holderName\x12'\n" +
+	"\x0foverdraft_limit\x18\n" +
+	" \x01(\x03R\x0eoverdraftLimit\x12,\n" +
+	"\x12overdraft_rate_bps\x18\v \x01(\x03R\x10overdraftRateBps\"l\n" +
+	"\x14CreateAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vholder_name\x18\x03 \x01(\tR\n" +
+	"holderName\"F\n" +
+	"\x15CreateAccountResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\"2\n" +
+	"\x11GetAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"C\n" +
+	"\x12GetAccountResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\"4\n" +
+	"\x19ListAccountsByUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"M\n" +
+	"\x1aListAccountsByUserResponse\x12/\n" +
+	"\baccounts\x18\x01 \x03(\v2\x13.account.v1.AccountR\baccounts\"2\n" +
+	"\x11GetBalanceRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"\xe0\x01\n" +
+	"\x12GetBalanceResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vheld_amount\x18\x04 \x01(\x03R\n" +
+	"heldAmount\x12+\n" +
+	"\x11available_balance\x18\x05 \x01(\x03R\x10availableBalance\x12'\n" +
+	"\x0foverdraft_limit\x18\x06 \x01(\x03R\x0eoverdraftLimit\"4\n" +
+	"\x13CloseAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"E\n" +
+	"\x14CloseAccountResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\"\xa2\x01\n" +
+	"\x17SetAccountStatusRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.account.v1.AccountStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x04 \x01(\tR\tchangedBy\"I\n" +
+	"\x18SetAccountStatusResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\"\xfb\x01\n" +
+	"\x13AccountStatusChange\x12:\n" +
+	"\vfrom_status\x18\x01 \x01(\x0e2\x19.account.v1.AccountStatusR\n" +
+	"fromStatus\x126\n" +
+	"\tto_status\x18\x02 \x01(\x0e2\x19.account.v1.AccountStatusR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x04 \x01(\tR\tchangedBy\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"@\n" +
+	"\x1fListAccountStatusChangesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"]\n" +
+	" ListAccountStatusChangesResponse\x129\n" +
+	"\achanges\x18\x01 \x03(\v2\x1f.account.v1.AccountStatusChangeR\achanges\"\x88\x02\n" +
+	"\rTransferLimit\x12,\n" +
+	"\x05scope\x18\x01 \x01(\x0e2\x16.account.v1.LimitScopeR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\x02 \x01(\tR\ascopeId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12'\n" +
+	"\x0fper_transaction\x18\x04 \x01(\x03R\x0eperTransaction\x12!\n" +
+	"\fdaily_amount\x18\x05 \x01(\x03R\vdailyAmount\x12%\n" +
+	"\x0emonthly_amount\x18\x06 \x01(\x03R\rmonthlyAmount\x12\x1f\n" +
+	"\vdaily_count\x18\a \x01(\x05R\n" +
+	"dailyCount\"\xf0\x02\n" +
+	"\x13TransferLimitStatus\x12/\n" +
+	"\x05limit\x18\x01 \x01(\v2\x19.account.v1.TransferLimitR\x05limit\x12*\n" +
+	"\x11daily_amount_used\x18\x02 \x01(\x03R\x0fdailyAmountUsed\x12.\n" +
+	"\x13monthly_amount_used\x18\x03 \x01(\x03R\x11monthlyAmountUsed\x12(\n" +
+	"\x10daily_count_used\x18\x04 \x01(\x05R\x0edailyCountUsed\x124\n" +
+	"\x16daily_amount_remaining\x18\x05 \x01(\x03R\x14dailyAmountRemaining\x128\n" +
+	"\x18monthly_amount_remaining\x18\x06 \x01(\x03R\x16monthlyAmountRemaining\x122\n" +
+	"\x15daily_count_remaining\x18\a \x01(\x05R\x13dailyCountRemaining\"J\n" +
+	"\x17SetTransferLimitRequest\x12/\n" +
+	"\x05limit\x18\x01 \x01(\v2\x19.account.v1.TransferLimitR\x05limit\"K\n" +
+	"\x18SetTransferLimitResponse\x12/\n" +
+	"\x05limit\x18\x01 \x01(\v2\x19.account.v1.TransferLimitR\x05limit\"9\n" +
+	"\x18GetTransferLimitsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"T\n" +
+	"\x19GetTransferLimitsResponse\x127\n" +
+	"\x06limits\x18\x01 \x03(\v2\x1f.account.v1.TransferLimitStatusR\x06limits\"J\n" +
+	"\x15SetAccountTierRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04tier\x18\x02 \x01(\tR\x04tier\"G\n" +
+	"\x16SetAccountTierResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount\"e\n" +
+	"\x13SetOverdraftRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x19\n" +
+	"\brate_bps\x18\x03 \x01(\x03R\arateBps\"E\n" +
+	"\x14SetOverdraftResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.account.v1.AccountR\aaccount*\xc5\x01\n" +
+	"\rAccountStatus\x12\x1e\n" +
+	"\x1aACCOUNT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ACCOUNT_STATUS_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15ACCOUNT_STATUS_CLOSED\x10\x02\x12\x19\n" +
+	"\x15ACCOUNT_STATUS_FROZEN\x10\x03\x12 \n" +
+	"\x1cACCOUNT_STATUS_DEBIT_BLOCKED\x10\x04\x12!\n" +
+	"\x1dACCOUNT_STATUS_CREDIT_BLOCKED\x10\x05*n\n" +
+	"\n" +
+	"LimitScope\x12\x1b\n" +
+	"\x17LIMIT_SCOPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13LIMIT_SCOPE_ACCOUNT\x10\x01\x12\x14\n" +
+	"\x10LIMIT_SCOPE_USER\x10\x02\x12\x14\n" +
+	"\x10LIMIT_SCOPE_TIER\x10\x032\xfb\a\n" +
+	"\x0eAccountService\x12T\n" +
+	"\rCreateAccount\x12 .account.v1.CreateAccountRequest\x1a!.account.v1.CreateAccountResponse\x12K\n" +
+	"\n" +
+	"GetAccount\x12\x1d.account.v1.GetAccountRequest\x1a\x1e.account.v1.GetAccountResponse\x12c\n" +
+	"\x12ListAccountsByUser\x12%.account.v1.ListAccountsByUserRequest\x1a&.account.v1.ListAccountsByUserResponse\x12K\n" +
+	"\n" +
+	"GetBalance\x12\x1d.account.v1.GetBalanceRequest\x1a\x1e.account.v1.GetBalanceResponse\x12Q\n" +
+	"\fCloseAccount\x12\x1f.account.v1.CloseAccountRequest\x1a .account.v1.CloseAccountResponse\x12]\n" +
+	"\x10SetAccountStatus\x12#.account.v1.SetAccountStatusRequest\x1a$.account.v1.SetAccountStatusResponse\x12u\n" +
+	"\x18ListAccountStatusChanges\x12+.account.v1.ListAccountStatusChangesRequest\x1a,.account.v1.ListAccountStatusChangesResponse\x12]\n" +
+	"\x10SetTransferLimit\x12#.account.v1.SetTransferLimitRequest\x1a$.account.v1.SetTransferLimitResponse\x12`\n" +
+	"\x11GetTransferLimits\x12$.account.v1.GetTransferLimitsRequest\x1a%.account.v1.GetTransferLimitsResponse\x12W\n" +
+	"\x0eSetAccountTier\x12!.account.v1.SetAccountTierRequest\x1a\".account.v1.SetAccountTierResponse\x12Q\n" +
+	"\fSetOverdraft\x12\x1f.account.v1.SetOverdraftRequest\x1a .account.v1.SetOverdraftResponseB+Z)FinTechPorto/gen/api/account/v1;accountv1b\x06proto3"
+
+var (
+	file_api_account_v1_account_proto_rawDescOnce sync.Once
+	file_api_account_v1_account_proto_rawDescData []byte
+)
+
+func file_api_account_v1_account_proto_rawDescGZIP() []byte {
+	file_api_account_v1_account_proto_rawDescOnce.Do(func() {
+		file_api_account_v1_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_account_v1_account_proto_rawDesc), len(file_api_account_v1_account_proto_rawDesc)))
+	})
+	return file_api_account_v1_account_proto_rawDescData
+}
+
+var file_api_account_v1_account_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_account_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_api_account_v1_account_proto_goTypes = []any{
+	(AccountStatus)(0),                       // 0: account.v1.AccountStatus
+	(LimitScope)(0),                          // 1: account.v1.LimitScope
+	(*Account)(nil),                          // 2: account.v1.Account
+	(*CreateAccountRequest)(nil),             // 3: account.v1.CreateAccountRequest
+	(*CreateAccountResponse)(nil),            // 4: account.v1.CreateAccountResponse
+	(*GetAccountRequest)(nil),                // 5: account.v1.GetAccountRequest
+	(*GetAccountResponse)(nil),               // 6: account.v1.GetAccountResponse
+	(*ListAccountsByUserRequest)(nil),        // 7: account.v1.ListAccountsByUserRequest
+	(*ListAccountsByUserResponse)(nil),       // 8: account.v1.ListAccountsByUserResponse
+	(*GetBalanceRequest)(nil),                // 9: account.v1.GetBalanceRequest
+	(*GetBalanceResponse)(nil),               // 10: account.v1.GetBalanceResponse
+	(*CloseAccountRequest)(nil),              // 11: account.v1.CloseAccountRequest
+	(*CloseAccountResponse)(nil),             // 12: account.v1.CloseAccountResponse
+	(*SetAccountStatusRequest)(nil),          // 13: account.v1.SetAccountStatusRequest
+	(*SetAccountStatusResponse)(nil),         // 14: account.v1.SetAccountStatusResponse
+	(*AccountStatusChange)(nil),              // 15: account.v1.AccountStatusChange
+	(*ListAccountStatusChangesRequest)(nil),  // 16: account.v1.ListAccountStatusChangesRequest
+	(*ListAccountStatusChangesResponse)(nil), // 17: account.v1.ListAccountStatusChangesResponse
+	(*TransferLimit)(nil),                    // 18: account.v1.TransferLimit
+	(*TransferLimitStatus)(nil),              // 19: account.v1.TransferLimitStatus
+	(*SetTransferLimitRequest)(nil),          // 20: account.v1.SetTransferLimitRequest
+	(*SetTransferLimitResponse)(nil),         // 21: account.v1.SetTransferLimitResponse
+	(*GetTransferLimitsRequest)(nil),         // 22: account.v1.GetTransferLimitsRequest
+	(*GetTransferLimitsResponse)(nil),        // 23: account.v1.GetTransferLimitsResponse
+	(*SetAccountTierRequest)(nil),            // 24: account.v1.SetAccountTierRequest
+	(*SetAccountTierResponse)(nil),           // 25: account.v1.SetAccountTierResponse
+	(*SetOverdraftRequest)(nil),              // 26: account.v1.SetOverdraftRequest
+	(*SetOverdraftResponse)(nil),             // 27: account.v1.SetOverdraftResponse
+	(*timestamppb.Timestamp)(nil),            // 28: google.protobuf.Timestamp
+}
+var file_api_account_v1_account_proto_depIdxs = []int32{
+	0,  // 0: account.v1.Account.status:type_name -> account.v1.AccountStatus
+	28, // 1: account.v1.Account.created_at:type_name -> google.protobuf.Timestamp
+	28, // 2: account.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: account.v1.CreateAccountResponse.account:type_name -> account.v1.Account
+	2,  // 4: account.v1.GetAccountResponse.account:type_name -> account.v1.Account
+	2,  // 5: account.v1.ListAccountsByUserResponse.accounts:type_name -> account.v1.Account
+	2,  // 6: account.v1.CloseAccountResponse.account:type_name -> account.v1.Account
+	0,  // 7: account.v1.SetAccountStatusRequest.status:type_name -> account.v1.AccountStatus
+	2,  // 8: account.v1.SetAccountStatusResponse.account:type_name -> account.v1.Account
+	0,  // 9: account.v1.AccountStatusChange.from_status:type_name -> account.v1.AccountStatus
+	0,  // 10: account.v1.AccountStatusChange.to_status:type_name -> account.v1.AccountStatus
+	28, // 11: account.v1.AccountStatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	15, // 12: account.v1.ListAccountStatusChangesResponse.changes:type_name -> account.v1.AccountStatusChange
+	1,  // 13: account.v1.TransferLimit.scope:type_name -> account.v1.LimitScope
+	18, // 14: account.v1.TransferLimitStatus.limit:type_name -> account.v1.TransferLimit
+	18, // 15: account.v1.SetTransferLimitRequest.limit:type_name -> account.v1.TransferLimit
+	18, // 16: account.v1.SetTransferLimitResponse.limit:type_name -> account.v1.TransferLimit
+	19, // 17: account.v1.GetTransferLimitsResponse.limits:type_name -> account.v1.TransferLimitStatus
+	2,  // 18: account.v1.SetAccountTierResponse.account:type_name -> account.v1.Account
+	2,  // 19: account.v1.SetOverdraftResponse.account:type_name -> account.v1.Account
+	3,  // 20: account.v1.AccountService.CreateAccount:input_type -> account.v1.CreateAccountRequest
+	5,  // 21: account.v1.AccountService.GetAccount:input_type -> account.v1.GetAccountRequest
+	7,  // 22: account.v1.AccountService.ListAccountsByUser:input_type -> account.v1.ListAccountsByUserRequest
+	9,  // 23: account.v1.AccountService.GetBalance:input_type -> account.v1.GetBalanceRequest
+	11, // 24: account.v1.AccountService.CloseAccount:input_type -> account.v1.CloseAccountRequest
+	13, // 25: account.v1.AccountService.SetAccountStatus:input_type -> account.v1.SetAccountStatusRequest
+	16, // 26: account.v1.AccountService.ListAccountStatusChanges:input_type -> account.v1.ListAccountStatusChangesRequest
+	20, // 27: account.v1.AccountService.SetTransferLimit:input_type -> account.v1.SetTransferLimitRequest
+	22, // 28: account.v1.AccountService.GetTransferLimits:input_type -> account.v1.GetTransferLimitsRequest
+	24, // 29: account.v1.AccountService.SetAccountTier:input_type -> account.v1.SetAccountTierRequest
+	26, // 30: account.v1.AccountService.SetOverdraft:input_type -> account.v1.SetOverdraftRequest
+	4,  // 31: account.v1.AccountService.CreateAccount:output_type -> account.v1.CreateAccountResponse
+	6,  // 32: account.v1.AccountService.GetAccount:output_type -> account.v1.GetAccountResponse
+	8,  // 33: account.v1.AccountService.ListAccountsByUser:output_type -> account.v1.ListAccountsByUserResponse
+	10, // 34: account.v1.AccountService.GetBalance:output_type -> account.v1.GetBalanceResponse
+	12, // 35: account.v1.AccountService.CloseAccount:output_type -> account.v1.CloseAccountResponse
+	14, // 36: account.v1.AccountService.SetAccountStatus:output_type -> account.v1.SetAccountStatusResponse
+	17, // 37: account.v1.AccountService.ListAccountStatusChanges:output_type -> account.v1.ListAccountStatusChangesResponse
+	21, // 38: account.v1.AccountService.SetTransferLimit:output_type -> account.v1.SetTransferLimitResponse
+	23, // 39: account.v1.AccountService.GetTransferLimits:output_type -> account.v1.GetTransferLimitsResponse
+	25, // 40: account.v1.AccountService.SetAccountTier:output_type -> account.v1.SetAccountTierResponse
+	27, // 41: account.v1.AccountService.SetOverdraft:output_type -> account.v1.SetOverdraftResponse
+	31, // [31:42] is the sub-list for method output_type
+	20, // [20:31] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_api_account_v1_account_proto_init() }
+func file_api_account_v1_account_proto_init() {
+	if File_api_account_v1_account_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_account_v1_account_proto_rawDesc), len(file_api_account_v1_account_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_account_v1_account_proto_goTypes,
+		DependencyIndexes: file_api_account_v1_account_proto_depIdxs,
+		EnumInfos:         file_api_account_v1_account_proto_enumTypes,
+		MessageInfos:      file_api_account_v1_account_proto_msgTypes,
+	}.Build()
+	File_api_account_v1_account_proto = out.File
+	file_api_account_v1_account_proto_goTypes = nil
+	file_api_account_v1_account_proto_depIdxs = nil
+}
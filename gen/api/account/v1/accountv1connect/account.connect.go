@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/account/v1/account.proto
+
+package accountv1connect
+
+import (
+	v1 "FinTechPorto/gen/api/account/v1"
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// AccountServiceName is the fully-qualified name of the AccountService service.
+	AccountServiceName = "account.v1.AccountService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccountServiceCreateAccountProcedure is the fully-qualified name of the AccountService's
+	// CreateAccount RPC.
+	AccountServiceCreateAccountProcedure = "/account.v1.AccountService/CreateAccount"
+	// AccountServiceGetAccountProcedure is the fully-qualified name of the AccountService's GetAccount
+	// RPC.
+	AccountServiceGetAccountProcedure = "/account.v1.AccountService/GetAccount"
+	// AccountServiceListAccountsByUserProcedure is the fully-qualified name of the AccountService's
+	// ListAccountsByUser RPC.
+	AccountServiceListAccountsByUserProcedure = "/account.v1.AccountService/ListAccountsByUser"
+	// AccountServiceGetBalanceProcedure is the fully-qualified name of the AccountService's GetBalance
+	// RPC.
+	AccountServiceGetBalanceProcedure = "/account.v1.AccountService/GetBalance"
+	// AccountServiceCloseAccountProcedure is the fully-qualified name of the AccountService's
+	// CloseAccount RPC.
+	AccountServiceCloseAccountProcedure = "/account.v1.AccountService/CloseAccount"
+	// AccountServiceSetAccountStatusProcedure is the fully-qualified name of the AccountService's
+	// SetAccountStatus RPC.
+	AccountServiceSetAccountStatusProcedure = "/account.v1.AccountService/SetAccountStatus"
+	// AccountServiceListAccountStatusChangesProcedure is the fully-qualified name of the
+	// AccountService's ListAccountStatusChanges RPC.
+	AccountServiceListAccountStatusChangesProcedure = "/account.v1.AccountService/ListAccountStatusChanges"
+	// AccountServiceSetTransferLimitProcedure is the fully-qualified name of the AccountService's
+	// SetTransferLimit RPC.
+	AccountServiceSetTransferLimitProcedure = "/account.v1.AccountService/SetTransferLimit"
+	// AccountServiceGetTransferLimitsProcedure is the fully-qualified name of the AccountService's
+	// GetTransferLimits RPC.
+	AccountServiceGetTransferLimitsProcedure = "/account.v1.AccountService/GetTransferLimits"
+	// AccountServiceSetAccountTierProcedure is the fully-qualified name of the AccountService's
+	// SetAccountTier RPC.
+	AccountServiceSetAccountTierProcedure = "/account.v1.AccountService/SetAccountTier"
+	// AccountServiceSetOverdraftProcedure is the fully-qualified name of the AccountService's
+	// SetOverdraft RPC.
+	AccountServiceSetOverdraftProcedure = "/account.v1.AccountService/SetOverdraft"
+)
+
+// AccountServiceClient is a client for the account.v1.AccountService service.
+type AccountServiceClient interface {
+	// CreateAccount opens a new account with a zero balance.
+	CreateAccount(context.Context, *connect_go.Request[v1.CreateAccountRequest]) (*connect_go.Response[v1.CreateAccountResponse], error)
+	// GetAccount returns an account by its ID.
+	GetAccount(context.Context, *connect_go.Request[v1.GetAccountRequest]) (*connect_go.Response[v1.GetAccountResponse], error)
+	// ListAccountsByUser returns every account owned by a user.
+	ListAccountsByUser(context.Context, *connect_go.Request[v1.ListAccountsByUserRequest]) (*connect_go.Response[v1.ListAccountsByUserResponse], error)
+	// GetBalance returns the current balance of an account.
+	GetBalance(context.Context, *connect_go.Request[v1.GetBalanceRequest]) (*connect_go.Response[v1.GetBalanceResponse], error)
+	// CloseAccount closes an account whose balance is zero.
+	CloseAccount(context.Context, *connect_go.Request[v1.CloseAccountRequest]) (*connect_go.Response[v1.CloseAccountResponse], error)
+	// SetAccountStatus freezes, blocks, reactivates or closes an account.
+	SetAccountStatus(context.Context, *connect_go.Request[v1.SetAccountStatusRequest]) (*connect_go.Response[v1.SetAccountStatusResponse], error)
+	// ListAccountStatusChanges returns the status history of an account.
+	ListAccountStatusChanges(context.Context, *connect_go.Request[v1.ListAccountStatusChangesRequest]) (*connect_go.Response[v1.ListAccountStatusChangesResponse], error)
+	// SetTransferLimit creates or replaces an account, user or tier transfer limit.
+	SetTransferLimit(context.Context, *connect_go.Request[v1.SetTransferLimitRequest]) (*connect_go.Response[v1.SetTransferLimitResponse], error)
+	// GetTransferLimits returns the limits that bind an account and how much of each is used.
+	GetTransferLimits(context.Context, *connect_go.Request[v1.GetTransferLimitsRequest]) (*connect_go.Response[v1.GetTransferLimitsResponse], error)
+	// SetAccountTier moves an account to another limit tier.
+	SetAccountTier(context.Context, *connect_go.Request[v1.SetAccountTierRequest]) (*connect_go.Response[v1.SetAccountTierResponse], error)
+	// SetOverdraft sets how far below zero an account may go and the interest it pays
+	// while it does.
+	SetOverdraft(context.Context, *connect_go.Request[v1.SetOverdraftRequest]) (*connect_go.Response[v1.SetOverdraftResponse], error)
+}
+
+// NewAccountServiceClient constructs a client for the account.v1.AccountService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccountServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) AccountServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &accountServiceClient{
+		createAccount: connect_go.NewClient[v1.CreateAccountRequest, v1.CreateAccountResponse](
+			httpClient,
+			baseURL+AccountServiceCreateAccountProcedure,
+			opts...,
+		),
+		getAccount: connect_go.NewClient[v1.GetAccountRequest, v1.GetAccountResponse](
+			httpClient,
+			baseURL+AccountServiceGetAccountProcedure,
+			opts...,
+		),
+		listAccountsByUser: connect_go.NewClient[v1.ListAccountsByUserRequest, v1.ListAccountsByUserResponse](
+			httpClient,
+			baseURL+AccountServiceListAccountsByUserProcedure,
+			opts...,
+		),
+		getBalance: connect_go.NewClient[v1.GetBalanceRequest, v1.GetBalanceResponse](
+			httpClient,
+			baseURL+AccountServiceGetBalanceProcedure,
+			opts...,
+		),
+		closeAccount: connect_go.NewClient[v1.CloseAccountRequest, v1.CloseAccountResponse](
+			httpClient,
+			baseURL+AccountServiceCloseAccountProcedure,
+			opts...,
+		),
+		setAccountStatus: connect_go.NewClient[v1.SetAccountStatusRequest, v1.SetAccountStatusResponse](
+			httpClient,
+			baseURL+AccountServiceSetAccountStatusProcedure,
+			opts...,
+		),
+		listAccountStatusChanges: connect_go.NewClient[v1.ListAccountStatusChangesRequest, v1.ListAccountStatusChangesResponse](
+			httpClient,
+			baseURL+AccountServiceListAccountStatusChangesProcedure,
+			opts...,
+		),
+		setTransferLimit: connect_go.NewClient[v1.SetTransferLimitRequest, v1.SetTransferLimitResponse](
+			httpClient,
+			baseURL+AccountServiceSetTransferLimitProcedure,
+			opts...,
+		),
+		getTransferLimits: connect_go.NewClient[v1.GetTransferLimitsRequest, v1.GetTransferLimitsResponse](
+			httpClient,
+			baseURL+AccountServiceGetTransferLimitsProcedure,
+			opts...,
+		),
+		setAccountTier: connect_go.NewClient[v1.SetAccountTierRequest, v1.SetAccountTierResponse](
+			httpClient,
+			baseURL+AccountServiceSetAccountTierProcedure,
+			opts...,
+		),
+		setOverdraft: connect_go.NewClient[v1.SetOverdraftRequest, v1.SetOverdraftResponse](
+			httpClient,
+			baseURL+AccountServiceSetOverdraftProcedure,
+			opts...,
+		),
+	}
+}
+
+// accountServiceClient implements AccountServiceClient.
+type accountServiceClient struct {
+	createAccount            *connect_go.Client[v1.CreateAccountRequest, v1.CreateAccountResponse]
+	getAccount               *connect_go.Client[v1.GetAccountRequest, v1.GetAccountResponse]
+	listAccountsByUser       *connect_go.Client[v1.ListAccountsByUserRequest, v1.ListAccountsByUserResponse]
+	getBalance               *connect_go.Client[v1.GetBalanceRequest, v1.GetBalanceResponse]
+	closeAccount             *connect_go.Client[v1.CloseAccountRequest, v1.CloseAccountResponse]
+	setAccountStatus         *connect_go.Client[v1.SetAccountStatusRequest, v1.SetAccountStatusResponse]
+	listAccountStatusChanges *connect_go.Client[v1.ListAccountStatusChangesRequest, v1.ListAccountStatusChangesResponse]
+	setTransferLimit         *connect_go.Client[v1.SetTransferLimitRequest, v1.SetTransferLimitResponse]
+	getTransferLimits        *connect_go.Client[v1.GetTransferLimitsRequest, v1.GetTransferLimitsResponse]
+	setAccountTier           *connect_go.Client[v1.SetAccountTierRequest, v1.SetAccountTierResponse]
+	setOverdraft             *connect_go.Client[v1.SetOverdraftRequest, v1.SetOverdraftResponse]
+}
+
+// CreateAccount calls account.v1.AccountService.CreateAccount.
+func (c *accountServiceClient) CreateAccount(ctx context.Context, req *connect_go.Request[v1.CreateAccountRequest]) (*connect_go.Response[v1.CreateAccountResponse], error) {
+	return c.createAccount.CallUnary(ctx, req)
+}
+
+// GetAccount calls account.v1.AccountService.GetAccount.
+func (c *accountServiceClient) GetAccount(ctx context.Context, req *connect_go.Request[v1.GetAccountRequest]) (*connect_go.Response[v1.GetAccountResponse], error) {
+	return c.getAccount.CallUnary(ctx, req)
+}
+
+// ListAccountsByUser calls account.v1.AccountService.ListAccountsByUser.
+func (c *accountServiceClient) ListAccountsByUser(ctx context.Context, req *connect_go.Request[v1.ListAccountsByUserRequest]) (*connect_go.Response[v1.ListAccountsByUserResponse], error) {
+	return c.listAccountsByUser.CallUnary(ctx, req)
+}
+
+// GetBalance calls account.v1.AccountService.GetBalance.
+func (c *accountServiceClient) GetBalance(ctx context.Context, req *connect_go.Request[v1.GetBalanceRequest]) (*connect_go.Response[v1.GetBalanceResponse], error) {
+	return c.getBalance.CallUnary(ctx, req)
+}
+
+// CloseAccount calls account.v1.AccountService.CloseAccount.
+func (c *accountServiceClient) CloseAccount(ctx context.Context, req *connect_go.Request[v1.CloseAccountRequest]) (*connect_go.Response[v1.CloseAccountResponse], error) {
+	return c.closeAccount.CallUnary(ctx, req)
+}
+
+// SetAccountStatus calls account.v1.AccountService.SetAccountStatus.
+func (c *accountServiceClient) SetAccountStatus(ctx context.Context, req *connect_go.Request[v1.SetAccountStatusRequest]) (*connect_go.Response[v1.SetAccountStatusResponse], error) {
+	return c.setAccountStatus.CallUnary(ctx, req)
+}
+
+// ListAccountStatusChanges calls account.v1.AccountService.ListAccountStatusChanges.
+func (c *accountServiceClient) ListAccountStatusChanges(ctx context.Context, req *connect_go.Request[v1.ListAccountStatusChangesRequest]) (*connect_go.Response[v1.ListAccountStatusChangesResponse], error) {
+	return c.listAccountStatusChanges.CallUnary(ctx, req)
+}
+
+// SetTransferLimit calls account.v1.AccountService.SetTransferLimit.
+func (c *accountServiceClient) SetTransferLimit(ctx context.Context, req *connect_go.Request[v1.SetTransferLimitRequest]) (*connect_go.Response[v1.SetTransferLimitResponse], error) {
+	return c.setTransferLimit.CallUnary(ctx, req)
+}
+
+// GetTransferLimits calls account.v1.AccountService.GetTransferLimits.
+func (c *accountServiceClient) GetTransferLimits(ctx context.Context, req *connect_go.Request[v1.GetTransferLimitsRequest]) (*connect_go.Response[v1.GetTransferLimitsResponse], error) {
+	return c.getTransferLimits.CallUnary(ctx, req)
+}
+
+// SetAccountTier calls account.v1.AccountService.SetAccountTier.
+func (c *accountServiceClient) SetAccountTier(ctx context.Context, req *connect_go.Request[v1.SetAccountTierRequest]) (*connect_go.Response[v1.SetAccountTierResponse], error) {
+	return c.setAccountTier.CallUnary(ctx, req)
+}
+
+// SetOverdraft calls account.v1.AccountService.SetOverdraft.
+func (c *accountServiceClient) SetOverdraft(ctx context.Context, req *connect_go.Request[v1.SetOverdraftRequest]) (*connect_go.Response[v1.SetOverdraftResponse], error) {
+	return c.setOverdraft.CallUnary(ctx, req)
+}
+
+// AccountServiceHandler is an implementation of the account.v1.AccountService service.
+type AccountServiceHandler interface {
+	// CreateAccount opens a new account with a zero balance.
+	CreateAccount(context.Context, *connect_go.Request[v1.CreateAccountRequest]) (*connect_go.Response[v1.CreateAccountResponse], error)
+	// GetAccount returns an account by its ID.
+	GetAccount(context.Context, *connect_go.Request[v1.GetAccountRequest]) (*connect_go.Response[v1.GetAccountResponse], error)
+	// ListAccountsByUser returns every account owned by a user.
+	ListAccountsByUser(context.Context, *connect_go.Request[v1.ListAccountsByUserRequest]) (*connect_go.Response[v1.ListAccountsByUserResponse], error)
+	// GetBalance returns the current balance of an account.
+	GetBalance(context.Context, *connect_go.Request[v1.GetBalanceRequest]) (*connect_go.Response[v1.GetBalanceResponse], error)
+	// CloseAccount closes an account whose balance is zero.
+	CloseAccount(context.Context, *connect_go.Request[v1.CloseAccountRequest]) (*connect_go.Response[v1.CloseAccountResponse], error)
+	// SetAccountStatus freezes, blocks, reactivates or closes an account.
+	SetAccountStatus(context.Context, *connect_go.Request[v1.SetAccountStatusRequest]) (*connect_go.Response[v1.SetAccountStatusResponse], error)
+	// ListAccountStatusChanges returns the status history of an account.
+	ListAccountStatusChanges(context.Context, *connect_go.Request[v1.ListAccountStatusChangesRequest]) (*connect_go.Response[v1.ListAccountStatusChangesResponse], error)
+	// SetTransferLimit creates or replaces an account, user or tier transfer limit.
+	SetTransferLimit(context.Context, *connect_go.Request[v1.SetTransferLimitRequest]) (*connect_go.Response[v1.SetTransferLimitResponse], error)
+	// GetTransferLimits returns the limits that bind an account and how much of each is used.
+	GetTransferLimits(context.Context, *connect_go.Request[v1.GetTransferLimitsRequest]) (*connect_go.Response[v1.GetTransferLimitsResponse], error)
+	// SetAccountTier moves an account to another limit tier.
+	SetAccountTier(context.Context, *connect_go.Request[v1.SetAccountTierRequest]) (*connect_go.Response[v1.SetAccountTierResponse], error)
+	// SetOverdraft sets how far below zero an account may go and the interest it pays
+	// while it does.
+	SetOverdraft(context.Context, *connect_go.Request[v1.SetOverdraftRequest]) (*connect_go.Response[v1.SetOverdraftResponse], error)
+}
+
+// NewAccountServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	accountServiceCreateAccountHandler := connect_go.NewUnaryHandler(
+		AccountServiceCreateAccountProcedure,
+		svc.CreateAccount,
+		opts...,
+	)
+	accountServiceGetAccountHandler := connect_go.NewUnaryHandler(
+		AccountServiceGetAccountProcedure,
+		svc.GetAccount,
+		opts...,
+	)
+	accountServiceListAccountsByUserHandler := connect_go.NewUnaryHandler(
+		AccountServiceListAccountsByUserProcedure,
+		svc.ListAccountsByUser,
+		opts...,
+	)
+	accountServiceGetBalanceHandler := connect_go.NewUnaryHandler(
+		AccountServiceGetBalanceProcedure,
+		svc.GetBalance,
+		opts...,
+	)
+	accountServiceCloseAccountHandler := connect_go.NewUnaryHandler(
+		AccountServiceCloseAccountProcedure,
+		svc.CloseAccount,
+		opts...,
+	)
+	accountServiceSetAccountStatusHandler := connect_go.NewUnaryHandler(
+		AccountServiceSetAccountStatusProcedure,
+		svc.SetAccountStatus,
+		opts...,
+	)
+	accountServiceListAccountStatusChangesHandler := connect_go.NewUnaryHandler(
+		AccountServiceListAccountStatusChangesProcedure,
+		svc.ListAccountStatusChanges,
+		opts...,
+	)
+	accountServiceSetTransferLimitHandler := connect_go.NewUnaryHandler(
+		AccountServiceSetTransferLimitProcedure,
+		svc.SetTransferLimit,
+		opts...,
+	)
+	accountServiceGetTransferLimitsHandler := connect_go.NewUnaryHandler(
+		AccountServiceGetTransferLimitsProcedure,
+		svc.GetTransferLimits,
+		opts...,
+	)
+	accountServiceSetAccountTierHandler := connect_go.NewUnaryHandler(
+		AccountServiceSetAccountTierProcedure,
+		svc.SetAccountTier,
+		opts...,
+	)
+	accountServiceSetOverdraftHandler := connect_go.NewUnaryHandler(
+		AccountServiceSetOverdraftProcedure,
+		svc.SetOverdraft,
+		opts...,
+	)
+	return "/account.v1.AccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccountServiceCreateAccountProcedure:
+			accountServiceCreateAccountHandler.ServeHTTP(w, r)
+		case AccountServiceGetAccountProcedure:
+			accountServiceGetAccountHandler.ServeHTTP(w, r)
+		case AccountServiceListAccountsByUserProcedure:
+			accountServiceListAccountsByUserHandler.ServeHTTP(w, r)
+		case AccountServiceGetBalanceProcedure:
+			accountServiceGetBalanceHandler.ServeHTTP(w, r)
+		case AccountServiceCloseAccountProcedure:
+			accountServiceCloseAccountHandler.ServeHTTP(w, r)
+		case AccountServiceSetAccountStatusProcedure:
+			accountServiceSetAccountStatusHandler.ServeHTTP(w, r)
+		case AccountServiceListAccountStatusChangesProcedure:
+			accountServiceListAccountStatusChangesHandler.ServeHTTP(w, r)
+		case AccountServiceSetTransferLimitProcedure:
+			accountServiceSetTransferLimitHandler.ServeHTTP(w, r)
+		case AccountServiceGetTransferLimitsProcedure:
+			accountServiceGetTransferLimitsHandler.ServeHTTP(w, r)
+		case AccountServiceSetAccountTierProcedure:
+			accountServiceSetAccountTierHandler.ServeHTTP(w, r)
+		case AccountServiceSetOverdraftProcedure:
+			accountServiceSetOverdraftHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccountServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccountServiceHandler struct{}
+
+func (UnimplementedAccountServiceHandler) CreateAccount(context.Context, *connect_go.Request[v1.CreateAccountRequest]) (*connect_go.Response[v1.CreateAccountResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.CreateAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetAccount(context.Context, *connect_go.Request[v1.GetAccountRequest]) (*connect_go.Response[v1.GetAccountResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.GetAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ListAccountsByUser(context.Context, *connect_go.Request[v1.ListAccountsByUserRequest]) (*connect_go.Response[v1.ListAccountsByUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.ListAccountsByUser is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetBalance(context.Context, *connect_go.Request[v1.GetBalanceRequest]) (*connect_go.Response[v1.GetBalanceResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.GetBalance is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) CloseAccount(context.Context, *connect_go.Request[v1.CloseAccountRequest]) (*connect_go.Response[v1.CloseAccountResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.CloseAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) SetAccountStatus(context.Context, *connect_go.Request[v1.SetAccountStatusRequest]) (*connect_go.Response[v1.SetAccountStatusResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.SetAccountStatus is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ListAccountStatusChanges(context.Context, *connect_go.Request[v1.ListAccountStatusChangesRequest]) (*connect_go.Response[v1.ListAccountStatusChangesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.ListAccountStatusChanges is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) SetTransferLimit(context.Context, *connect_go.Request[v1.SetTransferLimitRequest]) (*connect_go.Response[v1.SetTransferLimitResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.SetTransferLimit is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetTransferLimits(context.Context, *connect_go.Request[v1.GetTransferLimitsRequest]) (*connect_go.Response[v1.GetTransferLimitsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.GetTransferLimits is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) SetAccountTier(context.Context, *connect_go.Request[v1.SetAccountTierRequest]) (*connect_go.Response[v1.SetAccountTierResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.SetAccountTier is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) SetOverdraft(context.Context, *connect_go.Request[v1.SetOverdraftRequest]) (*connect_go.Response[v1.SetOverdraftResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("account.v1.AccountService.SetOverdraft is not implemented"))
+}
@@ -0,0 +1,955 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/events/v1/events.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Envelope wraps every event published to the broker. It is serialized as canonical
+// protobuf JSON; consumers should ignore unknown fields and reject a schema_version
+// newer than they understand.
+type Envelope struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique ID of the event; redeliveries of the same event keep it.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Dotted name of the payload, for example "transfer.completed".
+	EventType string `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Version of the payload schema for event_type.
+	SchemaVersion int32 `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// When the change the event reports was committed.
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Ties together the events of one business flow: the transaction ID for transfers,
+	// the original transaction for refunds, the batch ID for batches.
+	CorrelationId string `protobuf:"bytes,5,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	// Account the event is ordered by.
+	AggregateId string `protobuf:"bytes,6,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Envelope_TransferCompleted
+	//	*Envelope_TransferFailed
+	//	*Envelope_TransferRejected
+	//	*Envelope_TransferReversed
+	//	*Envelope_BatchSettled
+	//	*Envelope_OverdraftThresholdCrossed
+	Payload       isEnvelope_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	mi := &file_api_events_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Envelope) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Envelope) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *Envelope) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *Envelope) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *Envelope) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *Envelope) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *Envelope) GetPayload() isEnvelope_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Envelope) GetTransferCompleted() *TransferCompleted {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_TransferCompleted); ok {
+			return x.TransferCompleted
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetTransferFailed() *TransferFailed {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_TransferFailed); ok {
+			return x.TransferFailed
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetTransferRejected() *TransferRejected {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_TransferRejected); ok {
+			return x.TransferRejected
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetTransferReversed() *TransferReversed {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_TransferReversed); ok {
+			return x.TransferReversed
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetBatchSettled() *BatchSettled {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_BatchSettled); ok {
+			return x.BatchSettled
+		}
+	}
+	return nil
+}
+
+func (x *Envelope) GetOverdraftThresholdCrossed() *OverdraftThresholdCrossed {
+	if x != nil {
+		if x, ok := x.Payload.(*Envelope_OverdraftThresholdCrossed); ok {
+			return x.OverdraftThresholdCrossed
+		}
+	}
+	return nil
+}
+
+type isEnvelope_Payload interface {
+	isEnvelope_Payload()
+}
+
+type Envelope_TransferCompleted struct {
+	TransferCompleted *TransferCompleted `protobuf:"bytes,10,opt,name=transfer_completed,json=transferCompleted,proto3,oneof"`
+}
+
+type Envelope_TransferFailed struct {
+	TransferFailed *TransferFailed `protobuf:"bytes,11,opt,name=transfer_failed,json=transferFailed,proto3,oneof"`
+}
+
+type Envelope_TransferRejected struct {
+	TransferRejected *TransferRejected `protobuf:"bytes,12,opt,name=transfer_rejected,json=transferRejected,proto3,oneof"`
+}
+
+type Envelope_TransferReversed struct {
+	TransferReversed *TransferReversed `protobuf:"bytes,13,opt,name=transfer_reversed,json=transferReversed,proto3,oneof"`
+}
+
+type Envelope_BatchSettled struct {
+	BatchSettled *BatchSettled `protobuf:"bytes,14,opt,name=batch_settled,json=batchSettled,proto3,oneof"`
+}
+
+type Envelope_OverdraftThresholdCrossed struct {
+	OverdraftThresholdCrossed *OverdraftThresholdCrossed `protobuf:"bytes,15,opt,name=overdraft_threshold_crossed,json=overdraftThresholdCrossed,proto3,oneof"`
+}
+
+func (*Envelope_TransferCompleted) isEnvelope_Payload() {}
+
+func (*Envelope_TransferFailed) isEnvelope_Payload() {}
+
+func (*Envelope_TransferRejected) isEnvelope_Payload() {}
+
+func (*Envelope_TransferReversed) isEnvelope_Payload() {}
+
+func (*Envelope_BatchSettled) isEnvelope_Payload() {}
+
+func (*Envelope_OverdraftThresholdCrossed) isEnvelope_Payload() {}
+
+// Transfer is the state of a transaction when an event about it was produced.
+type Transfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// TRANSFER, CAPTURE, REFUND or REVERSAL.
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	SenderId    string `protobuf:"bytes,4,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId string `protobuf:"bytes,5,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	// Amount debited from the sender, excluding fees, in minor units.
+	Amount   int64  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Amount and currency credited to the recipient of a cross-currency transfer.
+	CounterAmount   int64  `protobuf:"varint,8,opt,name=counter_amount,json=counterAmount,proto3" json:"counter_amount,omitempty"`
+	CounterCurrency string `protobuf:"bytes,9,opt,name=counter_currency,json=counterCurrency,proto3" json:"counter_currency,omitempty"`
+	FeeAmount       int64  `protobuf:"varint,10,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	Memo            string `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Set on refunds and reversals.
+	OriginalTransactionId string                 `protobuf:"bytes,12,opt,name=original_transaction_id,json=originalTransactionId,proto3" json:"original_transaction_id,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_api_events_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Transfer) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Transfer) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Transfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Transfer) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *Transfer) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *Transfer) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transfer) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transfer) GetCounterAmount() int64 {
+	if x != nil {
+		return x.CounterAmount
+	}
+	return 0
+}
+
+func (x *Transfer) GetCounterCurrency() string {
+	if x != nil {
+		return x.CounterCurrency
+	}
+	return ""
+}
+
+func (x *Transfer) GetFeeAmount() int64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *Transfer) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Transfer) GetOriginalTransactionId() string {
+	if x != nil {
+		return x.OriginalTransactionId
+	}
+	return ""
+}
+
+func (x *Transfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// TransferCompleted reports that the recipient of a transfer was credited. Refunds and
+// reversals are reported by TransferReversed instead.
+type TransferCompleted struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Transfer *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// Set when the transfer captured an authorization hold.
+	HoldId        string `protobuf:"bytes,2,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCompleted) Reset() {
+	*x = TransferCompleted{}
+	mi := &file_api_events_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCompleted) ProtoMessage() {}
+
+func (x *TransferCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCompleted.ProtoReflect.Descriptor instead.
+func (*TransferCompleted) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransferCompleted) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *TransferCompleted) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+// TransferFailed reports that a transfer ended without moving funds to the recipient.
+type TransferFailed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferFailed) Reset() {
+	*x = TransferFailed{}
+	mi := &file_api_events_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFailed) ProtoMessage() {}
+
+func (x *TransferFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferFailed.ProtoReflect.Descriptor instead.
+func (*TransferFailed) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TransferFailed) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *TransferFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// TransferRejected reports that a transfer awaiting approval was rejected or timed out.
+type TransferRejected struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Transfer *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// Empty when the approval timed out.
+	ApproverId    string `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferRejected) Reset() {
+	*x = TransferRejected{}
+	mi := &file_api_events_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRejected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRejected) ProtoMessage() {}
+
+func (x *TransferRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRejected.ProtoReflect.Descriptor instead.
+func (*TransferRejected) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TransferRejected) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *TransferRejected) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *TransferRejected) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// TransferReversed reports that funds of a completed transfer went back to its sender,
+// through a refund or reversal transaction or by clawing back an item of an aborted batch.
+type TransferReversed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The transfer that was reversed, after the reversal.
+	Original *Transfer `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	// The refund or reversal transaction; unset for a batch clawback.
+	Reversal *Transfer `protobuf:"bytes,2,opt,name=reversal,proto3" json:"reversal,omitempty"`
+	// Amount returned by this reversal.
+	Amount        int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferReversed) Reset() {
+	*x = TransferReversed{}
+	mi := &file_api_events_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferReversed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferReversed) ProtoMessage() {}
+
+func (x *TransferReversed) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferReversed.ProtoReflect.Descriptor instead.
+func (*TransferReversed) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TransferReversed) GetOriginal() *Transfer {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *TransferReversed) GetReversal() *Transfer {
+	if x != nil {
+		return x.Reversal
+	}
+	return nil
+}
+
+func (x *TransferReversed) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferReversed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BatchSettled reports the outcome of a batch transfer.
+type BatchSettled struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	BatchId  string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	SenderId string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Currency string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// COMPLETED, PARTIALLY_COMPLETED or FAILED.
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ItemCount     int32  `protobuf:"varint,5,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	TotalAmount   int64  `protobuf:"varint,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalFee      int64  `protobuf:"varint,7,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+	FailureReason string `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSettled) Reset() {
+	*x = BatchSettled{}
+	mi := &file_api_events_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSettled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSettled) ProtoMessage() {}
+
+func (x *BatchSettled) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSettled.ProtoReflect.Descriptor instead.
+func (*BatchSettled) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchSettled) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *BatchSettled) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *BatchSettled) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BatchSettled) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchSettled) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *BatchSettled) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *BatchSettled) GetTotalFee() int64 {
+	if x != nil {
+		return x.TotalFee
+	}
+	return 0
+}
+
+func (x *BatchSettled) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+// OverdraftThresholdCrossed reports that a debit took an account's overdraft usage past
+// an alert threshold.
+type OverdraftThresholdCrossed struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Currency       string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance        int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	OverdraftLimit int64                  `protobuf:"varint,4,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	// Usage after the debit and the threshold it crossed, in percent of the limit.
+	UsagePercent     int64 `protobuf:"varint,5,opt,name=usage_percent,json=usagePercent,proto3" json:"usage_percent,omitempty"`
+	ThresholdPercent int64 `protobuf:"varint,6,opt,name=threshold_percent,json=thresholdPercent,proto3" json:"threshold_percent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OverdraftThresholdCrossed) Reset() {
+	*x = OverdraftThresholdCrossed{}
+	mi := &file_api_events_v1_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverdraftThresholdCrossed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverdraftThresholdCrossed) ProtoMessage() {}
+
+func (x *OverdraftThresholdCrossed) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_v1_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverdraftThresholdCrossed.ProtoReflect.Descriptor instead.
+func (*OverdraftThresholdCrossed) Descriptor() ([]byte, []int) {
+	return file_api_events_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OverdraftThresholdCrossed) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *OverdraftThresholdCrossed) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *OverdraftThresholdCrossed) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *OverdraftThresholdCrossed) GetOverdraftLimit() int64 {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return 0
+}
+
+func (x *OverdraftThresholdCrossed) GetUsagePercent() int64 {
+	if x != nil {
+		return x.UsagePercent
+	}
+	return 0
+}
+
+func (x *OverdraftThresholdCrossed) GetThresholdPercent() int64 {
+	if x != nil {
+		return x.ThresholdPercent
+	}
+	return 0
+}
+
+var File_api_events_v1_events_proto protoreflect.FileDescriptor
+
+const file_api_events_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapi/events/v1/events.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd2\x05\n" +
+	"\bEnvelope\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\x05R\rschemaVersion\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12%\n" +
+	"\x0ecorrelation_id\x18\x05 \x01(\tR\rcorrelationId\x12!\n" +
+	"\faggregate_id\x18\x06 \x01(\tR\vaggregateId\x12M\n" +
+	"\x12transfer_completed\x18\n" +
+	" \x01(\v2\x1c.events.v1.TransferCompletedH\x00R\x11transferCompleted\x12D\n" +
+	"\x0ftransfer_failed\x18\v \x01(\v2\x19.events.v1.TransferFailedH\x00R\x0etransferFailed\x12J\n" +
+	"\x11transfer_rejected\x18\f \x01(\v2\x1b.events.v1.TransferRejectedH\x00R\x10transferRejected\x12J\n" +
+	"\x11transfer_reversed\x18\r \x01(\v2\x1b.events.v1.TransferReversedH\x00R\x10transferReversed\x12>\n" +
+	"\rbatch_settled\x18\x0e \x01(\v2\x17.events.v1.BatchSettledH\x00R\fbatchSettled\x12f\n" +
+	"\x1boverdraft_threshold_crossed\x18\x0f \x01(\v2$.events.v1.OverdraftThresholdCrossedH\x00R\x19overdraftThresholdCrossedB\t\n" +
+	"\apayload\"\xc9\x03\n" +
+	"\bTransfer\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\tsender_id\x18\x04 \x01(\tR\bsenderId\x12!\n" +
+	"\frecipient_id\x18\x05 \x01(\tR\vrecipientId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12%\n" +
+	"\x0ecounter_amount\x18\b \x01(\x03R\rcounterAmount\x12)\n" +
+	"\x10counter_currency\x18\t \x01(\tR\x0fcounterCurrency\x12\x1d\n" +
+	"\n" +
+	"fee_amount\x18\n" +
+	" \x01(\x03R\tfeeAmount\x12\x12\n" +
+	"\x04memo\x18\v \x01(\tR\x04memo\x126\n" +
+	"\x17original_transaction_id\x18\f \x01(\tR\x15originalTransactionId\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"]\n" +
+	"\x11TransferCompleted\x12/\n" +
+	"\btransfer\x18\x01 \x01(\v2\x13.events.v1.TransferR\btransfer\x12\x17\n" +
+	"\ahold_id\x18\x02 \x01(\tR\x06holdId\"Y\n" +
+	"\x0eTransferFailed\x12/\n" +
+	"\btransfer\x18\x01 \x01(\v2\x13.events.v1.TransferR\btransfer\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"|\n" +
+	"\x10TransferRejected\x12/\n" +
+	"\btransfer\x18\x01 \x01(\v2\x13.events.v1.TransferR\btransfer\x12\x1f\n" +
+	"\vapprover_id\x18\x02 \x01(\tR\n" +
+	"approverId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xa4\x01\n" +
+	"\x10TransferReversed\x12/\n" +
+	"\boriginal\x18\x01 \x01(\v2\x13.events.v1.TransferR\boriginal\x12/\n" +
+	"\breversal\x18\x02 \x01(\v2\x13.events.v1.TransferR\breversal\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x80\x02\n" +
+	"\fBatchSettled\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x05 \x01(\x05R\titemCount\x12!\n" +
+	"\ftotal_amount\x18\x06 \x01(\x03R\vtotalAmount\x12\x1b\n" +
+	"\ttotal_fee\x18\a \x01(\x03R\btotalFee\x12%\n" +
+	"\x0efailure_reason\x18\b \x01(\tR\rfailureReason\"\xeb\x01\n" +
+	"\x19OverdraftThresholdCrossed\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\x12'\n" +
+	"\x0foverdraft_limit\x18\x04 \x01(\x03R\x0eoverdraftLimit\x12#\n" +
+	"\rusage_percent\x18\x05 \x01(\x03R\fusagePercent\x12+\n" +
+	"\x11threshold_percent\x18\x06 \x01(\x03R\x10thresholdPercentB)Z'FinTechPorto/gen/api/events/v1;eventsv1b\x06proto3"
+
+var (
+	file_api_events_v1_events_proto_rawDescOnce sync.Once
+	file_api_events_v1_events_proto_rawDescData []byte
+)
+
+func file_api_events_v1_events_proto_rawDescGZIP() []byte {
+	file_api_events_v1_events_proto_rawDescOnce.Do(func() {
+		file_api_events_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_events_v1_events_proto_rawDesc), len(file_api_events_v1_events_proto_rawDesc)))
+	})
+	return file_api_events_v1_events_proto_rawDescData
+}
+
+var file_api_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_events_v1_events_proto_goTypes = []any{
+	(*Envelope)(nil),                  // 0: events.v1.Envelope
+	(*Transfer)(nil),                  // 1: events.v1.Transfer
+	(*TransferCompleted)(nil),         // 2: events.v1.TransferCompleted
+	(*TransferFailed)(nil),            // 3: events.v1.TransferFailed
+	(*TransferRejected)(nil),          // 4: events.v1.TransferRejected
+	(*TransferReversed)(nil),          // 5: events.v1.TransferReversed
+	(*BatchSettled)(nil),              // 6: events.v1.BatchSettled
+	(*OverdraftThresholdCrossed)(nil), // 7: events.v1.OverdraftThresholdCrossed
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+}
+var file_api_events_v1_events_proto_depIdxs = []int32{
+	8,  // 0: events.v1.Envelope.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 1: events.v1.Envelope.transfer_completed:type_name -> events.v1.TransferCompleted
+	3,  // 2: events.v1.Envelope.transfer_failed:type_name -> events.v1.TransferFailed
+	4,  // 3: events.v1.Envelope.transfer_rejected:type_name -> events.v1.TransferRejected
+	5,  // 4: events.v1.Envelope.transfer_reversed:type_name -> events.v1.TransferReversed
+	6,  // 5: events.v1.Envelope.batch_settled:type_name -> events.v1.BatchSettled
+	7,  // 6: events.v1.Envelope.overdraft_threshold_crossed:type_name -> events.v1.OverdraftThresholdCrossed
+	8,  // 7: events.v1.Transfer.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: events.v1.TransferCompleted.transfer:type_name -> events.v1.Transfer
+	1,  // 9: events.v1.TransferFailed.transfer:type_name -> events.v1.Transfer
+	1,  // 10: events.v1.TransferRejected.transfer:type_name -> events.v1.Transfer
+	1,  // 11: events.v1.TransferReversed.original:type_name -> events.v1.Transfer
+	1,  // 12: events.v1.TransferReversed.reversal:type_name -> events.v1.Transfer
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_api_events_v1_events_proto_init() }
+func file_api_events_v1_events_proto_init() {
+	if File_api_events_v1_events_proto != nil {
+		return
+	}
+	file_api_events_v1_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*Envelope_TransferCompleted)(nil),
+		(*Envelope_TransferFailed)(nil),
+		(*Envelope_TransferRejected)(nil),
+		(*Envelope_TransferReversed)(nil),
+		(*Envelope_BatchSettled)(nil),
+		(*Envelope_OverdraftThresholdCrossed)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_events_v1_events_proto_rawDesc), len(file_api_events_v1_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_events_v1_events_proto_goTypes,
+		DependencyIndexes: file_api_events_v1_events_proto_depIdxs,
+		MessageInfos:      file_api_events_v1_events_proto_msgTypes,
+	}.Build()
+	File_api_events_v1_events_proto = out.File
+	file_api_events_v1_events_proto_goTypes = nil
+	file_api_events_v1_events_proto_depIdxs = nil
+}
@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/transaction/v1/fx.proto
+
+package transactionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FxRate is the mid-market price of one unit of base in units of quote, in major units.
+type FxRate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Base  string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote string                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	// Decimal rate, for example "15650.25".
+	Rate          string `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FxRate) Reset() {
+	*x = FxRate{}
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FxRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FxRate) ProtoMessage() {}
+
+func (x *FxRate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FxRate.ProtoReflect.Descriptor instead.
+func (*FxRate) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_fx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FxRate) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *FxRate) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *FxRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+// FxQuote locks a conversion rate for a limited time.
+type FxQuote struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId      string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	SellCurrency string                 `protobuf:"bytes,2,opt,name=sell_currency,json=sellCurrency,proto3" json:"sell_currency,omitempty"`
+	BuyCurrency  string                 `protobuf:"bytes,3,opt,name=buy_currency,json=buyCurrency,proto3" json:"buy_currency,omitempty"`
+	// Amounts in minor units of their currencies.
+	SellAmount int64 `protobuf:"varint,4,opt,name=sell_amount,json=sellAmount,proto3" json:"sell_amount,omitempty"`
+	BuyAmount  int64 `protobuf:"varint,5,opt,name=buy_amount,json=buyAmount,proto3" json:"buy_amount,omitempty"`
+	// Customer rate after the spread.
+	Rate      string `protobuf:"bytes,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	MidRate   string `protobuf:"bytes,7,opt,name=mid_rate,json=midRate,proto3" json:"mid_rate,omitempty"`
+	SpreadBps int64  `protobuf:"varint,8,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
+	// The quote must be used by CreateTransfer before this time.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FxQuote) Reset() {
+	*x = FxQuote{}
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FxQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FxQuote) ProtoMessage() {}
+
+func (x *FxQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FxQuote.ProtoReflect.Descriptor instead.
+func (*FxQuote) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_fx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FxQuote) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *FxQuote) GetSellCurrency() string {
+	if x != nil {
+		return x.SellCurrency
+	}
+	return ""
+}
+
+func (x *FxQuote) GetBuyCurrency() string {
+	if x != nil {
+		return x.BuyCurrency
+	}
+	return ""
+}
+
+func (x *FxQuote) GetSellAmount() int64 {
+	if x != nil {
+		return x.SellAmount
+	}
+	return 0
+}
+
+func (x *FxQuote) GetBuyAmount() int64 {
+	if x != nil {
+		return x.BuyAmount
+	}
+	return 0
+}
+
+func (x *FxQuote) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *FxQuote) GetMidRate() string {
+	if x != nil {
+		return x.MidRate
+	}
+	return ""
+}
+
+func (x *FxQuote) GetSpreadBps() int64 {
+	if x != nil {
+		return x.SpreadBps
+	}
+	return 0
+}
+
+func (x *FxQuote) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// QuoteFxRequest prices a conversion and locks the rate.
+type QuoteFxRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Currency of the sender account.
+	SellCurrency string `protobuf:"bytes,1,opt,name=sell_currency,json=sellCurrency,proto3" json:"sell_currency,omitempty"`
+	// Currency of the recipient account.
+	BuyCurrency string `protobuf:"bytes,2,opt,name=buy_currency,json=buyCurrency,proto3" json:"buy_currency,omitempty"`
+	// Amount the sender pays, in minor units.
+	SellAmount    int64 `protobuf:"varint,3,opt,name=sell_amount,json=sellAmount,proto3" json:"sell_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteFxRequest) Reset() {
+	*x = QuoteFxRequest{}
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteFxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteFxRequest) ProtoMessage() {}
+
+func (x *QuoteFxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteFxRequest.ProtoReflect.Descriptor instead.
+func (*QuoteFxRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_fx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QuoteFxRequest) GetSellCurrency() string {
+	if x != nil {
+		return x.SellCurrency
+	}
+	return ""
+}
+
+func (x *QuoteFxRequest) GetBuyCurrency() string {
+	if x != nil {
+		return x.BuyCurrency
+	}
+	return ""
+}
+
+func (x *QuoteFxRequest) GetSellAmount() int64 {
+	if x != nil {
+		return x.SellAmount
+	}
+	return 0
+}
+
+// QuoteFxResponse returns the locked quote.
+type QuoteFxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *FxQuote               `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteFxResponse) Reset() {
+	*x = QuoteFxResponse{}
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteFxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteFxResponse) ProtoMessage() {}
+
+func (x *QuoteFxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteFxResponse.ProtoReflect.Descriptor instead.
+func (*QuoteFxResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_fx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QuoteFxResponse) GetQuote() *FxQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+// SetFxRateRequest sets the mid-market rate for a currency pair.
+type SetFxRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *FxRate                `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFxRateRequest) Reset() {
+	*x = SetFxRateRequest{}
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFxRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFxRateRequest) ProtoMessage() {}
+
+func (x *SetFxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFxRateRequest.ProtoReflect.Descriptor instead.
+func (*SetFxRateRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_fx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetFxRateRequest) GetRate() *FxRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+// SetFxRateResponse returns the stored rate.
+type SetFxRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *FxRate                `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFxRateResponse) Reset() {
+	*x = SetFxRateResponse{}
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFxRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFxRateResponse) ProtoMessage() {}
+
+func (x *SetFxRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFxRateResponse.ProtoReflect.Descriptor instead.
+func (*SetFxRateResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_fx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetFxRateResponse) GetRate() *FxRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+// ListFxRatesRequest lists the configured rates.
+type ListFxRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFxRatesRequest) Reset() {
+	*x = ListFxRatesRequest{}
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFxRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFxRatesRequest) ProtoMessage() {}
+
+func (x *ListFxRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFxRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListFxRatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_fx_proto_rawDescGZIP(), []int{6}
+}
+
+// ListFxRatesResponse returns the configured rates and the spread applied to quotes.
+type ListFxRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*FxRate              `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	SpreadBps     int64                  `protobuf:"varint,2,opt,name=spread_bps,json=spreadBps,proto3" json:"spread_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFxRatesResponse) Reset() {
+	*x = ListFxRatesResponse{}
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFxRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFxRatesResponse) ProtoMessage() {}
+
+func (x *ListFxRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_fx_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFxRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListFxRatesResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_fx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListFxRatesResponse) GetRates() []*FxRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *ListFxRatesResponse) GetSpreadBps() int64 {
+	if x != nil {
+		return x.SpreadBps
+	}
+	return 0
+}
+
+var File_api_transaction_v1_fx_proto protoreflect.FileDescriptor
+
+const file_api_transaction_v1_fx_proto_rawDesc = "" +
+	"\n" +
+	"\x1bapi/transaction/v1/fx.proto\x12\x0etransaction.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"F\n" +
+	"\x06FxRate\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x14\n" +
+	"\x05quote\x18\x02 \x01(\tR\x05quote\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\"\xb5\x02\n" +
+	"\aFxQuote\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12#\n" +
+	"\rsell_currency\x18\x02 \x01(\tR\fsellCurrency\x12!\n" +
+	"\fbuy_currency\x18\x03 \x01(\tR\vbuyCurrency\x12\x1f\n" +
+	"\vsell_amount\x18\x04 \x01(\x03R\n" +
+	"sellAmount\x12\x1d\n" +
+	"\n" +
+	"buy_amount\x18\x05 \x01(\x03R\tbuyAmount\x12\x12\n" +
+	"\x04rate\x18\x06 \x01(\tR\x04rate\x12\x19\n" +
+	"\bmid_rate\x18\a \x01(\tR\amidRate\x12\x1d\n" +
+	"\n" +
+	"spread_bps\x18\b \x01(\x03R\tspreadBps\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"y\n" +
+	"\x0eQuoteFxRequest\x12#\n" +
+	"\rsell_currency\x18\x01 \x01(\tR\fsellCurrency\x12!\n" +
+	"\fbuy_currency\x18\x02 \x01(\tR\vbuyCurrency\x12\x1f\n" +
+	"\vsell_amount\x18\x03 \x01(\x03R\n" +
+	"sellAmount\"@\n" +
+	"\x0fQuoteFxResponse\x12-\n" +
+	"\x05quote\x18\x01 \x01(\v2\x17.transaction.v1.FxQuoteR\x05quote\">\n" +
+	"\x10SetFxRateRequest\x12*\n" +
+	"\x04rate\x18\x01 \x01(\v2\x16.transaction.v1.FxRateR\x04rate\"?\n" +
+	"\x11SetFxRateResponse\x12*\n" +
+	"\x04rate\x18\x01 \x01(\v2\x16.transaction.v1.FxRateR\x04rate\"\x14\n" +
+	"\x12ListFxRatesRequest\"b\n" +
+	"\x13ListFxRatesResponse\x12,\n" +
+	"\x05rates\x18\x01 \x03(\v2\x16.transaction.v1.FxRateR\x05rates\x12\x1d\n" +
+	"\n" +
+	"spread_bps\x18\x02 \x01(\x03R\tspreadBps2\x81\x02\n" +
+	"\tFxService\x12J\n" +
+	"\aQuoteFx\x12\x1e.transaction.v1.QuoteFxRequest\x1a\x1f.transaction.v1.QuoteFxResponse\x12P\n" +
+	"\tSetFxRate\x12 .transaction.v1.SetFxRateRequest\x1a!.transaction.v1.SetFxRateResponse\x12V\n" +
+	"\vListFxRates\x12\".transaction.v1.ListFxRatesRequest\x1a#.transaction.v1.ListFxRatesResponseB3Z1FinTechPorto/gen/api/transaction/v1;transactionv1b\x06proto3"
+
+var (
+	file_api_transaction_v1_fx_proto_rawDescOnce sync.Once
+	file_api_transaction_v1_fx_proto_rawDescData []byte
+)
+
+func file_api_transaction_v1_fx_proto_rawDescGZIP() []byte {
+	file_api_transaction_v1_fx_proto_rawDescOnce.Do(func() {
+		file_api_transaction_v1_fx_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_transaction_v1_fx_proto_rawDesc), len(file_api_transaction_v1_fx_proto_rawDesc)))
+	})
+	return file_api_transaction_v1_fx_proto_rawDescData
+}
+
+var file_api_transaction_v1_fx_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_transaction_v1_fx_proto_goTypes = []any{
+	(*FxRate)(nil),                // 0: transaction.v1.FxRate
+	(*FxQuote)(nil),               // 1: transaction.v1.FxQuote
+	(*QuoteFxRequest)(nil),        // 2: transaction.v1.QuoteFxRequest
+	(*QuoteFxResponse)(nil),       // 3: transaction.v1.QuoteFxResponse
+	(*SetFxRateRequest)(nil),      // 4: transaction.v1.SetFxRateRequest
+	(*SetFxRateResponse)(nil),     // 5: transaction.v1.SetFxRateResponse
+	(*ListFxRatesRequest)(nil),    // 6: transaction.v1.ListFxRatesRequest
+	(*ListFxRatesResponse)(nil),   // 7: transaction.v1.ListFxRatesResponse
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+}
+var file_api_transaction_v1_fx_proto_depIdxs = []int32{
+	8, // 0: transaction.v1.FxQuote.expires_at:type_name -> google.protobuf.Timestamp
+	1, // 1: transaction.v1.QuoteFxResponse.quote:type_name -> transaction.v1.FxQuote
+	0, // 2: transaction.v1.SetFxRateRequest.rate:type_name -> transaction.v1.FxRate
+	0, // 3: transaction.v1.SetFxRateResponse.rate:type_name -> transaction.v1.FxRate
+	0, // 4: transaction.v1.ListFxRatesResponse.rates:type_name -> transaction.v1.FxRate
+	2, // 5: transaction.v1.FxService.QuoteFx:input_type -> transaction.v1.QuoteFxRequest
+	4, // 6: transaction.v1.FxService.SetFxRate:input_type -> transaction.v1.SetFxRateRequest
+	6, // 7: transaction.v1.FxService.ListFxRates:input_type -> transaction.v1.ListFxRatesRequest
+	3, // 8: transaction.v1.FxService.QuoteFx:output_type -> transaction.v1.QuoteFxResponse
+	5, // 9: transaction.v1.FxService.SetFxRate:output_type -> transaction.v1.SetFxRateResponse
+	7, // 10: transaction.v1.FxService.ListFxRates:output_type -> transaction.v1.ListFxRatesResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_transaction_v1_fx_proto_init() }
+func file_api_transaction_v1_fx_proto_init() {
+	if File_api_transaction_v1_fx_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_transaction_v1_fx_proto_rawDesc), len(file_api_transaction_v1_fx_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_transaction_v1_fx_proto_goTypes,
+		DependencyIndexes: file_api_transaction_v1_fx_proto_depIdxs,
+		MessageInfos:      file_api_transaction_v1_fx_proto_msgTypes,
+	}.Build()
+	File_api_transaction_v1_fx_proto = out.File
+	file_api_transaction_v1_fx_proto_goTypes = nil
+	file_api_transaction_v1_fx_proto_depIdxs = nil
+}
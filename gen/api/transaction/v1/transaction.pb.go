@@ -36,6 +36,10 @@ const (
 	TransactionStatus_FAILED TransactionStatus = 3
 	// The transfer was reversed after completion.
 	TransactionStatus_REVERSED TransactionStatus = 4
+	// The transfer exceeds the approval threshold and waits for an approver.
+	TransactionStatus_AWAITING_APPROVAL TransactionStatus = 5
+	// An approver rejected the transfer, or nobody decided before the approval timeout.
+	TransactionStatus_REJECTED TransactionStatus = 6
 )
 
 // Enum value maps for TransactionStatus.
@@ -46,13 +50,17 @@ var (
 		2: "COMPLETED",
 		3: "FAILED",
 		4: "REVERSED",
+		5: "AWAITING_APPROVAL",
+		6: "REJECTED",
 	}
 	TransactionStatus_value = map[string]int32{
-		"UNSPECIFIED": 0,
-		"PENDING":     1,
-		"COMPLETED":   2,
-		"FAILED":      3,
-		"REVERSED":    4,
+		"UNSPECIFIED":       0,
+		"PENDING":           1,
+		"COMPLETED":         2,
+		"FAILED":            3,
+		"REVERSED":          4,
+		"AWAITING_APPROVAL": 5,
+		"REJECTED":          6,
 	}
 )
 
@@ -83,38 +91,4549 @@ func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{0}
 }
 
+// TransactionType distinguishes transfers from the counter-transactions that undo them.
+type TransactionType int32
+
+const (
+	// Default unspecified value.
+	TransactionType_TRANSACTION_TYPE_UNSPECIFIED TransactionType = 0
+	// A transfer initiated by the sender.
+	TransactionType_TRANSACTION_TYPE_TRANSFER TransactionType = 1
+	// A partial or full refund of a completed transfer.
+	TransactionType_TRANSACTION_TYPE_REFUND TransactionType = 2
+	// A reversal of whatever remains unrefunded on a completed transfer.
+	TransactionType_TRANSACTION_TYPE_REVERSAL TransactionType = 3
+	// A capture of funds reserved by an authorization hold.
+	TransactionType_TRANSACTION_TYPE_CAPTURE TransactionType = 4
+)
+
+// Enum value maps for TransactionType.
+var (
+	TransactionType_name = map[int32]string{
+		0: "TRANSACTION_TYPE_UNSPECIFIED",
+		1: "TRANSACTION_TYPE_TRANSFER",
+		2: "TRANSACTION_TYPE_REFUND",
+		3: "TRANSACTION_TYPE_REVERSAL",
+		4: "TRANSACTION_TYPE_CAPTURE",
+	}
+	TransactionType_value = map[string]int32{
+		"TRANSACTION_TYPE_UNSPECIFIED": 0,
+		"TRANSACTION_TYPE_TRANSFER":    1,
+		"TRANSACTION_TYPE_REFUND":      2,
+		"TRANSACTION_TYPE_REVERSAL":    3,
+		"TRANSACTION_TYPE_CAPTURE":     4,
+	}
+)
+
+func (x TransactionType) Enum() *TransactionType {
+	p := new(TransactionType)
+	*p = x
+	return p
+}
+
+func (x TransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[1].Descriptor()
+}
+
+func (TransactionType) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[1]
+}
+
+func (x TransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionType.Descriptor instead.
+func (TransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+// HoldStatus represents the lifecycle state of an authorization hold.
+type HoldStatus int32
+
+const (
+	// Default unspecified value.
+	HoldStatus_HOLD_STATUS_UNSPECIFIED HoldStatus = 0
+	// The funds are reserved and reduce the available balance.
+	HoldStatus_HOLD_STATUS_ACTIVE HoldStatus = 1
+	// The hold was captured, fully or partially; any remainder was released.
+	HoldStatus_HOLD_STATUS_CAPTURED HoldStatus = 2
+	// The hold was voided and the funds released.
+	HoldStatus_HOLD_STATUS_VOIDED HoldStatus = 3
+	// The hold expired before it was captured and the funds were released.
+	HoldStatus_HOLD_STATUS_EXPIRED HoldStatus = 4
+)
+
+// Enum value maps for HoldStatus.
+var (
+	HoldStatus_name = map[int32]string{
+		0: "HOLD_STATUS_UNSPECIFIED",
+		1: "HOLD_STATUS_ACTIVE",
+		2: "HOLD_STATUS_CAPTURED",
+		3: "HOLD_STATUS_VOIDED",
+		4: "HOLD_STATUS_EXPIRED",
+	}
+	HoldStatus_value = map[string]int32{
+		"HOLD_STATUS_UNSPECIFIED": 0,
+		"HOLD_STATUS_ACTIVE":      1,
+		"HOLD_STATUS_CAPTURED":    2,
+		"HOLD_STATUS_VOIDED":      3,
+		"HOLD_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x HoldStatus) Enum() *HoldStatus {
+	p := new(HoldStatus)
+	*p = x
+	return p
+}
+
+func (x HoldStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HoldStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[2].Descriptor()
+}
+
+func (HoldStatus) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[2]
+}
+
+func (x HoldStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HoldStatus.Descriptor instead.
+func (HoldStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+// ScheduleStatus represents the lifecycle state of a scheduled transfer.
+type ScheduleStatus int32
+
+const (
+	// Default unspecified value.
+	ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED ScheduleStatus = 0
+	// The schedule fires on its cron expression.
+	ScheduleStatus_SCHEDULE_STATUS_ACTIVE ScheduleStatus = 1
+	// The schedule reached its maximum run count or end date.
+	ScheduleStatus_SCHEDULE_STATUS_COMPLETED ScheduleStatus = 2
+	// The schedule was cancelled.
+	ScheduleStatus_SCHEDULE_STATUS_CANCELLED ScheduleStatus = 3
+)
+
+// Enum value maps for ScheduleStatus.
+var (
+	ScheduleStatus_name = map[int32]string{
+		0: "SCHEDULE_STATUS_UNSPECIFIED",
+		1: "SCHEDULE_STATUS_ACTIVE",
+		2: "SCHEDULE_STATUS_COMPLETED",
+		3: "SCHEDULE_STATUS_CANCELLED",
+	}
+	ScheduleStatus_value = map[string]int32{
+		"SCHEDULE_STATUS_UNSPECIFIED": 0,
+		"SCHEDULE_STATUS_ACTIVE":      1,
+		"SCHEDULE_STATUS_COMPLETED":   2,
+		"SCHEDULE_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x ScheduleStatus) Enum() *ScheduleStatus {
+	p := new(ScheduleStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[3].Descriptor()
+}
+
+func (ScheduleStatus) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[3]
+}
+
+func (x ScheduleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleStatus.Descriptor instead.
+func (ScheduleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+// InsufficientFundsPolicy decides what a scheduled run does when the sender cannot cover it.
+type InsufficientFundsPolicy int32
+
+const (
+	// Default unspecified value; treated as SKIP.
+	InsufficientFundsPolicy_INSUFFICIENT_FUNDS_POLICY_UNSPECIFIED InsufficientFundsPolicy = 0
+	// Skip the run and wait for the next one.
+	InsufficientFundsPolicy_INSUFFICIENT_FUNDS_POLICY_SKIP InsufficientFundsPolicy = 1
+	// Retry the run hourly, up to six attempts, before recording it as failed.
+	InsufficientFundsPolicy_INSUFFICIENT_FUNDS_POLICY_RETRY InsufficientFundsPolicy = 2
+)
+
+// Enum value maps for InsufficientFundsPolicy.
+var (
+	InsufficientFundsPolicy_name = map[int32]string{
+		0: "INSUFFICIENT_FUNDS_POLICY_UNSPECIFIED",
+		1: "INSUFFICIENT_FUNDS_POLICY_SKIP",
+		2: "INSUFFICIENT_FUNDS_POLICY_RETRY",
+	}
+	InsufficientFundsPolicy_value = map[string]int32{
+		"INSUFFICIENT_FUNDS_POLICY_UNSPECIFIED": 0,
+		"INSUFFICIENT_FUNDS_POLICY_SKIP":        1,
+		"INSUFFICIENT_FUNDS_POLICY_RETRY":       2,
+	}
+)
+
+func (x InsufficientFundsPolicy) Enum() *InsufficientFundsPolicy {
+	p := new(InsufficientFundsPolicy)
+	*p = x
+	return p
+}
+
+func (x InsufficientFundsPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InsufficientFundsPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[4].Descriptor()
+}
+
+func (InsufficientFundsPolicy) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[4]
+}
+
+func (x InsufficientFundsPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InsufficientFundsPolicy.Descriptor instead.
+func (InsufficientFundsPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+// ScheduleRunStatus represents the outcome of one run of a scheduled transfer.
+type ScheduleRunStatus int32
+
+const (
+	// Default unspecified value.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_UNSPECIFIED ScheduleRunStatus = 0
+	// The run is in progress or waiting to retry.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_PENDING ScheduleRunStatus = 1
+	// The run's transfer completed.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_COMPLETED ScheduleRunStatus = 2
+	// The run's transfer failed.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_FAILED ScheduleRunStatus = 3
+	// The run was skipped because the sender had insufficient funds.
+	ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED ScheduleRunStatus = 4
+)
+
+// Enum value maps for ScheduleRunStatus.
+var (
+	ScheduleRunStatus_name = map[int32]string{
+		0: "SCHEDULE_RUN_STATUS_UNSPECIFIED",
+		1: "SCHEDULE_RUN_STATUS_PENDING",
+		2: "SCHEDULE_RUN_STATUS_COMPLETED",
+		3: "SCHEDULE_RUN_STATUS_FAILED",
+		4: "SCHEDULE_RUN_STATUS_SKIPPED",
+	}
+	ScheduleRunStatus_value = map[string]int32{
+		"SCHEDULE_RUN_STATUS_UNSPECIFIED": 0,
+		"SCHEDULE_RUN_STATUS_PENDING":     1,
+		"SCHEDULE_RUN_STATUS_COMPLETED":   2,
+		"SCHEDULE_RUN_STATUS_FAILED":      3,
+		"SCHEDULE_RUN_STATUS_SKIPPED":     4,
+	}
+)
+
+func (x ScheduleRunStatus) Enum() *ScheduleRunStatus {
+	p := new(ScheduleRunStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduleRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[5].Descriptor()
+}
+
+func (ScheduleRunStatus) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[5]
+}
+
+func (x ScheduleRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleRunStatus.Descriptor instead.
+func (ScheduleRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+// BatchMode decides what happens to a batch when one of its items fails.
+type BatchMode int32
+
+const (
+	// Default unspecified value; rejected.
+	BatchMode_BATCH_MODE_UNSPECIFIED BatchMode = 0
+	// The first failed item stops the batch; paid items are clawed back and the whole
+	// reservation is returned to the sender.
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 1
+	// Every item is attempted; only failed items are returned to the sender.
+	BatchMode_BATCH_MODE_BEST_EFFORT BatchMode = 2
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_UNSPECIFIED",
+		1: "BATCH_MODE_ALL_OR_NOTHING",
+		2: "BATCH_MODE_BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_UNSPECIFIED":    0,
+		"BATCH_MODE_ALL_OR_NOTHING": 1,
+		"BATCH_MODE_BEST_EFFORT":    2,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[6].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[6]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+// BatchStatus represents the lifecycle state of a batch transfer.
+type BatchStatus int32
+
+const (
+	// Default unspecified value.
+	BatchStatus_BATCH_STATUS_UNSPECIFIED BatchStatus = 0
+	// The batch was accepted and its total is about to be reserved.
+	BatchStatus_BATCH_STATUS_PENDING BatchStatus = 1
+	// The total is reserved and items are being paid.
+	BatchStatus_BATCH_STATUS_PROCESSING BatchStatus = 2
+	// Every item was paid.
+	BatchStatus_BATCH_STATUS_COMPLETED BatchStatus = 3
+	// Some items were paid; the rest were returned to the sender.
+	BatchStatus_BATCH_STATUS_PARTIALLY_COMPLETED BatchStatus = 4
+	// No item was paid.
+	BatchStatus_BATCH_STATUS_FAILED BatchStatus = 5
+)
+
+// Enum value maps for BatchStatus.
+var (
+	BatchStatus_name = map[int32]string{
+		0: "BATCH_STATUS_UNSPECIFIED",
+		1: "BATCH_STATUS_PENDING",
+		2: "BATCH_STATUS_PROCESSING",
+		3: "BATCH_STATUS_COMPLETED",
+		4: "BATCH_STATUS_PARTIALLY_COMPLETED",
+		5: "BATCH_STATUS_FAILED",
+	}
+	BatchStatus_value = map[string]int32{
+		"BATCH_STATUS_UNSPECIFIED":         0,
+		"BATCH_STATUS_PENDING":             1,
+		"BATCH_STATUS_PROCESSING":          2,
+		"BATCH_STATUS_COMPLETED":           3,
+		"BATCH_STATUS_PARTIALLY_COMPLETED": 4,
+		"BATCH_STATUS_FAILED":              5,
+	}
+)
+
+func (x BatchStatus) Enum() *BatchStatus {
+	p := new(BatchStatus)
+	*p = x
+	return p
+}
+
+func (x BatchStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[7].Descriptor()
+}
+
+func (BatchStatus) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[7]
+}
+
+func (x BatchStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchStatus.Descriptor instead.
+func (BatchStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+// RiskOutcome is the result of screening a transfer before its debit.
+type RiskOutcome int32
+
+const (
+	// Default unspecified value.
+	RiskOutcome_RISK_OUTCOME_UNSPECIFIED RiskOutcome = 0
+	// No rule matched; the transfer proceeds.
+	RiskOutcome_RISK_OUTCOME_ALLOW RiskOutcome = 1
+	// The transfer waits in AWAITING_APPROVAL for ApproveTransfer or RejectTransfer.
+	RiskOutcome_RISK_OUTCOME_REVIEW RiskOutcome = 2
+	// The transfer failed without moving funds.
+	RiskOutcome_RISK_OUTCOME_DENY RiskOutcome = 3
+)
+
+// Enum value maps for RiskOutcome.
+var (
+	RiskOutcome_name = map[int32]string{
+		0: "RISK_OUTCOME_UNSPECIFIED",
+		1: "RISK_OUTCOME_ALLOW",
+		2: "RISK_OUTCOME_REVIEW",
+		3: "RISK_OUTCOME_DENY",
+	}
+	RiskOutcome_value = map[string]int32{
+		"RISK_OUTCOME_UNSPECIFIED": 0,
+		"RISK_OUTCOME_ALLOW":       1,
+		"RISK_OUTCOME_REVIEW":      2,
+		"RISK_OUTCOME_DENY":        3,
+	}
+)
+
+func (x RiskOutcome) Enum() *RiskOutcome {
+	p := new(RiskOutcome)
+	*p = x
+	return p
+}
+
+func (x RiskOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RiskOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[8].Descriptor()
+}
+
+func (RiskOutcome) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[8]
+}
+
+func (x RiskOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RiskOutcome.Descriptor instead.
+func (RiskOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+// WebhookEndpointStatus is whether an endpoint receives deliveries.
+type WebhookEndpointStatus int32
+
+const (
+	WebhookEndpointStatus_WEBHOOK_ENDPOINT_STATUS_UNSPECIFIED WebhookEndpointStatus = 0
+	WebhookEndpointStatus_WEBHOOK_ENDPOINT_STATUS_ACTIVE      WebhookEndpointStatus = 1
+	WebhookEndpointStatus_WEBHOOK_ENDPOINT_STATUS_DISABLED    WebhookEndpointStatus = 2
+)
+
+// Enum value maps for WebhookEndpointStatus.
+var (
+	WebhookEndpointStatus_name = map[int32]string{
+		0: "WEBHOOK_ENDPOINT_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_ENDPOINT_STATUS_ACTIVE",
+		2: "WEBHOOK_ENDPOINT_STATUS_DISABLED",
+	}
+	WebhookEndpointStatus_value = map[string]int32{
+		"WEBHOOK_ENDPOINT_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_ENDPOINT_STATUS_ACTIVE":      1,
+		"WEBHOOK_ENDPOINT_STATUS_DISABLED":    2,
+	}
+)
+
+func (x WebhookEndpointStatus) Enum() *WebhookEndpointStatus {
+	p := new(WebhookEndpointStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookEndpointStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEndpointStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[9].Descriptor()
+}
+
+func (WebhookEndpointStatus) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[9]
+}
+
+func (x WebhookEndpointStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEndpointStatus.Descriptor instead.
+func (WebhookEndpointStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+// WebhookDeliveryStatus is the progress of a webhook delivery.
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED   WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED      WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_DELIVERED",
+		3: "WEBHOOK_DELIVERY_STATUS_FAILED",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_DELIVERED":   2,
+		"WEBHOOK_DELIVERY_STATUS_FAILED":      3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_transaction_v1_transaction_proto_enumTypes[10].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_api_transaction_v1_transaction_proto_enumTypes[10]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{10}
+}
+
 // CreateTransferRequest is used to initiate a fund transfer between two accounts.
 type CreateTransferRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier for the account initiating the transfer (e.g., UUID).
-	SenderId string `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// Unique identifier for the recipient account (e.g., UUID).
-	RecipientId string `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
-	// Amount in minor units (e.g., cents). Use an integer to avoid floating point issues.
-	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Currency as an ISO 4217 code, for example "USD" or "EUR".
-	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	// Optional short memo or description attached to the transfer.
-	Memo          *string `protobuf:"bytes,5,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	// Unique identifier for the account initiating the transfer (e.g., UUID).
+	SenderId string `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// Unique identifier for the recipient account (e.g., UUID).
+	RecipientId string `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	// Amount in minor units (e.g., cents). Use an integer to avoid floating point issues.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Currency as an ISO 4217 code, for example "USD" or "EUR".
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Optional short memo or description attached to the transfer.
+	Memo *string `protobuf:"bytes,5,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	// Client-generated key that makes retries safe (e.g., a UUID). Replaying a key
+	// with the same payload returns the original transaction; replaying it with a
+	// different payload is rejected. Keys are scoped to the sender account.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Quote from FxService.QuoteFx, required when the recipient account is in a different
+	// currency. The quote's sell currency and amount must match currency and amount.
+	FxQuoteId string `protobuf:"bytes,7,opt,name=fx_quote_id,json=fxQuoteId,proto3" json:"fx_quote_id,omitempty"`
+	// Ignored: the initiator, who may not approve the transfer, is always the owner of the
+	// sender account.
+	//
+	// Deprecated: Marked as deprecated in api/transaction/v1/transaction.proto.
+	InitiatorId   string `protobuf:"bytes,8,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransferRequest) Reset() {
+	*x = CreateTransferRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferRequest) ProtoMessage() {}
+
+func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateTransferRequest) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetFxQuoteId() string {
+	if x != nil {
+		return x.FxQuoteId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in api/transaction/v1/transaction.proto.
+func (x *CreateTransferRequest) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+// CreateTransferResponse returns the created transaction identifier and initial status.
+type CreateTransferResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Server-assigned transaction ID.
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Initial status of the transaction (often PENDING).
+	Status TransactionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.v1.TransactionStatus" json:"status,omitempty"`
+	// The created transaction record (optional, may be included for convenience).
+	Transaction *Transaction `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Fee charged on top of amount, in the transfer currency.
+	Fee *FeeBreakdown `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Amount debited from the sender: amount plus the fee.
+	TotalDebit    int64 `protobuf:"varint,5,opt,name=total_debit,json=totalDebit,proto3" json:"total_debit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransferResponse) Reset() {
+	*x = CreateTransferResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferResponse) ProtoMessage() {}
+
+func (x *CreateTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferResponse.ProtoReflect.Descriptor instead.
+func (*CreateTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTransferResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CreateTransferResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_UNSPECIFIED
+}
+
+func (x *CreateTransferResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *CreateTransferResponse) GetFee() *FeeBreakdown {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *CreateTransferResponse) GetTotalDebit() int64 {
+	if x != nil {
+		return x.TotalDebit
+	}
+	return 0
+}
+
+// LimitViolation is attached as an error detail to RESOURCE_EXHAUSTED errors returned
+// when a transfer would exceed a transfer limit.
+type LimitViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ACCOUNT, USER or TIER, and the account ID, user ID or tier name of the limit.
+	Scope   string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	ScopeId string `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// PER_TRANSACTION, DAILY_AMOUNT, MONTHLY_AMOUNT or DAILY_COUNT.
+	LimitType string `protobuf:"bytes,3,opt,name=limit_type,json=limitType,proto3" json:"limit_type,omitempty"`
+	Currency  string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The cap, what was already used of it and what is left. DAILY_COUNT values count
+	// transfers; the others are amounts in minor units.
+	Limit         int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used          int64 `protobuf:"varint,6,opt,name=used,proto3" json:"used,omitempty"`
+	Remaining     int64 `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LimitViolation) Reset() {
+	*x = LimitViolation{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LimitViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitViolation) ProtoMessage() {}
+
+func (x *LimitViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitViolation.ProtoReflect.Descriptor instead.
+func (*LimitViolation) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LimitViolation) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *LimitViolation) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *LimitViolation) GetLimitType() string {
+	if x != nil {
+		return x.LimitType
+	}
+	return ""
+}
+
+func (x *LimitViolation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *LimitViolation) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LimitViolation) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *LimitViolation) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// FeeBreakdown itemises the fee charged on a transfer. total is flat + percentage +
+// adjustment, where adjustment lifts the fee to the minimum or caps it at the maximum.
+type FeeBreakdown struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Currency the fee is charged in; always the sender's currency.
+	Currency   string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Flat       int64  `protobuf:"varint,2,opt,name=flat,proto3" json:"flat,omitempty"`
+	Percentage int64  `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Adjustment int64  `protobuf:"varint,4,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+	Total      int64  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	// The fee schedule rule that applied, as "<product>/<corridor>"; empty when none did.
+	Rule          string `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeBreakdown) Reset() {
+	*x = FeeBreakdown{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeBreakdown) ProtoMessage() {}
+
+func (x *FeeBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeBreakdown.ProtoReflect.Descriptor instead.
+func (*FeeBreakdown) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeeBreakdown) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FeeBreakdown) GetFlat() int64 {
+	if x != nil {
+		return x.Flat
+	}
+	return 0
+}
+
+func (x *FeeBreakdown) GetPercentage() int64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *FeeBreakdown) GetAdjustment() int64 {
+	if x != nil {
+		return x.Adjustment
+	}
+	return 0
+}
+
+func (x *FeeBreakdown) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FeeBreakdown) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+// QuoteTransferRequest prices a transfer without creating it.
+type QuoteTransferRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Amount to send in minor units.
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Sender currency as an ISO 4217 code.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Recipient currency; defaults to currency.
+	RecipientCurrency string `protobuf:"bytes,3,opt,name=recipient_currency,json=recipientCurrency,proto3" json:"recipient_currency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QuoteTransferRequest) Reset() {
+	*x = QuoteTransferRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteTransferRequest) ProtoMessage() {}
+
+func (x *QuoteTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteTransferRequest.ProtoReflect.Descriptor instead.
+func (*QuoteTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QuoteTransferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QuoteTransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *QuoteTransferRequest) GetRecipientCurrency() string {
+	if x != nil {
+		return x.RecipientCurrency
+	}
+	return ""
+}
+
+// QuoteTransferResponse returns the fee a matching CreateTransfer would charge.
+type QuoteTransferResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Fee   *FeeBreakdown          `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Amount that would be debited from the sender: amount plus the fee.
+	TotalDebit    int64 `protobuf:"varint,2,opt,name=total_debit,json=totalDebit,proto3" json:"total_debit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteTransferResponse) Reset() {
+	*x = QuoteTransferResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteTransferResponse) ProtoMessage() {}
+
+func (x *QuoteTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteTransferResponse.ProtoReflect.Descriptor instead.
+func (*QuoteTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QuoteTransferResponse) GetFee() *FeeBreakdown {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *QuoteTransferResponse) GetTotalDebit() int64 {
+	if x != nil {
+		return x.TotalDebit
+	}
+	return 0
+}
+
+// GetTransactionStatusRequest is used to query a transaction by its ID.
+type GetTransactionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionStatusRequest) Reset() {
+	*x = GetTransactionStatusRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionStatusRequest) ProtoMessage() {}
+
+func (x *GetTransactionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTransactionStatusRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+// GetTransactionStatusResponse returns the current status and optional details.
+type GetTransactionStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Status        TransactionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.v1.TransactionStatus" json:"status,omitempty"`
+	// When the transaction failed, a human-readable reason may be provided.
+	FailureReason string `protobuf:"bytes,3,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// Full transaction details (optional convenience field).
+	Transaction   *Transaction `protobuf:"bytes,4,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionStatusResponse) Reset() {
+	*x = GetTransactionStatusResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionStatusResponse) ProtoMessage() {}
+
+func (x *GetTransactionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTransactionStatusResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *GetTransactionStatusResponse) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_UNSPECIFIED
+}
+
+func (x *GetTransactionStatusResponse) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *GetTransactionStatusResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// Transaction is a record representing a transfer between two accounts.
+type Transaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	SenderId      string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId   string                 `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status        TransactionStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=transaction.v1.TransactionStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Memo          *string                `protobuf:"bytes,9,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	Type          TransactionType        `protobuf:"varint,10,opt,name=type,proto3,enum=transaction.v1.TransactionType" json:"type,omitempty"`
+	// For refunds and reversals, the transfer being undone.
+	OriginalTransactionId string `protobuf:"bytes,11,opt,name=original_transaction_id,json=originalTransactionId,proto3" json:"original_transaction_id,omitempty"`
+	// Total amount refunded or reversed so far (transfers only).
+	RefundedAmount int64 `protobuf:"varint,12,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	// For cross-currency transfers, the amount credited to the recipient and its currency.
+	CounterAmount   int64  `protobuf:"varint,13,opt,name=counter_amount,json=counterAmount,proto3" json:"counter_amount,omitempty"`
+	CounterCurrency string `protobuf:"bytes,14,opt,name=counter_currency,json=counterCurrency,proto3" json:"counter_currency,omitempty"`
+	// For cross-currency transfers, the applied rate.
+	FxRate string `protobuf:"bytes,15,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
+	// Fee charged to the sender on top of amount, in currency.
+	FeeAmount int64 `protobuf:"varint,16,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	// User who initiated the transfer, and the approver who decided it if approval was required.
+	InitiatorId   string `protobuf:"bytes,17,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	ApproverId    string `protobuf:"bytes,18,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Transaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Transaction) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *Transaction) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *Transaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Transaction) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_UNSPECIFIED
+}
+
+func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *Transaction) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *Transaction) GetOriginalTransactionId() string {
+	if x != nil {
+		return x.OriginalTransactionId
+	}
+	return ""
+}
+
+func (x *Transaction) GetRefundedAmount() int64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetCounterAmount() int64 {
+	if x != nil {
+		return x.CounterAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetCounterCurrency() string {
+	if x != nil {
+		return x.CounterCurrency
+	}
+	return ""
+}
+
+func (x *Transaction) GetFxRate() string {
+	if x != nil {
+		return x.FxRate
+	}
+	return ""
+}
+
+func (x *Transaction) GetFeeAmount() int64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *Transaction) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *Transaction) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+// ReverseTransferRequest reverses everything not yet refunded on a completed transfer.
+type ReverseTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Human-readable reason recorded on the counter-transaction.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Client-generated key that makes retries safe. Scoped to the original transaction.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReverseTransferRequest) Reset() {
+	*x = ReverseTransferRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseTransferRequest) ProtoMessage() {}
+
+func (x *ReverseTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseTransferRequest.ProtoReflect.Descriptor instead.
+func (*ReverseTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReverseTransferRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ReverseTransferRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReverseTransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// ReverseTransferResponse returns the counter-transaction created for the reversal.
+type ReverseTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reversal      *Transaction           `protobuf:"bytes,1,opt,name=reversal,proto3" json:"reversal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseTransferResponse) Reset() {
+	*x = ReverseTransferResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseTransferResponse) ProtoMessage() {}
+
+func (x *ReverseTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseTransferResponse.ProtoReflect.Descriptor instead.
+func (*ReverseTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReverseTransferResponse) GetReversal() *Transaction {
+	if x != nil {
+		return x.Reversal
+	}
+	return nil
+}
+
+// RefundTransferRequest refunds part or all of a completed transfer.
+type RefundTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Amount to refund in minor units. Must not exceed the amount still refundable.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Human-readable reason recorded on the counter-transaction.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Client-generated key that makes retries safe. Scoped to the original transaction.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundTransferRequest) Reset() {
+	*x = RefundTransferRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTransferRequest) ProtoMessage() {}
+
+func (x *RefundTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTransferRequest.ProtoReflect.Descriptor instead.
+func (*RefundTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RefundTransferRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RefundTransferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundTransferRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundTransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// RefundTransferResponse returns the counter-transaction created for the refund.
+type RefundTransferResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Refund *Transaction           `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	// Amount that can still be refunded after this refund.
+	RemainingRefundable int64 `protobuf:"varint,2,opt,name=remaining_refundable,json=remainingRefundable,proto3" json:"remaining_refundable,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RefundTransferResponse) Reset() {
+	*x = RefundTransferResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTransferResponse) ProtoMessage() {}
+
+func (x *RefundTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTransferResponse.ProtoReflect.Descriptor instead.
+func (*RefundTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefundTransferResponse) GetRefund() *Transaction {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *RefundTransferResponse) GetRemainingRefundable() int64 {
+	if x != nil {
+		return x.RemainingRefundable
+	}
+	return 0
+}
+
+// ListTransactionsRequest filters an account's transactions, newest first.
+type ListTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Account whose statement is listed; matches either the sender or the recipient.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Optional status filter. UNSPECIFIED returns every status.
+	Status TransactionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.v1.TransactionStatus" json:"status,omitempty"`
+	// Optional ISO 4217 currency filter.
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Optional lower bound on created_at (inclusive).
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Optional upper bound on created_at (exclusive).
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// Maximum number of transactions to return. Defaults to 50, capped at 200.
+	PageSize int32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque cursor from a previous response's next_page_token.
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListTransactionsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_UNSPECIFIED
+}
+
+func (x *ListTransactionsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListTransactionsResponse returns one page of transactions.
+type ListTransactionsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Transactions []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// Cursor for the next page; empty when there are no more results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsResponse) ProtoMessage() {}
+
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Hold reserves funds on an account without moving them.
+type Hold struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	HoldId    string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	AccountId string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Reserved amount in minor units.
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Amount captured, set once the hold is CAPTURED.
+	CapturedAmount int64      `protobuf:"varint,4,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"`
+	Currency       string     `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status         HoldStatus `protobuf:"varint,6,opt,name=status,proto3,enum=transaction.v1.HoldStatus" json:"status,omitempty"`
+	// Caller-supplied reference, for example a card authorization code.
+	Reference string `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`
+	// The transaction created when the hold was captured.
+	CaptureTransactionId string                 `protobuf:"bytes,8,opt,name=capture_transaction_id,json=captureTransactionId,proto3" json:"capture_transaction_id,omitempty"`
+	ExpiresAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Hold) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *Hold) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Hold) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Hold) GetCapturedAmount() int64 {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return 0
+}
+
+func (x *Hold) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Hold) GetStatus() HoldStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HoldStatus_HOLD_STATUS_UNSPECIFIED
+}
+
+func (x *Hold) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Hold) GetCaptureTransactionId() string {
+	if x != nil {
+		return x.CaptureTransactionId
+	}
+	return ""
+}
+
+func (x *Hold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Hold) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AuthorizeHoldRequest reserves funds on an account.
+type AuthorizeHoldRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Amount to reserve in minor units.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Must match the account currency.
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Caller-supplied reference, for example a card authorization code.
+	Reference string `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Seconds until the hold expires and is released automatically. Defaults to 7 days.
+	TtlSeconds    int64 `protobuf:"varint,5,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeHoldRequest) Reset() {
+	*x = AuthorizeHoldRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeHoldRequest) ProtoMessage() {}
+
+func (x *AuthorizeHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeHoldRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeHoldRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AuthorizeHoldRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AuthorizeHoldRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AuthorizeHoldRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AuthorizeHoldRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *AuthorizeHoldRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+// AuthorizeHoldResponse returns the hold and the account's remaining available balance.
+type AuthorizeHoldResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Hold             *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	AvailableBalance int64                  `protobuf:"varint,2,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AuthorizeHoldResponse) Reset() {
+	*x = AuthorizeHoldResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeHoldResponse) ProtoMessage() {}
+
+func (x *AuthorizeHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeHoldResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeHoldResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuthorizeHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *AuthorizeHoldResponse) GetAvailableBalance() int64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+// CaptureHoldRequest moves reserved funds to a recipient.
+type CaptureHoldRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	HoldId      string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	RecipientId string                 `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	// Amount to capture; zero captures the full hold. Any remainder is released.
+	Amount        int64   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo          *string `protobuf:"bytes,4,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureHoldRequest) Reset() {
+	*x = CaptureHoldRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldRequest) ProtoMessage() {}
+
+func (x *CaptureHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldRequest.ProtoReflect.Descriptor instead.
+func (*CaptureHoldRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CaptureHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CaptureHoldRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+// CaptureHoldResponse returns the captured hold and the resulting transaction.
+type CaptureHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureHoldResponse) Reset() {
+	*x = CaptureHoldResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldResponse) ProtoMessage() {}
+
+func (x *CaptureHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldResponse.ProtoReflect.Descriptor instead.
+func (*CaptureHoldResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CaptureHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *CaptureHoldResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// VoidHoldRequest releases a hold without moving funds.
+type VoidHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidHoldRequest) Reset() {
+	*x = VoidHoldRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidHoldRequest) ProtoMessage() {}
+
+func (x *VoidHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidHoldRequest.ProtoReflect.Descriptor instead.
+func (*VoidHoldRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *VoidHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+// VoidHoldResponse returns the voided hold.
+type VoidHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidHoldResponse) Reset() {
+	*x = VoidHoldResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidHoldResponse) ProtoMessage() {}
+
+func (x *VoidHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidHoldResponse.ProtoReflect.Descriptor instead.
+func (*VoidHoldResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VoidHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// TransferSchedule is a standing order that repeats a transfer.
+type TransferSchedule struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId  string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	SenderId    string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId string                 `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Amount      int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency    string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Memo        *string                `protobuf:"bytes,6,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	// Standard five-field cron expression, for example "0 9 1 * *" for 09:00 on the 1st.
+	CronExpression string `protobuf:"bytes,7,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// IANA time zone the cron expression is evaluated in; UTC when empty.
+	TimeZone string                 `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	StartAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// Maximum number of runs; zero means no limit.
+	MaxRuns int32 `protobuf:"varint,11,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	// Number of runs so far, including skipped and failed ones.
+	RunCount            int32                   `protobuf:"varint,12,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	OnInsufficientFunds InsufficientFundsPolicy `protobuf:"varint,13,opt,name=on_insufficient_funds,json=onInsufficientFunds,proto3,enum=transaction.v1.InsufficientFundsPolicy" json:"on_insufficient_funds,omitempty"`
+	Status              ScheduleStatus          `protobuf:"varint,14,opt,name=status,proto3,enum=transaction.v1.ScheduleStatus" json:"status,omitempty"`
+	CreatedAt           *timestamppb.Timestamp  `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TransferSchedule) Reset() {
+	*x = TransferSchedule{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferSchedule) ProtoMessage() {}
+
+func (x *TransferSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferSchedule.ProtoReflect.Descriptor instead.
+func (*TransferSchedule) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TransferSchedule) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *TransferSchedule) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *TransferSchedule) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *TransferSchedule) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferSchedule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransferSchedule) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *TransferSchedule) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *TransferSchedule) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *TransferSchedule) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *TransferSchedule) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *TransferSchedule) GetMaxRuns() int32 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+func (x *TransferSchedule) GetRunCount() int32 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *TransferSchedule) GetOnInsufficientFunds() InsufficientFundsPolicy {
+	if x != nil {
+		return x.OnInsufficientFunds
+	}
+	return InsufficientFundsPolicy_INSUFFICIENT_FUNDS_POLICY_UNSPECIFIED
+}
+
+func (x *TransferSchedule) GetStatus() ScheduleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED
+}
+
+func (x *TransferSchedule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ScheduleRun is one firing of a TransferSchedule.
+type ScheduleRun struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RunId      string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	ScheduleId string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Transaction of the latest attempt.
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Status        ScheduleRunStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=transaction.v1.ScheduleRunStatus" json:"status,omitempty"`
+	Attempts      int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	FailureReason string                 `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRun) Reset() {
+	*x = ScheduleRun{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRun) ProtoMessage() {}
+
+func (x *ScheduleRun) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRun.ProtoReflect.Descriptor instead.
+func (*ScheduleRun) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScheduleRun) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetStatus() ScheduleRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduleRunStatus_SCHEDULE_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduleRun) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ScheduleRun) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateScheduledTransferRequest sets up a recurring transfer between two accounts
+// that share a currency.
+type CreateScheduledTransferRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SenderId    string                 `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId string                 `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	// Amount in minor units moved on every run.
+	Amount         int64   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string  `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Memo           *string `protobuf:"bytes,5,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	CronExpression string  `protobuf:"bytes,6,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	TimeZone       string  `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Optional first and last instants the schedule may fire.
+	StartAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// Maximum number of runs; zero means no limit.
+	MaxRuns             int32                   `protobuf:"varint,10,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+	OnInsufficientFunds InsufficientFundsPolicy `protobuf:"varint,11,opt,name=on_insufficient_funds,json=onInsufficientFunds,proto3,enum=transaction.v1.InsufficientFundsPolicy" json:"on_insufficient_funds,omitempty"`
+	// Client-generated key that makes retries safe. Scoped to the sender account.
+	IdempotencyKey string `protobuf:"bytes,12,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateScheduledTransferRequest) Reset() {
+	*x = CreateScheduledTransferRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduledTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledTransferRequest) ProtoMessage() {}
+
+func (x *CreateScheduledTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduledTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateScheduledTransferRequest) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateScheduledTransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateScheduledTransferRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *CreateScheduledTransferRequest) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *CreateScheduledTransferRequest) GetMaxRuns() int32 {
+	if x != nil {
+		return x.MaxRuns
+	}
+	return 0
+}
+
+func (x *CreateScheduledTransferRequest) GetOnInsufficientFunds() InsufficientFundsPolicy {
+	if x != nil {
+		return x.OnInsufficientFunds
+	}
+	return InsufficientFundsPolicy_INSUFFICIENT_FUNDS_POLICY_UNSPECIFIED
+}
+
+func (x *CreateScheduledTransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// CreateScheduledTransferResponse returns the created schedule.
+type CreateScheduledTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *TransferSchedule      `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduledTransferResponse) Reset() {
+	*x = CreateScheduledTransferResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduledTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduledTransferResponse) ProtoMessage() {}
+
+func (x *CreateScheduledTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduledTransferResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduledTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateScheduledTransferResponse) GetSchedule() *TransferSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// ListSchedulesRequest lists the schedules of a sender account.
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      string                 `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListSchedulesRequest) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+// ListSchedulesResponse returns the sender's schedules, newest first.
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*TransferSchedule    `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*TransferSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+// CancelScheduleRequest stops a schedule. Runs already in progress finish.
+type CancelScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduleRequest) Reset() {
+	*x = CancelScheduleRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduleRequest) ProtoMessage() {}
+
+func (x *CancelScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CancelScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+// CancelScheduleResponse returns the cancelled schedule.
+type CancelScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *TransferSchedule      `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduleResponse) Reset() {
+	*x = CancelScheduleResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduleResponse) ProtoMessage() {}
+
+func (x *CancelScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CancelScheduleResponse) GetSchedule() *TransferSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// ListScheduleRunsRequest lists the run history of a schedule, newest first.
+type ListScheduleRunsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Maximum number of runs to return. Defaults to 50, capped at 200.
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleRunsRequest) Reset() {
+	*x = ListScheduleRunsRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleRunsRequest) ProtoMessage() {}
+
+func (x *ListScheduleRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduleRunsRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListScheduleRunsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ListScheduleRunsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListScheduleRunsResponse returns the schedule's runs.
+type ListScheduleRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*ScheduleRun         `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleRunsResponse) Reset() {
+	*x = ListScheduleRunsResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleRunsResponse) ProtoMessage() {}
+
+func (x *ListScheduleRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduleRunsResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListScheduleRunsResponse) GetRuns() []*ScheduleRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+// BatchTransferItem is one payout requested in a batch.
+type BatchTransferItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RecipientId string                 `protobuf:"bytes,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	// Amount in minor units.
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Caller reference such as an employee number; stored as the item transaction's memo.
+	Reference     string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTransferItem) Reset() {
+	*x = BatchTransferItem{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTransferItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransferItem) ProtoMessage() {}
+
+func (x *BatchTransferItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransferItem.ProtoReflect.Descriptor instead.
+func (*BatchTransferItem) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BatchTransferItem) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *BatchTransferItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BatchTransferItem) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+// BatchItem is one payout of a batch with the status of its transaction.
+type BatchItem struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ItemId string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// Position of the item in the request, starting at 1.
+	Seq           int32  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	RecipientId   string `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Amount        int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeAmount     int64  `protobuf:"varint,5,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	Reference     string `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	TransactionId string `protobuf:"bytes,7,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// PENDING until paid, then COMPLETED; FAILED if it could not be paid or the batch
+	// stopped first; REVERSED if it was clawed back from an ALL_OR_NOTHING batch.
+	Status        TransactionStatus `protobuf:"varint,8,opt,name=status,proto3,enum=transaction.v1.TransactionStatus" json:"status,omitempty"`
+	FailureReason string            `protobuf:"bytes,9,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItem) Reset() {
+	*x = BatchItem{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItem) ProtoMessage() {}
+
+func (x *BatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItem.ProtoReflect.Descriptor instead.
+func (*BatchItem) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *BatchItem) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *BatchItem) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *BatchItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BatchItem) GetFeeAmount() int64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *BatchItem) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *BatchItem) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *BatchItem) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_UNSPECIFIED
+}
+
+func (x *BatchItem) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+// Batch summarises a batch transfer.
+type Batch struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BatchId   string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	SenderId  string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Currency  string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Mode      BatchMode              `protobuf:"varint,4,opt,name=mode,proto3,enum=transaction.v1.BatchMode" json:"mode,omitempty"`
+	Status    BatchStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=transaction.v1.BatchStatus" json:"status,omitempty"`
+	ItemCount int32                  `protobuf:"varint,6,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	// Sum of the item amounts, and of their fees, reserved from the sender.
+	TotalAmount int64 `protobuf:"varint,7,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalFee    int64 `protobuf:"varint,8,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`
+	// Items by current status.
+	PendingCount   int32                  `protobuf:"varint,9,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	CompletedCount int32                  `protobuf:"varint,10,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	FailedCount    int32                  `protobuf:"varint,11,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	ReversedCount  int32                  `protobuf:"varint,12,opt,name=reversed_count,json=reversedCount,proto3" json:"reversed_count,omitempty"`
+	FailureReason  string                 `protobuf:"bytes,13,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Batch) Reset() {
+	*x = Batch{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch) ProtoMessage() {}
+
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Batch) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *Batch) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *Batch) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Batch) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+func (x *Batch) GetStatus() BatchStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BatchStatus_BATCH_STATUS_UNSPECIFIED
+}
+
+func (x *Batch) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Batch) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *Batch) GetTotalFee() int64 {
+	if x != nil {
+		return x.TotalFee
+	}
+	return 0
+}
+
+func (x *Batch) GetPendingCount() int32 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *Batch) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *Batch) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *Batch) GetReversedCount() int32 {
+	if x != nil {
+		return x.ReversedCount
+	}
+	return 0
+}
+
+func (x *Batch) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Batch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Batch) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateBatchTransferRequest pays many recipients from one sender. Every item is
+// validated before anything moves; one invalid item rejects the whole request.
+type CreateBatchTransferRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SenderId string                 `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// Currency of the sender and every recipient.
+	Currency string    `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Mode     BatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=transaction.v1.BatchMode" json:"mode,omitempty"`
+	// Between 1 and 5000 items.
+	Items []*BatchTransferItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	// Client-generated key that makes retries safe. Scoped to the sender account.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateBatchTransferRequest) Reset() {
+	*x = CreateBatchTransferRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBatchTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBatchTransferRequest) ProtoMessage() {}
+
+func (x *CreateBatchTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBatchTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateBatchTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateBatchTransferRequest) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *CreateBatchTransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateBatchTransferRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+func (x *CreateBatchTransferRequest) GetItems() []*BatchTransferItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateBatchTransferRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// CreateBatchTransferResponse returns the accepted batch.
+type CreateBatchTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *Batch                 `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBatchTransferResponse) Reset() {
+	*x = CreateBatchTransferResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBatchTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBatchTransferResponse) ProtoMessage() {}
+
+func (x *CreateBatchTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBatchTransferResponse.ProtoReflect.Descriptor instead.
+func (*CreateBatchTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateBatchTransferResponse) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+// GetBatchTransferRequest returns a batch and a page of its items.
+type GetBatchTransferRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	BatchId string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// Maximum number of items to return. Defaults to 50, capped at 200.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque cursor from a previous response's next_page_token.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBatchTransferRequest) Reset() {
+	*x = GetBatchTransferRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBatchTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchTransferRequest) ProtoMessage() {}
+
+func (x *GetBatchTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetBatchTransferRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *GetBatchTransferRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetBatchTransferRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// GetBatchTransferResponse returns the batch summary and one page of items in order.
+type GetBatchTransferResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Batch *Batch                 `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	Items []*BatchItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// Cursor for the next page; empty when there are no more items.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBatchTransferResponse) Reset() {
+	*x = GetBatchTransferResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBatchTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchTransferResponse) ProtoMessage() {}
+
+func (x *GetBatchTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetBatchTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetBatchTransferResponse) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *GetBatchTransferResponse) GetItems() []*BatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetBatchTransferResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ApproveTransferRequest releases a transfer that is AWAITING_APPROVAL.
+type ApproveTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Must differ from the transfer's initiator.
+	ApproverId    string `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTransferRequest) Reset() {
+	*x = ApproveTransferRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTransferRequest) ProtoMessage() {}
+
+func (x *ApproveTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTransferRequest.ProtoReflect.Descriptor instead.
+func (*ApproveTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ApproveTransferRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ApproveTransferRequest) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+// ApproveTransferResponse returns the transfer. The decision is applied asynchronously,
+// so the status may still read AWAITING_APPROVAL.
+type ApproveTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTransferResponse) Reset() {
+	*x = ApproveTransferResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTransferResponse) ProtoMessage() {}
+
+func (x *ApproveTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTransferResponse.ProtoReflect.Descriptor instead.
+func (*ApproveTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ApproveTransferResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// RejectTransferRequest rejects a transfer that is AWAITING_APPROVAL.
+type RejectTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// Must differ from the transfer's initiator.
+	ApproverId string `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	// Human-readable reason recorded as the failure reason.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTransferRequest) Reset() {
+	*x = RejectTransferRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTransferRequest) ProtoMessage() {}
+
+func (x *RejectTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTransferRequest.ProtoReflect.Descriptor instead.
+func (*RejectTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RejectTransferRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RejectTransferRequest) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *RejectTransferRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RejectTransferResponse returns the transfer. The decision is applied asynchronously,
+// so the status may still read AWAITING_APPROVAL.
+type RejectTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTransferResponse) Reset() {
+	*x = RejectTransferResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTransferResponse) ProtoMessage() {}
+
+func (x *RejectTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTransferResponse.ProtoReflect.Descriptor instead.
+func (*RejectTransferResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RejectTransferResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// RiskRuleHit is a risk rule that matched a transfer.
+type RiskRuleHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rule  string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// REVIEW or DENY.
+	Action        string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskRuleHit) Reset() {
+	*x = RiskRuleHit{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskRuleHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskRuleHit) ProtoMessage() {}
+
+func (x *RiskRuleHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskRuleHit.ProtoReflect.Descriptor instead.
+func (*RiskRuleHit) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RiskRuleHit) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RiskRuleHit) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RiskRuleHit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// GetRiskDecisionRequest is used to fetch the screening decision of a transfer.
+type GetRiskDecisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiskDecisionRequest) Reset() {
+	*x = GetRiskDecisionRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskDecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskDecisionRequest) ProtoMessage() {}
+
+func (x *GetRiskDecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskDecisionRequest.ProtoReflect.Descriptor instead.
+func (*GetRiskDecisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetRiskDecisionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+// GetRiskDecisionResponse returns the screening decision and the rules that matched,
+// most severe first.
+type GetRiskDecisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Outcome       RiskOutcome            `protobuf:"varint,2,opt,name=outcome,proto3,enum=transaction.v1.RiskOutcome" json:"outcome,omitempty"`
+	Hits          []*RiskRuleHit         `protobuf:"bytes,3,rep,name=hits,proto3" json:"hits,omitempty"`
+	ScreenedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=screened_at,json=screenedAt,proto3" json:"screened_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRiskDecisionResponse) Reset() {
+	*x = GetRiskDecisionResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRiskDecisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRiskDecisionResponse) ProtoMessage() {}
+
+func (x *GetRiskDecisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRiskDecisionResponse.ProtoReflect.Descriptor instead.
+func (*GetRiskDecisionResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRiskDecisionResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *GetRiskDecisionResponse) GetOutcome() RiskOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return RiskOutcome_RISK_OUTCOME_UNSPECIFIED
+}
+
+func (x *GetRiskDecisionResponse) GetHits() []*RiskRuleHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *GetRiskDecisionResponse) GetScreenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScreenedAt
+	}
+	return nil
+}
+
+// ScreeningHit is a sanctions list entry that matched a party of a transfer.
+type ScreeningHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SENDER or RECIPIENT.
+	Party      string `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	AccountId  string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	HolderName string `protobuf:"bytes,3,opt,name=holder_name,json=holderName,proto3" json:"holder_name,omitempty"`
+	// UID and primary name of the watchlist entry, and the name or alias that matched.
+	EntryUid    string `protobuf:"bytes,4,opt,name=entry_uid,json=entryUid,proto3" json:"entry_uid,omitempty"`
+	EntryName   string `protobuf:"bytes,5,opt,name=entry_name,json=entryName,proto3" json:"entry_name,omitempty"`
+	MatchedName string `protobuf:"bytes,6,opt,name=matched_name,json=matchedName,proto3" json:"matched_name,omitempty"`
+	// Similarity from 0 to 1.
+	Score         float64                `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	ScreenedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=screened_at,json=screenedAt,proto3" json:"screened_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreeningHit) Reset() {
+	*x = ScreeningHit{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreeningHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningHit) ProtoMessage() {}
+
+func (x *ScreeningHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningHit.ProtoReflect.Descriptor instead.
+func (*ScreeningHit) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ScreeningHit) GetParty() string {
+	if x != nil {
+		return x.Party
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetHolderName() string {
+	if x != nil {
+		return x.HolderName
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetEntryUid() string {
+	if x != nil {
+		return x.EntryUid
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetEntryName() string {
+	if x != nil {
+		return x.EntryName
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetMatchedName() string {
+	if x != nil {
+		return x.MatchedName
+	}
+	return ""
+}
+
+func (x *ScreeningHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScreeningHit) GetScreenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScreenedAt
+	}
+	return nil
+}
+
+// ListScreeningHitsRequest is used to fetch the sanctions hits of a transfer.
+type ListScreeningHitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScreeningHitsRequest) Reset() {
+	*x = ListScreeningHitsRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScreeningHitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScreeningHitsRequest) ProtoMessage() {}
+
+func (x *ListScreeningHitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScreeningHitsRequest.ProtoReflect.Descriptor instead.
+func (*ListScreeningHitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListScreeningHitsRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+// ListScreeningHitsResponse returns the sanctions hits of a transfer; empty when it was clear.
+type ListScreeningHitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*ScreeningHit        `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScreeningHitsResponse) Reset() {
+	*x = ListScreeningHitsResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScreeningHitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScreeningHitsResponse) ProtoMessage() {}
+
+func (x *ListScreeningHitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScreeningHitsResponse.ProtoReflect.Descriptor instead.
+func (*ListScreeningHitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListScreeningHitsResponse) GetHits() []*ScreeningHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// WebhookEndpoint is a merchant URL that receives signed transaction events.
+type WebhookEndpoint struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EndpointId string                 `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	MerchantId string                 `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Url        string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Event types sent to the endpoint, such as "transfer.completed"; empty means all.
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Status        WebhookEndpointStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=transaction.v1.WebhookEndpointStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEndpoint) Reset() {
+	*x = WebhookEndpoint{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpoint) ProtoMessage() {}
+
+func (x *WebhookEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpoint.ProtoReflect.Descriptor instead.
+func (*WebhookEndpoint) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WebhookEndpoint) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookEndpoint) GetStatus() WebhookEndpointStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookEndpointStatus_WEBHOOK_ENDPOINT_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookEndpoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateWebhookEndpointRequest registers an endpoint for a merchant.
+type CreateWebhookEndpointRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User that owns the accounts whose events are sent.
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	// HTTPS URL the events are POSTed to.
+	Url           string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookEndpointRequest) Reset() {
+	*x = CreateWebhookEndpointRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookEndpointRequest) ProtoMessage() {}
+
+func (x *CreateWebhookEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookEndpointRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateWebhookEndpointRequest) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+func (x *CreateWebhookEndpointRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookEndpointRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+// CreateWebhookEndpointResponse returns the endpoint and its signing secret. The secret
+// is only returned here.
+type CreateWebhookEndpointResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint *WebhookEndpoint       `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Key of the HMAC-SHA256 in the Webhook-Signature header of every delivery.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookEndpointResponse) Reset() {
+	*x = CreateWebhookEndpointResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookEndpointResponse) ProtoMessage() {}
+
+func (x *CreateWebhookEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookEndpointResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateWebhookEndpointResponse) GetEndpoint() *WebhookEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *CreateWebhookEndpointResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// ListWebhookEndpointsRequest is used to fetch a merchant's endpoints.
+type ListWebhookEndpointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    string                 `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookEndpointsRequest) Reset() {
+	*x = ListWebhookEndpointsRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookEndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookEndpointsRequest) ProtoMessage() {}
+
+func (x *ListWebhookEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListWebhookEndpointsRequest) GetMerchantId() string {
+	if x != nil {
+		return x.MerchantId
+	}
+	return ""
+}
+
+// ListWebhookEndpointsResponse returns the merchant's endpoints.
+type ListWebhookEndpointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []*WebhookEndpoint     `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookEndpointsResponse) Reset() {
+	*x = ListWebhookEndpointsResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookEndpointsResponse) ProtoMessage() {}
+
+func (x *ListWebhookEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListWebhookEndpointsResponse) GetEndpoints() []*WebhookEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+// DisableWebhookEndpointRequest stops deliveries to an endpoint.
+type DisableWebhookEndpointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EndpointId    string                 `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableWebhookEndpointRequest) Reset() {
+	*x = DisableWebhookEndpointRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableWebhookEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableWebhookEndpointRequest) ProtoMessage() {}
+
+func (x *DisableWebhookEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableWebhookEndpointRequest.ProtoReflect.Descriptor instead.
+func (*DisableWebhookEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DisableWebhookEndpointRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+// DisableWebhookEndpointResponse returns the disabled endpoint.
+type DisableWebhookEndpointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      *WebhookEndpoint       `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTransferRequest) Reset() {
-	*x = CreateTransferRequest{}
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[0]
+func (x *DisableWebhookEndpointResponse) Reset() {
+	*x = DisableWebhookEndpointResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableWebhookEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableWebhookEndpointResponse) ProtoMessage() {}
+
+func (x *DisableWebhookEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableWebhookEndpointResponse.ProtoReflect.Descriptor instead.
+func (*DisableWebhookEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DisableWebhookEndpointResponse) GetEndpoint() *WebhookEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+// WebhookDelivery is one event sent, or being sent, to one endpoint.
+type WebhookDelivery struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	EndpointId string                 `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	EventId    string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType  string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// JSON body POSTed to the endpoint.
+	Payload  string                `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status   WebhookDeliveryStatus `protobuf:"varint,6,opt,name=status,proto3,enum=transaction.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts int32                 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// HTTP status of the latest attempt; zero when no response was received.
+	LastStatusCode int32                  `protobuf:"varint,8,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Replays        int32                  `protobuf:"varint,10,opt,name=replays,proto3" json:"replays,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=delivered_at,json=deliveredAt,proto3,oneof" json:"delivered_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTransferRequest) String() string {
+func (x *WebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransferRequest) ProtoMessage() {}
+func (*WebhookDelivery) ProtoMessage() {}
 
-func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[0]
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -125,133 +4644,120 @@ func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransferRequest.ProtoReflect.Descriptor instead.
-func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
-	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateTransferRequest) GetSenderId() string {
+func (x *WebhookDelivery) GetDeliveryId() string {
 	if x != nil {
-		return x.SenderId
+		return x.DeliveryId
 	}
 	return ""
 }
 
-func (x *CreateTransferRequest) GetRecipientId() string {
+func (x *WebhookDelivery) GetEndpointId() string {
 	if x != nil {
-		return x.RecipientId
+		return x.EndpointId
 	}
 	return ""
 }
 
-func (x *CreateTransferRequest) GetAmount() int64 {
+func (x *WebhookDelivery) GetEventId() string {
 	if x != nil {
-		return x.Amount
+		return x.EventId
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateTransferRequest) GetCurrency() string {
+func (x *WebhookDelivery) GetEventType() string {
 	if x != nil {
-		return x.Currency
+		return x.EventType
 	}
 	return ""
 }
 
-func (x *CreateTransferRequest) GetMemo() string {
-	if x != nil && x.Memo != nil {
-		return *x.Memo
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
 	}
 	return ""
 }
 
-// CreateTransferResponse returns the created transaction identifier and initial status.
-type CreateTransferResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Server-assigned transaction ID.
-	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	// Initial status of the transaction (often PENDING).
-	Status TransactionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.v1.TransactionStatus" json:"status,omitempty"`
-	// The created transaction record (optional, may be included for convenience).
-	Transaction   *Transaction `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateTransferResponse) Reset() {
-	*x = CreateTransferResponse{}
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
 }
 
-func (x *CreateTransferResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
 }
 
-func (*CreateTransferResponse) ProtoMessage() {}
-
-func (x *CreateTransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[1]
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastStatusCode
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateTransferResponse.ProtoReflect.Descriptor instead.
-func (*CreateTransferResponse) Descriptor() ([]byte, []int) {
-	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{1}
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
 }
 
-func (x *CreateTransferResponse) GetTransactionId() string {
+func (x *WebhookDelivery) GetReplays() int32 {
 	if x != nil {
-		return x.TransactionId
+		return x.Replays
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateTransferResponse) GetStatus() TransactionStatus {
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.CreatedAt
 	}
-	return TransactionStatus_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateTransferResponse) GetTransaction() *Transaction {
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Transaction
+		return x.DeliveredAt
 	}
 	return nil
 }
 
-// GetTransactionStatusRequest is used to query a transaction by its ID.
-type GetTransactionStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+// ListWebhookDeliveriesRequest is used to fetch the delivery log of an endpoint.
+type ListWebhookDeliveriesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EndpointId string                 `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	// Maximum number of deliveries to return, newest first. Defaults to 50, capped at 200.
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionStatusRequest) Reset() {
-	*x = GetTransactionStatusRequest{}
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[2]
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionStatusRequest) String() string {
+func (x *ListWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionStatusRequest) ProtoMessage() {}
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *GetTransactionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[2]
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,46 +4768,48 @@ func (x *GetTransactionStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetTransactionStatusRequest) GetTransactionId() string {
+func (x *ListWebhookDeliveriesRequest) GetEndpointId() string {
 	if x != nil {
-		return x.TransactionId
+		return x.EndpointId
 	}
 	return ""
 }
 
-// GetTransactionStatusResponse returns the current status and optional details.
-type GetTransactionStatusResponse struct {
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListWebhookDeliveriesResponse returns the endpoint's deliveries.
+type ListWebhookDeliveriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Status        TransactionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.v1.TransactionStatus" json:"status,omitempty"`
-	// When the transaction failed, a human-readable reason may be provided.
-	FailureReason string `protobuf:"bytes,3,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
-	// Full transaction details (optional convenience field).
-	Transaction   *Transaction `protobuf:"bytes,4,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionStatusResponse) Reset() {
-	*x = GetTransactionStatusResponse{}
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[3]
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionStatusResponse) String() string {
+func (x *ListWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionStatusResponse) ProtoMessage() {}
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *GetTransactionStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[3]
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,70 +4820,41 @@ func (x *GetTransactionStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *GetTransactionStatusResponse) GetTransactionId() string {
-	if x != nil {
-		return x.TransactionId
-	}
-	return ""
-}
-
-func (x *GetTransactionStatusResponse) GetStatus() TransactionStatus {
-	if x != nil {
-		return x.Status
-	}
-	return TransactionStatus_UNSPECIFIED
-}
-
-func (x *GetTransactionStatusResponse) GetFailureReason() string {
-	if x != nil {
-		return x.FailureReason
-	}
-	return ""
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetTransactionStatusResponse) GetTransaction() *Transaction {
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Transaction
+		return x.Deliveries
 	}
 	return nil
 }
 
-// Transaction is a record representing a transfer between two accounts.
-type Transaction struct {
+// ReplayWebhookDeliveryRequest sends a delivery again.
+type ReplayWebhookDeliveryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	SenderId      string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	RecipientId   string                 `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
-	Status        TransactionStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=transaction.v1.TransactionStatus" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Memo          *string                `protobuf:"bytes,9,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[4]
+func (x *ReplayWebhookDeliveryRequest) Reset() {
+	*x = ReplayWebhookDeliveryRequest{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *ReplayWebhookDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*ReplayWebhookDeliveryRequest) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_transaction_v1_transaction_proto_msgTypes[4]
+func (x *ReplayWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,97 +4865,120 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ReplayWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Transaction) GetTransactionId() string {
+func (x *ReplayWebhookDeliveryRequest) GetDeliveryId() string {
 	if x != nil {
-		return x.TransactionId
+		return x.DeliveryId
 	}
 	return ""
 }
 
-func (x *Transaction) GetSenderId() string {
-	if x != nil {
-		return x.SenderId
-	}
-	return ""
+// ReplayWebhookDeliveryResponse returns the delivery, PENDING again.
+type ReplayWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) GetRecipientId() string {
-	if x != nil {
-		return x.RecipientId
-	}
-	return ""
+func (x *ReplayWebhookDeliveryResponse) Reset() {
+	*x = ReplayWebhookDeliveryResponse{}
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+func (x *ReplayWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Transaction) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
-}
+func (*ReplayWebhookDeliveryResponse) ProtoMessage() {}
 
-func (x *Transaction) GetStatus() TransactionStatus {
+func (x *ReplayWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_transaction_v1_transaction_proto_msgTypes[60]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return TransactionStatus_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use ReplayWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_api_transaction_v1_transaction_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *Transaction) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ReplayWebhookDeliveryResponse) GetDelivery() *WebhookDelivery {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Delivery
 	}
 	return nil
 }
 
-func (x *Transaction) GetMemo() string {
-	if x != nil && x.Memo != nil {
-		return *x.Memo
-	}
-	return ""
-}
-
 var File_api_transaction_v1_transaction_proto protoreflect.FileDescriptor
 
 const file_api_transaction_v1_transaction_proto_rawDesc = "" +
 	"\n" +
-	"$api/transaction/v1/transaction.proto\x12\x0etransaction.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xad\x01\n" +
+	"$api/transaction/v1/transaction.proto\x12\x0etransaction.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9d\x02\n" +
 	"\x15CreateTransferRequest\x12\x1b\n" +
 	"\tsender_id\x18\x01 \x01(\tR\bsenderId\x12!\n" +
 	"\frecipient_id\x18\x02 \x01(\tR\vrecipientId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x17\n" +
-	"\x04memo\x18\x05 \x01(\tH\x00R\x04memo\x88\x01\x01B\a\n" +
-	"\x05_memo\"\xb9\x01\n" +
+	"\x04memo\x18\x05 \x01(\tH\x00R\x04memo\x88\x01\x01\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\x12\x1e\n" +
+	"\vfx_quote_id\x18\a \x01(\tR\tfxQuoteId\x12%\n" +
+	"\finitiator_id\x18\b \x01(\tB\x02\x18\x01R\vinitiatorIdB\a\n" +
+	"\x05_memo\"\x8a\x02\n" +
 	"\x16CreateTransferResponse\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x129\n" +
 	"\x06status\x18\x02 \x01(\x0e2!.transaction.v1.TransactionStatusR\x06status\x12=\n" +
-	"\vtransaction\x18\x03 \x01(\v2\x1b.transaction.v1.TransactionR\vtransaction\"D\n" +
+	"\vtransaction\x18\x03 \x01(\v2\x1b.transaction.v1.TransactionR\vtransaction\x12.\n" +
+	"\x03fee\x18\x04 \x01(\v2\x1c.transaction.v1.FeeBreakdownR\x03fee\x12\x1f\n" +
+	"\vtotal_debit\x18\x05 \x01(\x03R\n" +
+	"totalDebit\"\xc4\x01\n" +
+	"\x0eLimitViolation\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\x02 \x01(\tR\ascopeId\x12\x1d\n" +
+	"\n" +
+	"limit_type\x18\x03 \x01(\tR\tlimitType\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x03R\x05limit\x12\x12\n" +
+	"\x04used\x18\x06 \x01(\x03R\x04used\x12\x1c\n" +
+	"\tremaining\x18\a \x01(\x03R\tremaining\"\xa8\x01\n" +
+	"\fFeeBreakdown\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04flat\x18\x02 \x01(\x03R\x04flat\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x03 \x01(\x03R\n" +
+	"percentage\x12\x1e\n" +
+	"\n" +
+	"adjustment\x18\x04 \x01(\x03R\n" +
+	"adjustment\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04rule\x18\x06 \x01(\tR\x04rule\"y\n" +
+	"\x14QuoteTransferRequest\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12-\n" +
+	"\x12recipient_currency\x18\x03 \x01(\tR\x11recipientCurrency\"h\n" +
+	"\x15QuoteTransferResponse\x12.\n" +
+	"\x03fee\x18\x01 \x01(\v2\x1c.transaction.v1.FeeBreakdownR\x03fee\x12\x1f\n" +
+	"\vtotal_debit\x18\x02 \x01(\x03R\n" +
+	"totalDebit\"D\n" +
 	"\x1bGetTransactionStatusRequest\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"\xe6\x01\n" +
 	"\x1cGetTransactionStatusResponse\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x129\n" +
 	"\x06status\x18\x02 \x01(\x0e2!.transaction.v1.TransactionStatusR\x06status\x12%\n" +
 	"\x0efailure_reason\x18\x03 \x01(\tR\rfailureReason\x12=\n" +
-	"\vtransaction\x18\x04 \x01(\v2\x1b.transaction.v1.TransactionR\vtransaction\"\xfb\x02\n" +
+	"\vtransaction\x18\x04 \x01(\v2\x1b.transaction.v1.TransactionR\vtransaction\"\xdf\x05\n" +
 	"\vTransaction\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1b\n" +
 	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12!\n" +
@@ -488,18 +4990,394 @@ const file_api_transaction_v1_transaction_proto_rawDesc = "" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
-	"\x04memo\x18\t \x01(\tH\x00R\x04memo\x88\x01\x01B\a\n" +
-	"\x05_memo*Z\n" +
+	"\x04memo\x18\t \x01(\tH\x00R\x04memo\x88\x01\x01\x123\n" +
+	"\x04type\x18\n" +
+	" \x01(\x0e2\x1f.transaction.v1.TransactionTypeR\x04type\x126\n" +
+	"\x17original_transaction_id\x18\v \x01(\tR\x15originalTransactionId\x12'\n" +
+	"\x0frefunded_amount\x18\f \x01(\x03R\x0erefundedAmount\x12%\n" +
+	"\x0ecounter_amount\x18\r \x01(\x03R\rcounterAmount\x12)\n" +
+	"\x10counter_currency\x18\x0e \x01(\tR\x0fcounterCurrency\x12\x17\n" +
+	"\afx_rate\x18\x0f \x01(\tR\x06fxRate\x12\x1d\n" +
+	"\n" +
+	"fee_amount\x18\x10 \x01(\x03R\tfeeAmount\x12!\n" +
+	"\finitiator_id\x18\x11 \x01(\tR\vinitiatorId\x12\x1f\n" +
+	"\vapprover_id\x18\x12 \x01(\tR\n" +
+	"approverIdB\a\n" +
+	"\x05_memo\"\x80\x01\n" +
+	"\x16ReverseTransferRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"R\n" +
+	"\x17ReverseTransferResponse\x127\n" +
+	"\breversal\x18\x01 \x01(\v2\x1b.transaction.v1.TransactionR\breversal\"\x97\x01\n" +
+	"\x15RefundTransferRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\x80\x01\n" +
+	"\x16RefundTransferResponse\x123\n" +
+	"\x06refund\x18\x01 \x01(\v2\x1b.transaction.v1.TransactionR\x06refund\x121\n" +
+	"\x14remaining_refundable\x18\x02 \x01(\x03R\x13remainingRefundable\"\xcf\x02\n" +
+	"\x17ListTransactionsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.transaction.v1.TransactionStatusR\x06status\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12?\n" +
+	"\rcreated_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"\x83\x01\n" +
+	"\x18ListTransactionsResponse\x12?\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1b.transaction.v1.TransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x99\x03\n" +
+	"\x04Hold\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12'\n" +
+	"\x0fcaptured_amount\x18\x04 \x01(\x03R\x0ecapturedAmount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x122\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1a.transaction.v1.HoldStatusR\x06status\x12\x1c\n" +
+	"\treference\x18\a \x01(\tR\treference\x124\n" +
+	"\x16capture_transaction_id\x18\b \x01(\tR\x14captureTransactionId\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa8\x01\n" +
+	"\x14AuthorizeHoldRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12\x1f\n" +
+	"\vttl_seconds\x18\x05 \x01(\x03R\n" +
+	"ttlSeconds\"n\n" +
+	"\x15AuthorizeHoldResponse\x12(\n" +
+	"\x04hold\x18\x01 \x01(\v2\x14.transaction.v1.HoldR\x04hold\x12+\n" +
+	"\x11available_balance\x18\x02 \x01(\x03R\x10availableBalance\"\x8a\x01\n" +
+	"\x12CaptureHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12!\n" +
+	"\frecipient_id\x18\x02 \x01(\tR\vrecipientId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x17\n" +
+	"\x04memo\x18\x04 \x01(\tH\x00R\x04memo\x88\x01\x01B\a\n" +
+	"\x05_memo\"~\n" +
+	"\x13CaptureHoldResponse\x12(\n" +
+	"\x04hold\x18\x01 \x01(\v2\x14.transaction.v1.HoldR\x04hold\x12=\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x1b.transaction.v1.TransactionR\vtransaction\"*\n" +
+	"\x0fVoidHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\"<\n" +
+	"\x10VoidHoldResponse\x12(\n" +
+	"\x04hold\x18\x01 \x01(\v2\x14.transaction.v1.HoldR\x04hold\"\x81\x05\n" +
+	"\x10TransferSchedule\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12!\n" +
+	"\frecipient_id\x18\x03 \x01(\tR\vrecipientId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x17\n" +
+	"\x04memo\x18\x06 \x01(\tH\x00R\x04memo\x88\x01\x01\x12'\n" +
+	"\x0fcron_expression\x18\a \x01(\tR\x0ecronExpression\x12\x1b\n" +
+	"\ttime_zone\x18\b \x01(\tR\btimeZone\x125\n" +
+	"\bstart_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12\x19\n" +
+	"\bmax_runs\x18\v \x01(\x05R\amaxRuns\x12\x1b\n" +
+	"\trun_count\x18\f \x01(\x05R\brunCount\x12[\n" +
+	"\x15on_insufficient_funds\x18\r \x01(\x0e2'.transaction.v1.InsufficientFundsPolicyR\x13onInsufficientFunds\x126\n" +
+	"\x06status\x18\x0e \x01(\x0e2\x1e.transaction.v1.ScheduleStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\a\n" +
+	"\x05_memo\"\xe0\x02\n" +
+	"\vScheduleRun\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x129\n" +
+	"\x06status\x18\x04 \x01(\x0e2!.transaction.v1.ScheduleRunStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12%\n" +
+	"\x0efailure_reason\x18\x06 \x01(\tR\rfailureReason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x87\x04\n" +
+	"\x1eCreateScheduledTransferRequest\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\tR\bsenderId\x12!\n" +
+	"\frecipient_id\x18\x02 \x01(\tR\vrecipientId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x17\n" +
+	"\x04memo\x18\x05 \x01(\tH\x00R\x04memo\x88\x01\x01\x12'\n" +
+	"\x0fcron_expression\x18\x06 \x01(\tR\x0ecronExpression\x12\x1b\n" +
+	"\ttime_zone\x18\a \x01(\tR\btimeZone\x125\n" +
+	"\bstart_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12\x19\n" +
+	"\bmax_runs\x18\n" +
+	" \x01(\x05R\amaxRuns\x12[\n" +
+	"\x15on_insufficient_funds\x18\v \x01(\x0e2'.transaction.v1.InsufficientFundsPolicyR\x13onInsufficientFunds\x12'\n" +
+	"\x0fidempotency_key\x18\f \x01(\tR\x0eidempotencyKeyB\a\n" +
+	"\x05_memo\"_\n" +
+	"\x1fCreateScheduledTransferResponse\x12<\n" +
+	"\bschedule\x18\x01 \x01(\v2 .transaction.v1.TransferScheduleR\bschedule\"3\n" +
+	"\x14ListSchedulesRequest\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\tR\bsenderId\"W\n" +
+	"\x15ListSchedulesResponse\x12>\n" +
+	"\tschedules\x18\x01 \x03(\v2 .transaction.v1.TransferScheduleR\tschedules\"8\n" +
+	"\x15CancelScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\"V\n" +
+	"\x16CancelScheduleResponse\x12<\n" +
+	"\bschedule\x18\x01 \x01(\v2 .transaction.v1.TransferScheduleR\bschedule\"W\n" +
+	"\x17ListScheduleRunsRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"K\n" +
+	"\x18ListScheduleRunsResponse\x12/\n" +
+	"\x04runs\x18\x01 \x03(\v2\x1b.transaction.v1.ScheduleRunR\x04runs\"l\n" +
+	"\x11BatchTransferItem\x12!\n" +
+	"\frecipient_id\x18\x01 \x01(\tR\vrecipientId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\"\xb7\x02\n" +
+	"\tBatchItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x05R\x03seq\x12!\n" +
+	"\frecipient_id\x18\x03 \x01(\tR\vrecipientId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1d\n" +
+	"\n" +
+	"fee_amount\x18\x05 \x01(\x03R\tfeeAmount\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x12%\n" +
+	"\x0etransaction_id\x18\a \x01(\tR\rtransactionId\x129\n" +
+	"\x06status\x18\b \x01(\x0e2!.transaction.v1.TransactionStatusR\x06status\x12%\n" +
+	"\x0efailure_reason\x18\t \x01(\tR\rfailureReason\"\xd3\x04\n" +
+	"\x05Batch\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12-\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x19.transaction.v1.BatchModeR\x04mode\x123\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1b.transaction.v1.BatchStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x06 \x01(\x05R\titemCount\x12!\n" +
+	"\ftotal_amount\x18\a \x01(\x03R\vtotalAmount\x12\x1b\n" +
+	"\ttotal_fee\x18\b \x01(\x03R\btotalFee\x12#\n" +
+	"\rpending_count\x18\t \x01(\x05R\fpendingCount\x12'\n" +
+	"\x0fcompleted_count\x18\n" +
+	" \x01(\x05R\x0ecompletedCount\x12!\n" +
+	"\ffailed_count\x18\v \x01(\x05R\vfailedCount\x12%\n" +
+	"\x0ereversed_count\x18\f \x01(\x05R\rreversedCount\x12%\n" +
+	"\x0efailure_reason\x18\r \x01(\tR\rfailureReason\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe6\x01\n" +
+	"\x1aCreateBatchTransferRequest\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\tR\bsenderId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12-\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x19.transaction.v1.BatchModeR\x04mode\x127\n" +
+	"\x05items\x18\x04 \x03(\v2!.transaction.v1.BatchTransferItemR\x05items\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"J\n" +
+	"\x1bCreateBatchTransferResponse\x12+\n" +
+	"\x05batch\x18\x01 \x01(\v2\x15.transaction.v1.BatchR\x05batch\"p\n" +
+	"\x17GetBatchTransferRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa0\x01\n" +
+	"\x18GetBatchTransferResponse\x12+\n" +
+	"\x05batch\x18\x01 \x01(\v2\x15.transaction.v1.BatchR\x05batch\x12/\n" +
+	"\x05items\x18\x02 \x03(\v2\x19.transaction.v1.BatchItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"`\n" +
+	"\x16ApproveTransferRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1f\n" +
+	"\vapprover_id\x18\x02 \x01(\tR\n" +
+	"approverId\"X\n" +
+	"\x17ApproveTransferResponse\x12=\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1b.transaction.v1.TransactionR\vtransaction\"w\n" +
+	"\x15RejectTransferRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1f\n" +
+	"\vapprover_id\x18\x02 \x01(\tR\n" +
+	"approverId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"W\n" +
+	"\x16RejectTransferResponse\x12=\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1b.transaction.v1.TransactionR\vtransaction\"Q\n" +
+	"\vRiskRuleHit\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"?\n" +
+	"\x16GetRiskDecisionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"\xe5\x01\n" +
+	"\x17GetRiskDecisionResponse\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x125\n" +
+	"\aoutcome\x18\x02 \x01(\x0e2\x1b.transaction.v1.RiskOutcomeR\aoutcome\x12/\n" +
+	"\x04hits\x18\x03 \x03(\v2\x1b.transaction.v1.RiskRuleHitR\x04hits\x12;\n" +
+	"\vscreened_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"screenedAt\"\x96\x02\n" +
+	"\fScreeningHit\x12\x14\n" +
+	"\x05party\x18\x01 \x01(\tR\x05party\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vholder_name\x18\x03 \x01(\tR\n" +
+	"holderName\x12\x1b\n" +
+	"\tentry_uid\x18\x04 \x01(\tR\bentryUid\x12\x1d\n" +
+	"\n" +
+	"entry_name\x18\x05 \x01(\tR\tentryName\x12!\n" +
+	"\fmatched_name\x18\x06 \x01(\tR\vmatchedName\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\x12;\n" +
+	"\vscreened_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"screenedAt\"A\n" +
+	"\x18ListScreeningHitsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"M\n" +
+	"\x19ListScreeningHitsResponse\x120\n" +
+	"\x04hits\x18\x01 \x03(\v2\x1c.transaction.v1.ScreeningHitR\x04hits\"\x80\x02\n" +
+	"\x0fWebhookEndpoint\x12\x1f\n" +
+	"\vendpoint_id\x18\x01 \x01(\tR\n" +
+	"endpointId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\tR\n" +
+	"merchantId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12=\n" +
+	"\x06status\x18\x05 \x01(\x0e2%.transaction.v1.WebhookEndpointStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"r\n" +
+	"\x1cCreateWebhookEndpointRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\tR\n" +
+	"merchantId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\"t\n" +
+	"\x1dCreateWebhookEndpointResponse\x12;\n" +
+	"\bendpoint\x18\x01 \x01(\v2\x1f.transaction.v1.WebhookEndpointR\bendpoint\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\">\n" +
+	"\x1bListWebhookEndpointsRequest\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\tR\n" +
+	"merchantId\"]\n" +
+	"\x1cListWebhookEndpointsResponse\x12=\n" +
+	"\tendpoints\x18\x01 \x03(\v2\x1f.transaction.v1.WebhookEndpointR\tendpoints\"@\n" +
+	"\x1dDisableWebhookEndpointRequest\x12\x1f\n" +
+	"\vendpoint_id\x18\x01 \x01(\tR\n" +
+	"endpointId\"]\n" +
+	"\x1eDisableWebhookEndpointResponse\x12;\n" +
+	"\bendpoint\x18\x01 \x01(\v2\x1f.transaction.v1.WebhookEndpointR\bendpoint\"\xf5\x03\n" +
+	"\x0fWebhookDelivery\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\tR\n" +
+	"endpointId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12=\n" +
+	"\x06status\x18\x06 \x01(\x0e2%.transaction.v1.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\b \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12\x18\n" +
+	"\areplays\x18\n" +
+	" \x01(\x05R\areplays\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\fdelivered_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vdeliveredAt\x88\x01\x01B\x0f\n" +
+	"\r_delivered_at\"\\\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1f\n" +
+	"\vendpoint_id\x18\x01 \x01(\tR\n" +
+	"endpointId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"`\n" +
+	"\x1dListWebhookDeliveriesResponse\x12?\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1f.transaction.v1.WebhookDeliveryR\n" +
+	"deliveries\"?\n" +
+	"\x1cReplayWebhookDeliveryRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\"\\\n" +
+	"\x1dReplayWebhookDeliveryResponse\x12;\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x1f.transaction.v1.WebhookDeliveryR\bdelivery*\x7f\n" +
 	"\x11TransactionStatus\x12\x0f\n" +
 	"\vUNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\r\n" +
 	"\tCOMPLETED\x10\x02\x12\n" +
 	"\n" +
 	"\x06FAILED\x10\x03\x12\f\n" +
-	"\bREVERSED\x10\x042\xe8\x01\n" +
+	"\bREVERSED\x10\x04\x12\x15\n" +
+	"\x11AWAITING_APPROVAL\x10\x05\x12\f\n" +
+	"\bREJECTED\x10\x06*\xac\x01\n" +
+	"\x0fTransactionType\x12 \n" +
+	"\x1cTRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19TRANSACTION_TYPE_TRANSFER\x10\x01\x12\x1b\n" +
+	"\x17TRANSACTION_TYPE_REFUND\x10\x02\x12\x1d\n" +
+	"\x19TRANSACTION_TYPE_REVERSAL\x10\x03\x12\x1c\n" +
+	"\x18TRANSACTION_TYPE_CAPTURE\x10\x04*\x8c\x01\n" +
+	"\n" +
+	"HoldStatus\x12\x1b\n" +
+	"\x17HOLD_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12HOLD_STATUS_ACTIVE\x10\x01\x12\x18\n" +
+	"\x14HOLD_STATUS_CAPTURED\x10\x02\x12\x16\n" +
+	"\x12HOLD_STATUS_VOIDED\x10\x03\x12\x17\n" +
+	"\x13HOLD_STATUS_EXPIRED\x10\x04*\x8b\x01\n" +
+	"\x0eScheduleStatus\x12\x1f\n" +
+	"\x1bSCHEDULE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SCHEDULE_STATUS_ACTIVE\x10\x01\x12\x1d\n" +
+	"\x19SCHEDULE_STATUS_COMPLETED\x10\x02\x12\x1d\n" +
+	"\x19SCHEDULE_STATUS_CANCELLED\x10\x03*\x8d\x01\n" +
+	"\x17InsufficientFundsPolicy\x12)\n" +
+	"%INSUFFICIENT_FUNDS_POLICY_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eINSUFFICIENT_FUNDS_POLICY_SKIP\x10\x01\x12#\n" +
+	"\x1fINSUFFICIENT_FUNDS_POLICY_RETRY\x10\x02*\xbd\x01\n" +
+	"\x11ScheduleRunStatus\x12#\n" +
+	"\x1fSCHEDULE_RUN_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSCHEDULE_RUN_STATUS_PENDING\x10\x01\x12!\n" +
+	"\x1dSCHEDULE_RUN_STATUS_COMPLETED\x10\x02\x12\x1e\n" +
+	"\x1aSCHEDULE_RUN_STATUS_FAILED\x10\x03\x12\x1f\n" +
+	"\x1bSCHEDULE_RUN_STATUS_SKIPPED\x10\x04*b\n" +
+	"\tBatchMode\x12\x1a\n" +
+	"\x16BATCH_MODE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19BATCH_MODE_ALL_OR_NOTHING\x10\x01\x12\x1a\n" +
+	"\x16BATCH_MODE_BEST_EFFORT\x10\x02*\xbd\x01\n" +
+	"\vBatchStatus\x12\x1c\n" +
+	"\x18BATCH_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14BATCH_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17BATCH_STATUS_PROCESSING\x10\x02\x12\x1a\n" +
+	"\x16BATCH_STATUS_COMPLETED\x10\x03\x12$\n" +
+	" BATCH_STATUS_PARTIALLY_COMPLETED\x10\x04\x12\x17\n" +
+	"\x13BATCH_STATUS_FAILED\x10\x05*s\n" +
+	"\vRiskOutcome\x12\x1c\n" +
+	"\x18RISK_OUTCOME_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12RISK_OUTCOME_ALLOW\x10\x01\x12\x17\n" +
+	"\x13RISK_OUTCOME_REVIEW\x10\x02\x12\x15\n" +
+	"\x11RISK_OUTCOME_DENY\x10\x03*\x8a\x01\n" +
+	"\x15WebhookEndpointStatus\x12'\n" +
+	"#WEBHOOK_ENDPOINT_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eWEBHOOK_ENDPOINT_STATUS_ACTIVE\x10\x01\x12$\n" +
+	" WEBHOOK_ENDPOINT_STATUS_DISABLED\x10\x02*\xb0\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_DELIVERED\x10\x02\x12\"\n" +
+	"\x1eWEBHOOK_DELIVERY_STATUS_FAILED\x10\x032\xd1\x13\n" +
 	"\x12TransactionService\x12_\n" +
-	"\x0eCreateTransfer\x12%.transaction.v1.CreateTransferRequest\x1a&.transaction.v1.CreateTransferResponse\x12q\n" +
-	"\x14GetTransactionStatus\x12+.transaction.v1.GetTransactionStatusRequest\x1a,.transaction.v1.GetTransactionStatusResponseB3Z1FinTechPorto/gen/api/transaction/v1;transactionv1b\x06proto3"
+	"\x0eCreateTransfer\x12%.transaction.v1.CreateTransferRequest\x1a&.transaction.v1.CreateTransferResponse\x12\\\n" +
+	"\rQuoteTransfer\x12$.transaction.v1.QuoteTransferRequest\x1a%.transaction.v1.QuoteTransferResponse\x12q\n" +
+	"\x14GetTransactionStatus\x12+.transaction.v1.GetTransactionStatusRequest\x1a,.transaction.v1.GetTransactionStatusResponse\x12e\n" +
+	"\x10ListTransactions\x12'.transaction.v1.ListTransactionsRequest\x1a(.transaction.v1.ListTransactionsResponse\x12b\n" +
+	"\x0fReverseTransfer\x12&.transaction.v1.ReverseTransferRequest\x1a'.transaction.v1.ReverseTransferResponse\x12_\n" +
+	"\x0eRefundTransfer\x12%.transaction.v1.RefundTransferRequest\x1a&.transaction.v1.RefundTransferResponse\x12\\\n" +
+	"\rAuthorizeHold\x12$.transaction.v1.AuthorizeHoldRequest\x1a%.transaction.v1.AuthorizeHoldResponse\x12V\n" +
+	"\vCaptureHold\x12\".transaction.v1.CaptureHoldRequest\x1a#.transaction.v1.CaptureHoldResponse\x12M\n" +
+	"\bVoidHold\x12\x1f.transaction.v1.VoidHoldRequest\x1a .transaction.v1.VoidHoldResponse\x12z\n" +
+	"\x17CreateScheduledTransfer\x12..transaction.v1.CreateScheduledTransferRequest\x1a/.transaction.v1.CreateScheduledTransferResponse\x12\\\n" +
+	"\rListSchedules\x12$.transaction.v1.ListSchedulesRequest\x1a%.transaction.v1.ListSchedulesResponse\x12_\n" +
+	"\x0eCancelSchedule\x12%.transaction.v1.CancelScheduleRequest\x1a&.transaction.v1.CancelScheduleResponse\x12e\n" +
+	"\x10ListScheduleRuns\x12'.transaction.v1.ListScheduleRunsRequest\x1a(.transaction.v1.ListScheduleRunsResponse\x12n\n" +
+	"\x13CreateBatchTransfer\x12*.transaction.v1.CreateBatchTransferRequest\x1a+.transaction.v1.CreateBatchTransferResponse\x12e\n" +
+	"\x10GetBatchTransfer\x12'.transaction.v1.GetBatchTransferRequest\x1a(.transaction.v1.GetBatchTransferResponse\x12b\n" +
+	"\x0fApproveTransfer\x12&.transaction.v1.ApproveTransferRequest\x1a'.transaction.v1.ApproveTransferResponse\x12_\n" +
+	"\x0eRejectTransfer\x12%.transaction.v1.RejectTransferRequest\x1a&.transaction.v1.RejectTransferResponse\x12b\n" +
+	"\x0fGetRiskDecision\x12&.transaction.v1.GetRiskDecisionRequest\x1a'.transaction.v1.GetRiskDecisionResponse\x12h\n" +
+	"\x11ListScreeningHits\x12(.transaction.v1.ListScreeningHitsRequest\x1a).transaction.v1.ListScreeningHitsResponse\x12t\n" +
+	"\x15CreateWebhookEndpoint\x12,.transaction.v1.CreateWebhookEndpointRequest\x1a-.transaction.v1.CreateWebhookEndpointResponse\x12q\n" +
+	"\x14ListWebhookEndpoints\x12+.transaction.v1.ListWebhookEndpointsRequest\x1a,.transaction.v1.ListWebhookEndpointsResponse\x12w\n" +
+	"\x16DisableWebhookEndpoint\x12-.transaction.v1.DisableWebhookEndpointRequest\x1a..transaction.v1.DisableWebhookEndpointResponse\x12t\n" +
+	"\x15ListWebhookDeliveries\x12,.transaction.v1.ListWebhookDeliveriesRequest\x1a-.transaction.v1.ListWebhookDeliveriesResponse\x12t\n" +
+	"\x15ReplayWebhookDelivery\x12,.transaction.v1.ReplayWebhookDeliveryRequest\x1a-.transaction.v1.ReplayWebhookDeliveryResponseB3Z1FinTechPorto/gen/api/transaction/v1;transactionv1b\x06proto3"
 
 var (
 	file_api_transaction_v1_transaction_proto_rawDescOnce sync.Once
@@ -513,34 +5391,202 @@ func file_api_transaction_v1_transaction_proto_rawDescGZIP() []byte {
 	return file_api_transaction_v1_transaction_proto_rawDescData
 }
 
-var file_api_transaction_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_transaction_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_transaction_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_api_transaction_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_api_transaction_v1_transaction_proto_goTypes = []any{
-	(TransactionStatus)(0),               // 0: transaction.v1.TransactionStatus
-	(*CreateTransferRequest)(nil),        // 1: transaction.v1.CreateTransferRequest
-	(*CreateTransferResponse)(nil),       // 2: transaction.v1.CreateTransferResponse
-	(*GetTransactionStatusRequest)(nil),  // 3: transaction.v1.GetTransactionStatusRequest
-	(*GetTransactionStatusResponse)(nil), // 4: transaction.v1.GetTransactionStatusResponse
-	(*Transaction)(nil),                  // 5: transaction.v1.Transaction
-	(*timestamppb.Timestamp)(nil),        // 6: google.protobuf.Timestamp
+	(TransactionStatus)(0),                  // 0: transaction.v1.TransactionStatus
+	(TransactionType)(0),                    // 1: transaction.v1.TransactionType
+	(HoldStatus)(0),                         // 2: transaction.v1.HoldStatus
+	(ScheduleStatus)(0),                     // 3: transaction.v1.ScheduleStatus
+	(InsufficientFundsPolicy)(0),            // 4: transaction.v1.InsufficientFundsPolicy
+	(ScheduleRunStatus)(0),                  // 5: transaction.v1.ScheduleRunStatus
+	(BatchMode)(0),                          // 6: transaction.v1.BatchMode
+	(BatchStatus)(0),                        // 7: transaction.v1.BatchStatus
+	(RiskOutcome)(0),                        // 8: transaction.v1.RiskOutcome
+	(WebhookEndpointStatus)(0),              // 9: transaction.v1.WebhookEndpointStatus
+	(WebhookDeliveryStatus)(0),              // 10: transaction.v1.WebhookDeliveryStatus
+	(*CreateTransferRequest)(nil),           // 11: transaction.v1.CreateTransferRequest
+	(*CreateTransferResponse)(nil),          // 12: transaction.v1.CreateTransferResponse
+	(*LimitViolation)(nil),                  // 13: transaction.v1.LimitViolation
+	(*FeeBreakdown)(nil),                    // 14: transaction.v1.FeeBreakdown
+	(*QuoteTransferRequest)(nil),            // 15: transaction.v1.QuoteTransferRequest
+	(*QuoteTransferResponse)(nil),           // 16: transaction.v1.QuoteTransferResponse
+	(*GetTransactionStatusRequest)(nil),     // 17: transaction.v1.GetTransactionStatusRequest
+	(*GetTransactionStatusResponse)(nil),    // 18: transaction.v1.GetTransactionStatusResponse
+	(*Transaction)(nil),                     // 19: transaction.v1.Transaction
+	(*ReverseTransferRequest)(nil),          // 20: transaction.v1.ReverseTransferRequest
+	(*ReverseTransferResponse)(nil),         // 21: transaction.v1.ReverseTransferResponse
+	(*RefundTransferRequest)(nil),           // 22: transaction.v1.RefundTransferRequest
+	(*RefundTransferResponse)(nil),          // 23: transaction.v1.RefundTransferResponse
+	(*ListTransactionsRequest)(nil),         // 24: transaction.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),        // 25: transaction.v1.ListTransactionsResponse
+	(*Hold)(nil),                            // 26: transaction.v1.Hold
+	(*AuthorizeHoldRequest)(nil),            // 27: transaction.v1.AuthorizeHoldRequest
+	(*AuthorizeHoldResponse)(nil),           // 28: transaction.v1.AuthorizeHoldResponse
+	(*CaptureHoldRequest)(nil),              // 29: transaction.v1.CaptureHoldRequest
+	(*CaptureHoldResponse)(nil),             // 30: transaction.v1.CaptureHoldResponse
+	(*VoidHoldRequest)(nil),                 // 31: transaction.v1.VoidHoldRequest
+	(*VoidHoldResponse)(nil),                // 32: transaction.v1.VoidHoldResponse
+	(*TransferSchedule)(nil),                // 33: transaction.v1.TransferSchedule
+	(*ScheduleRun)(nil),                     // 34: transaction.v1.ScheduleRun
+	(*CreateScheduledTransferRequest)(nil),  // 35: transaction.v1.CreateScheduledTransferRequest
+	(*CreateScheduledTransferResponse)(nil), // 36: transaction.v1.CreateScheduledTransferResponse
+	(*ListSchedulesRequest)(nil),            // 37: transaction.v1.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),           // 38: transaction.v1.ListSchedulesResponse
+	(*CancelScheduleRequest)(nil),           // 39: transaction.v1.CancelScheduleRequest
+	(*CancelScheduleResponse)(nil),          // 40: transaction.v1.CancelScheduleResponse
+	(*ListScheduleRunsRequest)(nil),         // 41: transaction.v1.ListScheduleRunsRequest
+	(*ListScheduleRunsResponse)(nil),        // 42: transaction.v1.ListScheduleRunsResponse
+	(*BatchTransferItem)(nil),               // 43: transaction.v1.BatchTransferItem
+	(*BatchItem)(nil),                       // 44: transaction.v1.BatchItem
+	(*Batch)(nil),                           // 45: transaction.v1.Batch
+	(*CreateBatchTransferRequest)(nil),      // 46: transaction.v1.CreateBatchTransferRequest
+	(*CreateBatchTransferResponse)(nil),     // 47: transaction.v1.CreateBatchTransferResponse
+	(*GetBatchTransferRequest)(nil),         // 48: transaction.v1.GetBatchTransferRequest
+	(*GetBatchTransferResponse)(nil),        // 49: transaction.v1.GetBatchTransferResponse
+	(*ApproveTransferRequest)(nil),          // 50: transaction.v1.ApproveTransferRequest
+	(*ApproveTransferResponse)(nil),         // 51: transaction.v1.ApproveTransferResponse
+	(*RejectTransferRequest)(nil),           // 52: transaction.v1.RejectTransferRequest
+	(*RejectTransferResponse)(nil),          // 53: transaction.v1.RejectTransferResponse
+	(*RiskRuleHit)(nil),                     // 54: transaction.v1.RiskRuleHit
+	(*GetRiskDecisionRequest)(nil),          // 55: transaction.v1.GetRiskDecisionRequest
+	(*GetRiskDecisionResponse)(nil),         // 56: transaction.v1.GetRiskDecisionResponse
+	(*ScreeningHit)(nil),                    // 57: transaction.v1.ScreeningHit
+	(*ListScreeningHitsRequest)(nil),        // 58: transaction.v1.ListScreeningHitsRequest
+	(*ListScreeningHitsResponse)(nil),       // 59: transaction.v1.ListScreeningHitsResponse
+	(*WebhookEndpoint)(nil),                 // 60: transaction.v1.WebhookEndpoint
+	(*CreateWebhookEndpointRequest)(nil),    // 61: transaction.v1.CreateWebhookEndpointRequest
+	(*CreateWebhookEndpointResponse)(nil),   // 62: transaction.v1.CreateWebhookEndpointResponse
+	(*ListWebhookEndpointsRequest)(nil),     // 63: transaction.v1.ListWebhookEndpointsRequest
+	(*ListWebhookEndpointsResponse)(nil),    // 64: transaction.v1.ListWebhookEndpointsResponse
+	(*DisableWebhookEndpointRequest)(nil),   // 65: transaction.v1.DisableWebhookEndpointRequest
+	(*DisableWebhookEndpointResponse)(nil),  // 66: transaction.v1.DisableWebhookEndpointResponse
+	(*WebhookDelivery)(nil),                 // 67: transaction.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),    // 68: transaction.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),   // 69: transaction.v1.ListWebhookDeliveriesResponse
+	(*ReplayWebhookDeliveryRequest)(nil),    // 70: transaction.v1.ReplayWebhookDeliveryRequest
+	(*ReplayWebhookDeliveryResponse)(nil),   // 71: transaction.v1.ReplayWebhookDeliveryResponse
+	(*timestamppb.Timestamp)(nil),           // 72: google.protobuf.Timestamp
 }
 var file_api_transaction_v1_transaction_proto_depIdxs = []int32{
-	0, // 0: transaction.v1.CreateTransferResponse.status:type_name -> transaction.v1.TransactionStatus
-	5, // 1: transaction.v1.CreateTransferResponse.transaction:type_name -> transaction.v1.Transaction
-	0, // 2: transaction.v1.GetTransactionStatusResponse.status:type_name -> transaction.v1.TransactionStatus
-	5, // 3: transaction.v1.GetTransactionStatusResponse.transaction:type_name -> transaction.v1.Transaction
-	0, // 4: transaction.v1.Transaction.status:type_name -> transaction.v1.TransactionStatus
-	6, // 5: transaction.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	6, // 6: transaction.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
-	1, // 7: transaction.v1.TransactionService.CreateTransfer:input_type -> transaction.v1.CreateTransferRequest
-	3, // 8: transaction.v1.TransactionService.GetTransactionStatus:input_type -> transaction.v1.GetTransactionStatusRequest
-	2, // 9: transaction.v1.TransactionService.CreateTransfer:output_type -> transaction.v1.CreateTransferResponse
-	4, // 10: transaction.v1.TransactionService.GetTransactionStatus:output_type -> transaction.v1.GetTransactionStatusResponse
-	9, // [9:11] is the sub-list for method output_type
-	7, // [7:9] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: transaction.v1.CreateTransferResponse.status:type_name -> transaction.v1.TransactionStatus
+	19, // 1: transaction.v1.CreateTransferResponse.transaction:type_name -> transaction.v1.Transaction
+	14, // 2: transaction.v1.CreateTransferResponse.fee:type_name -> transaction.v1.FeeBreakdown
+	14, // 3: transaction.v1.QuoteTransferResponse.fee:type_name -> transaction.v1.FeeBreakdown
+	0,  // 4: transaction.v1.GetTransactionStatusResponse.status:type_name -> transaction.v1.TransactionStatus
+	19, // 5: transaction.v1.GetTransactionStatusResponse.transaction:type_name -> transaction.v1.Transaction
+	0,  // 6: transaction.v1.Transaction.status:type_name -> transaction.v1.TransactionStatus
+	72, // 7: transaction.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	72, // 8: transaction.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 9: transaction.v1.Transaction.type:type_name -> transaction.v1.TransactionType
+	19, // 10: transaction.v1.ReverseTransferResponse.reversal:type_name -> transaction.v1.Transaction
+	19, // 11: transaction.v1.RefundTransferResponse.refund:type_name -> transaction.v1.Transaction
+	0,  // 12: transaction.v1.ListTransactionsRequest.status:type_name -> transaction.v1.TransactionStatus
+	72, // 13: transaction.v1.ListTransactionsRequest.created_after:type_name -> google.protobuf.Timestamp
+	72, // 14: transaction.v1.ListTransactionsRequest.created_before:type_name -> google.protobuf.Timestamp
+	19, // 15: transaction.v1.ListTransactionsResponse.transactions:type_name -> transaction.v1.Transaction
+	2,  // 16: transaction.v1.Hold.status:type_name -> transaction.v1.HoldStatus
+	72, // 17: transaction.v1.Hold.expires_at:type_name -> google.protobuf.Timestamp
+	72, // 18: transaction.v1.Hold.created_at:type_name -> google.protobuf.Timestamp
+	26, // 19: transaction.v1.AuthorizeHoldResponse.hold:type_name -> transaction.v1.Hold
+	26, // 20: transaction.v1.CaptureHoldResponse.hold:type_name -> transaction.v1.Hold
+	19, // 21: transaction.v1.CaptureHoldResponse.transaction:type_name -> transaction.v1.Transaction
+	26, // 22: transaction.v1.VoidHoldResponse.hold:type_name -> transaction.v1.Hold
+	72, // 23: transaction.v1.TransferSchedule.start_at:type_name -> google.protobuf.Timestamp
+	72, // 24: transaction.v1.TransferSchedule.end_at:type_name -> google.protobuf.Timestamp
+	4,  // 25: transaction.v1.TransferSchedule.on_insufficient_funds:type_name -> transaction.v1.InsufficientFundsPolicy
+	3,  // 26: transaction.v1.TransferSchedule.status:type_name -> transaction.v1.ScheduleStatus
+	72, // 27: transaction.v1.TransferSchedule.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 28: transaction.v1.ScheduleRun.status:type_name -> transaction.v1.ScheduleRunStatus
+	72, // 29: transaction.v1.ScheduleRun.created_at:type_name -> google.protobuf.Timestamp
+	72, // 30: transaction.v1.ScheduleRun.updated_at:type_name -> google.protobuf.Timestamp
+	72, // 31: transaction.v1.CreateScheduledTransferRequest.start_at:type_name -> google.protobuf.Timestamp
+	72, // 32: transaction.v1.CreateScheduledTransferRequest.end_at:type_name -> google.protobuf.Timestamp
+	4,  // 33: transaction.v1.CreateScheduledTransferRequest.on_insufficient_funds:type_name -> transaction.v1.InsufficientFundsPolicy
+	33, // 34: transaction.v1.CreateScheduledTransferResponse.schedule:type_name -> transaction.v1.TransferSchedule
+	33, // 35: transaction.v1.ListSchedulesResponse.schedules:type_name -> transaction.v1.TransferSchedule
+	33, // 36: transaction.v1.CancelScheduleResponse.schedule:type_name -> transaction.v1.TransferSchedule
+	34, // 37: transaction.v1.ListScheduleRunsResponse.runs:type_name -> transaction.v1.ScheduleRun
+	0,  // 38: transaction.v1.BatchItem.status:type_name -> transaction.v1.TransactionStatus
+	6,  // 39: transaction.v1.Batch.mode:type_name -> transaction.v1.BatchMode
+	7,  // 40: transaction.v1.Batch.status:type_name -> transaction.v1.BatchStatus
+	72, // 41: transaction.v1.Batch.created_at:type_name -> google.protobuf.Timestamp
+	72, // 42: transaction.v1.Batch.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 43: transaction.v1.CreateBatchTransferRequest.mode:type_name -> transaction.v1.BatchMode
+	43, // 44: transaction.v1.CreateBatchTransferRequest.items:type_name -> transaction.v1.BatchTransferItem
+	45, // 45: transaction.v1.CreateBatchTransferResponse.batch:type_name -> transaction.v1.Batch
+	45, // 46: transaction.v1.GetBatchTransferResponse.batch:type_name -> transaction.v1.Batch
+	44, // 47: transaction.v1.GetBatchTransferResponse.items:type_name -> transaction.v1.BatchItem
+	19, // 48: transaction.v1.ApproveTransferResponse.transaction:type_name -> transaction.v1.Transaction
+	19, // 49: transaction.v1.RejectTransferResponse.transaction:type_name -> transaction.v1.Transaction
+	8,  // 50: transaction.v1.GetRiskDecisionResponse.outcome:type_name -> transaction.v1.RiskOutcome
+	54, // 51: transaction.v1.GetRiskDecisionResponse.hits:type_name -> transaction.v1.RiskRuleHit
+	72, // 52: transaction.v1.GetRiskDecisionResponse.screened_at:type_name -> google.protobuf.Timestamp
+	72, // 53: transaction.v1.ScreeningHit.screened_at:type_name -> google.protobuf.Timestamp
+	57, // 54: transaction.v1.ListScreeningHitsResponse.hits:type_name -> transaction.v1.ScreeningHit
+	9,  // 55: transaction.v1.WebhookEndpoint.status:type_name -> transaction.v1.WebhookEndpointStatus
+	72, // 56: transaction.v1.WebhookEndpoint.created_at:type_name -> google.protobuf.Timestamp
+	60, // 57: transaction.v1.CreateWebhookEndpointResponse.endpoint:type_name -> transaction.v1.WebhookEndpoint
+	60, // 58: transaction.v1.ListWebhookEndpointsResponse.endpoints:type_name -> transaction.v1.WebhookEndpoint
+	60, // 59: transaction.v1.DisableWebhookEndpointResponse.endpoint:type_name -> transaction.v1.WebhookEndpoint
+	10, // 60: transaction.v1.WebhookDelivery.status:type_name -> transaction.v1.WebhookDeliveryStatus
+	72, // 61: transaction.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	72, // 62: transaction.v1.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	67, // 63: transaction.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> transaction.v1.WebhookDelivery
+	67, // 64: transaction.v1.ReplayWebhookDeliveryResponse.delivery:type_name -> transaction.v1.WebhookDelivery
+	11, // 65: transaction.v1.TransactionService.CreateTransfer:input_type -> transaction.v1.CreateTransferRequest
+	15, // 66: transaction.v1.TransactionService.QuoteTransfer:input_type -> transaction.v1.QuoteTransferRequest
+	17, // 67: transaction.v1.TransactionService.GetTransactionStatus:input_type -> transaction.v1.GetTransactionStatusRequest
+	24, // 68: transaction.v1.TransactionService.ListTransactions:input_type -> transaction.v1.ListTransactionsRequest
+	20, // 69: transaction.v1.TransactionService.ReverseTransfer:input_type -> transaction.v1.ReverseTransferRequest
+	22, // 70: transaction.v1.TransactionService.RefundTransfer:input_type -> transaction.v1.RefundTransferRequest
+	27, // 71: transaction.v1.TransactionService.AuthorizeHold:input_type -> transaction.v1.AuthorizeHoldRequest
+	29, // 72: transaction.v1.TransactionService.CaptureHold:input_type -> transaction.v1.CaptureHoldRequest
+	31, // 73: transaction.v1.TransactionService.VoidHold:input_type -> transaction.v1.VoidHoldRequest
+	35, // 74: transaction.v1.TransactionService.CreateScheduledTransfer:input_type -> transaction.v1.CreateScheduledTransferRequest
+	37, // 75: transaction.v1.TransactionService.ListSchedules:input_type -> transaction.v1.ListSchedulesRequest
+	39, // 76: transaction.v1.TransactionService.CancelSchedule:input_type -> transaction.v1.CancelScheduleRequest
+	41, // 77: transaction.v1.TransactionService.ListScheduleRuns:input_type -> transaction.v1.ListScheduleRunsRequest
+	46, // 78: transaction.v1.TransactionService.CreateBatchTransfer:input_type -> transaction.v1.CreateBatchTransferRequest
+	48, // 79: transaction.v1.TransactionService.GetBatchTransfer:input_type -> transaction.v1.GetBatchTransferRequest
+	50, // 80: transaction.v1.TransactionService.ApproveTransfer:input_type -> transaction.v1.ApproveTransferRequest
+	52, // 81: transaction.v1.TransactionService.RejectTransfer:input_type -> transaction.v1.RejectTransferRequest
+	55, // 82: transaction.v1.TransactionService.GetRiskDecision:input_type -> transaction.v1.GetRiskDecisionRequest
+	58, // 83: transaction.v1.TransactionService.ListScreeningHits:input_type -> transaction.v1.ListScreeningHitsRequest
+	61, // 84: transaction.v1.TransactionService.CreateWebhookEndpoint:input_type -> transaction.v1.CreateWebhookEndpointRequest
+	63, // 85: transaction.v1.TransactionService.ListWebhookEndpoints:input_type -> transaction.v1.ListWebhookEndpointsRequest
+	65, // 86: transaction.v1.TransactionService.DisableWebhookEndpoint:input_type -> transaction.v1.DisableWebhookEndpointRequest
+	68, // 87: transaction.v1.TransactionService.ListWebhookDeliveries:input_type -> transaction.v1.ListWebhookDeliveriesRequest
+	70, // 88: transaction.v1.TransactionService.ReplayWebhookDelivery:input_type -> transaction.v1.ReplayWebhookDeliveryRequest
+	12, // 89: transaction.v1.TransactionService.CreateTransfer:output_type -> transaction.v1.CreateTransferResponse
+	16, // 90: transaction.v1.TransactionService.QuoteTransfer:output_type -> transaction.v1.QuoteTransferResponse
+	18, // 91: transaction.v1.TransactionService.GetTransactionStatus:output_type -> transaction.v1.GetTransactionStatusResponse
+	25, // 92: transaction.v1.TransactionService.ListTransactions:output_type -> transaction.v1.ListTransactionsResponse
+	21, // 93: transaction.v1.TransactionService.ReverseTransfer:output_type -> transaction.v1.ReverseTransferResponse
+	23, // 94: transaction.v1.TransactionService.RefundTransfer:output_type -> transaction.v1.RefundTransferResponse
+	28, // 95: transaction.v1.TransactionService.AuthorizeHold:output_type -> transaction.v1.AuthorizeHoldResponse
+	30, // 96: transaction.v1.TransactionService.CaptureHold:output_type -> transaction.v1.CaptureHoldResponse
+	32, // 97: transaction.v1.TransactionService.VoidHold:output_type -> transaction.v1.VoidHoldResponse
+	36, // 98: transaction.v1.TransactionService.CreateScheduledTransfer:output_type -> transaction.v1.CreateScheduledTransferResponse
+	38, // 99: transaction.v1.TransactionService.ListSchedules:output_type -> transaction.v1.ListSchedulesResponse
+	40, // 100: transaction.v1.TransactionService.CancelSchedule:output_type -> transaction.v1.CancelScheduleResponse
+	42, // 101: transaction.v1.TransactionService.ListScheduleRuns:output_type -> transaction.v1.ListScheduleRunsResponse
+	47, // 102: transaction.v1.TransactionService.CreateBatchTransfer:output_type -> transaction.v1.CreateBatchTransferResponse
+	49, // 103: transaction.v1.TransactionService.GetBatchTransfer:output_type -> transaction.v1.GetBatchTransferResponse
+	51, // 104: transaction.v1.TransactionService.ApproveTransfer:output_type -> transaction.v1.ApproveTransferResponse
+	53, // 105: transaction.v1.TransactionService.RejectTransfer:output_type -> transaction.v1.RejectTransferResponse
+	56, // 106: transaction.v1.TransactionService.GetRiskDecision:output_type -> transaction.v1.GetRiskDecisionResponse
+	59, // 107: transaction.v1.TransactionService.ListScreeningHits:output_type -> transaction.v1.ListScreeningHitsResponse
+	62, // 108: transaction.v1.TransactionService.CreateWebhookEndpoint:output_type -> transaction.v1.CreateWebhookEndpointResponse
+	64, // 109: transaction.v1.TransactionService.ListWebhookEndpoints:output_type -> transaction.v1.ListWebhookEndpointsResponse
+	66, // 110: transaction.v1.TransactionService.DisableWebhookEndpoint:output_type -> transaction.v1.DisableWebhookEndpointResponse
+	69, // 111: transaction.v1.TransactionService.ListWebhookDeliveries:output_type -> transaction.v1.ListWebhookDeliveriesResponse
+	71, // 112: transaction.v1.TransactionService.ReplayWebhookDelivery:output_type -> transaction.v1.ReplayWebhookDeliveryResponse
+	89, // [89:113] is the sub-list for method output_type
+	65, // [65:89] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_api_transaction_v1_transaction_proto_init() }
@@ -549,14 +5595,18 @@ func file_api_transaction_v1_transaction_proto_init() {
 		return
 	}
 	file_api_transaction_v1_transaction_proto_msgTypes[0].OneofWrappers = []any{}
-	file_api_transaction_v1_transaction_proto_msgTypes[4].OneofWrappers = []any{}
+	file_api_transaction_v1_transaction_proto_msgTypes[8].OneofWrappers = []any{}
+	file_api_transaction_v1_transaction_proto_msgTypes[18].OneofWrappers = []any{}
+	file_api_transaction_v1_transaction_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_transaction_v1_transaction_proto_msgTypes[24].OneofWrappers = []any{}
+	file_api_transaction_v1_transaction_proto_msgTypes[56].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_transaction_v1_transaction_proto_rawDesc), len(file_api_transaction_v1_transaction_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      11,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/transaction/v1/fx.proto
+
+package transactionv1connect
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// FxServiceName is the fully-qualified name of the FxService service.
+	FxServiceName = "transaction.v1.FxService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// FxServiceQuoteFxProcedure is the fully-qualified name of the FxService's QuoteFx RPC.
+	FxServiceQuoteFxProcedure = "/transaction.v1.FxService/QuoteFx"
+	// FxServiceSetFxRateProcedure is the fully-qualified name of the FxService's SetFxRate RPC.
+	FxServiceSetFxRateProcedure = "/transaction.v1.FxService/SetFxRate"
+	// FxServiceListFxRatesProcedure is the fully-qualified name of the FxService's ListFxRates RPC.
+	FxServiceListFxRatesProcedure = "/transaction.v1.FxService/ListFxRates"
+)
+
+// FxServiceClient is a client for the transaction.v1.FxService service.
+type FxServiceClient interface {
+	// QuoteFx prices a conversion and locks the rate for a limited time.
+	QuoteFx(context.Context, *connect_go.Request[v1.QuoteFxRequest]) (*connect_go.Response[v1.QuoteFxResponse], error)
+	// SetFxRate sets the mid-market rate for a currency pair (admin).
+	SetFxRate(context.Context, *connect_go.Request[v1.SetFxRateRequest]) (*connect_go.Response[v1.SetFxRateResponse], error)
+	// ListFxRates returns the configured rates.
+	ListFxRates(context.Context, *connect_go.Request[v1.ListFxRatesRequest]) (*connect_go.Response[v1.ListFxRatesResponse], error)
+}
+
+// NewFxServiceClient constructs a client for the transaction.v1.FxService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewFxServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) FxServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &fxServiceClient{
+		quoteFx: connect_go.NewClient[v1.QuoteFxRequest, v1.QuoteFxResponse](
+			httpClient,
+			baseURL+FxServiceQuoteFxProcedure,
+			opts...,
+		),
+		setFxRate: connect_go.NewClient[v1.SetFxRateRequest, v1.SetFxRateResponse](
+			httpClient,
+			baseURL+FxServiceSetFxRateProcedure,
+			opts...,
+		),
+		listFxRates: connect_go.NewClient[v1.ListFxRatesRequest, v1.ListFxRatesResponse](
+			httpClient,
+			baseURL+FxServiceListFxRatesProcedure,
+			opts...,
+		),
+	}
+}
+
+// fxServiceClient implements FxServiceClient.
+type fxServiceClient struct {
+	quoteFx     *connect_go.Client[v1.QuoteFxRequest, v1.QuoteFxResponse]
+	setFxRate   *connect_go.Client[v1.SetFxRateRequest, v1.SetFxRateResponse]
+	listFxRates *connect_go.Client[v1.ListFxRatesRequest, v1.ListFxRatesResponse]
+}
+
+// QuoteFx calls transaction.v1.FxService.QuoteFx.
+func (c *fxServiceClient) QuoteFx(ctx context.Context, req *connect_go.Request[v1.QuoteFxRequest]) (*connect_go.Response[v1.QuoteFxResponse], error) {
+	return c.quoteFx.CallUnary(ctx, req)
+}
+
+// SetFxRate calls transaction.v1.FxService.SetFxRate.
+func (c *fxServiceClient) SetFxRate(ctx context.Context, req *connect_go.Request[v1.SetFxRateRequest]) (*connect_go.Response[v1.SetFxRateResponse], error) {
+	return c.setFxRate.CallUnary(ctx, req)
+}
+
+// ListFxRates calls transaction.v1.FxService.ListFxRates.
+func (c *fxServiceClient) ListFxRates(ctx context.Context, req *connect_go.Request[v1.ListFxRatesRequest]) (*connect_go.Response[v1.ListFxRatesResponse], error) {
+	return c.listFxRates.CallUnary(ctx, req)
+}
+
+// FxServiceHandler is an implementation of the transaction.v1.FxService service.
+type FxServiceHandler interface {
+	// QuoteFx prices a conversion and locks the rate for a limited time.
+	QuoteFx(context.Context, *connect_go.Request[v1.QuoteFxRequest]) (*connect_go.Response[v1.QuoteFxResponse], error)
+	// SetFxRate sets the mid-market rate for a currency pair (admin).
+	SetFxRate(context.Context, *connect_go.Request[v1.SetFxRateRequest]) (*connect_go.Response[v1.SetFxRateResponse], error)
+	// ListFxRates returns the configured rates.
+	ListFxRates(context.Context, *connect_go.Request[v1.ListFxRatesRequest]) (*connect_go.Response[v1.ListFxRatesResponse], error)
+}
+
+// NewFxServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewFxServiceHandler(svc FxServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	fxServiceQuoteFxHandler := connect_go.NewUnaryHandler(
+		FxServiceQuoteFxProcedure,
+		svc.QuoteFx,
+		opts...,
+	)
+	fxServiceSetFxRateHandler := connect_go.NewUnaryHandler(
+		FxServiceSetFxRateProcedure,
+		svc.SetFxRate,
+		opts...,
+	)
+	fxServiceListFxRatesHandler := connect_go.NewUnaryHandler(
+		FxServiceListFxRatesProcedure,
+		svc.ListFxRates,
+		opts...,
+	)
+	return "/transaction.v1.FxService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case FxServiceQuoteFxProcedure:
+			fxServiceQuoteFxHandler.ServeHTTP(w, r)
+		case FxServiceSetFxRateProcedure:
+			fxServiceSetFxRateHandler.ServeHTTP(w, r)
+		case FxServiceListFxRatesProcedure:
+			fxServiceListFxRatesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedFxServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedFxServiceHandler struct{}
+
+func (UnimplementedFxServiceHandler) QuoteFx(context.Context, *connect_go.Request[v1.QuoteFxRequest]) (*connect_go.Response[v1.QuoteFxResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.FxService.QuoteFx is not implemented"))
+}
+
+func (UnimplementedFxServiceHandler) SetFxRate(context.Context, *connect_go.Request[v1.SetFxRateRequest]) (*connect_go.Response[v1.SetFxRateResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.FxService.SetFxRate is not implemented"))
+}
+
+func (UnimplementedFxServiceHandler) ListFxRates(context.Context, *connect_go.Request[v1.ListFxRatesRequest]) (*connect_go.Response[v1.ListFxRatesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.FxService.ListFxRates is not implemented"))
+}
@@ -36,17 +36,132 @@ const (
 	// TransactionServiceCreateTransferProcedure is the fully-qualified name of the TransactionService's
 	// CreateTransfer RPC.
 	TransactionServiceCreateTransferProcedure = "/transaction.v1.TransactionService/CreateTransfer"
+	// TransactionServiceQuoteTransferProcedure is the fully-qualified name of the TransactionService's
+	// QuoteTransfer RPC.
+	TransactionServiceQuoteTransferProcedure = "/transaction.v1.TransactionService/QuoteTransfer"
 	// TransactionServiceGetTransactionStatusProcedure is the fully-qualified name of the
 	// TransactionService's GetTransactionStatus RPC.
 	TransactionServiceGetTransactionStatusProcedure = "/transaction.v1.TransactionService/GetTransactionStatus"
+	// TransactionServiceListTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's ListTransactions RPC.
+	TransactionServiceListTransactionsProcedure = "/transaction.v1.TransactionService/ListTransactions"
+	// TransactionServiceReverseTransferProcedure is the fully-qualified name of the
+	// TransactionService's ReverseTransfer RPC.
+	TransactionServiceReverseTransferProcedure = "/transaction.v1.TransactionService/ReverseTransfer"
+	// TransactionServiceRefundTransferProcedure is the fully-qualified name of the TransactionService's
+	// RefundTransfer RPC.
+	TransactionServiceRefundTransferProcedure = "/transaction.v1.TransactionService/RefundTransfer"
+	// TransactionServiceAuthorizeHoldProcedure is the fully-qualified name of the TransactionService's
+	// AuthorizeHold RPC.
+	TransactionServiceAuthorizeHoldProcedure = "/transaction.v1.TransactionService/AuthorizeHold"
+	// TransactionServiceCaptureHoldProcedure is the fully-qualified name of the TransactionService's
+	// CaptureHold RPC.
+	TransactionServiceCaptureHoldProcedure = "/transaction.v1.TransactionService/CaptureHold"
+	// TransactionServiceVoidHoldProcedure is the fully-qualified name of the TransactionService's
+	// VoidHold RPC.
+	TransactionServiceVoidHoldProcedure = "/transaction.v1.TransactionService/VoidHold"
+	// TransactionServiceCreateScheduledTransferProcedure is the fully-qualified name of the
+	// TransactionService's CreateScheduledTransfer RPC.
+	TransactionServiceCreateScheduledTransferProcedure = "/transaction.v1.TransactionService/CreateScheduledTransfer"
+	// TransactionServiceListSchedulesProcedure is the fully-qualified name of the TransactionService's
+	// ListSchedules RPC.
+	TransactionServiceListSchedulesProcedure = "/transaction.v1.TransactionService/ListSchedules"
+	// TransactionServiceCancelScheduleProcedure is the fully-qualified name of the TransactionService's
+	// CancelSchedule RPC.
+	TransactionServiceCancelScheduleProcedure = "/transaction.v1.TransactionService/CancelSchedule"
+	// TransactionServiceListScheduleRunsProcedure is the fully-qualified name of the
+	// TransactionService's ListScheduleRuns RPC.
+	TransactionServiceListScheduleRunsProcedure = "/transaction.v1.TransactionService/ListScheduleRuns"
+	// TransactionServiceCreateBatchTransferProcedure is the fully-qualified name of the
+	// TransactionService's CreateBatchTransfer RPC.
+	TransactionServiceCreateBatchTransferProcedure = "/transaction.v1.TransactionService/CreateBatchTransfer"
+	// TransactionServiceGetBatchTransferProcedure is the fully-qualified name of the
+	// TransactionService's GetBatchTransfer RPC.
+	TransactionServiceGetBatchTransferProcedure = "/transaction.v1.TransactionService/GetBatchTransfer"
+	// TransactionServiceApproveTransferProcedure is the fully-qualified name of the
+	// TransactionService's ApproveTransfer RPC.
+	TransactionServiceApproveTransferProcedure = "/transaction.v1.TransactionService/ApproveTransfer"
+	// TransactionServiceRejectTransferProcedure is the fully-qualified name of the TransactionService's
+	// RejectTransfer RPC.
+	TransactionServiceRejectTransferProcedure = "/transaction.v1.TransactionService/RejectTransfer"
+	// TransactionServiceGetRiskDecisionProcedure is the fully-qualified name of the
+	// TransactionService's GetRiskDecision RPC.
+	TransactionServiceGetRiskDecisionProcedure = "/transaction.v1.TransactionService/GetRiskDecision"
+	// TransactionServiceListScreeningHitsProcedure is the fully-qualified name of the
+	// TransactionService's ListScreeningHits RPC.
+	TransactionServiceListScreeningHitsProcedure = "/transaction.v1.TransactionService/ListScreeningHits"
+	// TransactionServiceCreateWebhookEndpointProcedure is the fully-qualified name of the
+	// TransactionService's CreateWebhookEndpoint RPC.
+	TransactionServiceCreateWebhookEndpointProcedure = "/transaction.v1.TransactionService/CreateWebhookEndpoint"
+	// TransactionServiceListWebhookEndpointsProcedure is the fully-qualified name of the
+	// TransactionService's ListWebhookEndpoints RPC.
+	TransactionServiceListWebhookEndpointsProcedure = "/transaction.v1.TransactionService/ListWebhookEndpoints"
+	// TransactionServiceDisableWebhookEndpointProcedure is the fully-qualified name of the
+	// TransactionService's DisableWebhookEndpoint RPC.
+	TransactionServiceDisableWebhookEndpointProcedure = "/transaction.v1.TransactionService/DisableWebhookEndpoint"
+	// TransactionServiceListWebhookDeliveriesProcedure is the fully-qualified name of the
+	// TransactionService's ListWebhookDeliveries RPC.
+	TransactionServiceListWebhookDeliveriesProcedure = "/transaction.v1.TransactionService/ListWebhookDeliveries"
+	// TransactionServiceReplayWebhookDeliveryProcedure is the fully-qualified name of the
+	// TransactionService's ReplayWebhookDelivery RPC.
+	TransactionServiceReplayWebhookDeliveryProcedure = "/transaction.v1.TransactionService/ReplayWebhookDelivery"
 )
 
 // TransactionServiceClient is a client for the transaction.v1.TransactionService service.
 type TransactionServiceClient interface {
 	// CreateTransfer initiates a funds transfer between two accounts.
 	CreateTransfer(context.Context, *connect_go.Request[v1.CreateTransferRequest]) (*connect_go.Response[v1.CreateTransferResponse], error)
+	// QuoteTransfer returns the fees for a transfer without moving money.
+	QuoteTransfer(context.Context, *connect_go.Request[v1.QuoteTransferRequest]) (*connect_go.Response[v1.QuoteTransferResponse], error)
 	// GetTransactionStatus returns the status of a previously created transaction.
 	GetTransactionStatus(context.Context, *connect_go.Request[v1.GetTransactionStatusRequest]) (*connect_go.Response[v1.GetTransactionStatusResponse], error)
+	// ListTransactions returns a page of an account's transaction history.
+	ListTransactions(context.Context, *connect_go.Request[v1.ListTransactionsRequest]) (*connect_go.Response[v1.ListTransactionsResponse], error)
+	// ReverseTransfer moves the unrefunded remainder of a completed transfer back to the sender.
+	ReverseTransfer(context.Context, *connect_go.Request[v1.ReverseTransferRequest]) (*connect_go.Response[v1.ReverseTransferResponse], error)
+	// RefundTransfer moves part of a completed transfer back to the sender.
+	RefundTransfer(context.Context, *connect_go.Request[v1.RefundTransferRequest]) (*connect_go.Response[v1.RefundTransferResponse], error)
+	// AuthorizeHold reserves funds on an account, reducing its available balance.
+	AuthorizeHold(context.Context, *connect_go.Request[v1.AuthorizeHoldRequest]) (*connect_go.Response[v1.AuthorizeHoldResponse], error)
+	// CaptureHold moves all or part of a hold to a recipient and releases the rest.
+	// A capture denied by the risk rules is returned FAILED with the hold still active. One
+	// held by the risk rules, matching the sanctions list or above the approval threshold is
+	// returned AWAITING_APPROVAL with the hold still active; it completes once released
+	// through ApproveTransfer.
+	CaptureHold(context.Context, *connect_go.Request[v1.CaptureHoldRequest]) (*connect_go.Response[v1.CaptureHoldResponse], error)
+	// VoidHold releases a hold without moving funds.
+	VoidHold(context.Context, *connect_go.Request[v1.VoidHoldRequest]) (*connect_go.Response[v1.VoidHoldResponse], error)
+	// CreateScheduledTransfer sets up a transfer that repeats on a cron schedule.
+	CreateScheduledTransfer(context.Context, *connect_go.Request[v1.CreateScheduledTransferRequest]) (*connect_go.Response[v1.CreateScheduledTransferResponse], error)
+	// ListSchedules returns the scheduled transfers of a sender account.
+	ListSchedules(context.Context, *connect_go.Request[v1.ListSchedulesRequest]) (*connect_go.Response[v1.ListSchedulesResponse], error)
+	// CancelSchedule stops a scheduled transfer.
+	CancelSchedule(context.Context, *connect_go.Request[v1.CancelScheduleRequest]) (*connect_go.Response[v1.CancelScheduleResponse], error)
+	// ListScheduleRuns returns the run history of a scheduled transfer.
+	ListScheduleRuns(context.Context, *connect_go.Request[v1.ListScheduleRunsRequest]) (*connect_go.Response[v1.ListScheduleRunsResponse], error)
+	// CreateBatchTransfer reserves the total of many payouts from one sender and pays them.
+	CreateBatchTransfer(context.Context, *connect_go.Request[v1.CreateBatchTransferRequest]) (*connect_go.Response[v1.CreateBatchTransferResponse], error)
+	// GetBatchTransfer returns the progress of a batch and the status of its items.
+	GetBatchTransfer(context.Context, *connect_go.Request[v1.GetBatchTransferRequest]) (*connect_go.Response[v1.GetBatchTransferResponse], error)
+	// ApproveTransfer lets a transfer that is AWAITING_APPROVAL proceed.
+	ApproveTransfer(context.Context, *connect_go.Request[v1.ApproveTransferRequest]) (*connect_go.Response[v1.ApproveTransferResponse], error)
+	// RejectTransfer stops a transfer that is AWAITING_APPROVAL without moving funds.
+	RejectTransfer(context.Context, *connect_go.Request[v1.RejectTransferRequest]) (*connect_go.Response[v1.RejectTransferResponse], error)
+	// GetRiskDecision returns how risk screening judged a transfer, for audit.
+	GetRiskDecision(context.Context, *connect_go.Request[v1.GetRiskDecisionRequest]) (*connect_go.Response[v1.GetRiskDecisionResponse], error)
+	// ListScreeningHits returns the sanctions list matches that held a transfer for review.
+	ListScreeningHits(context.Context, *connect_go.Request[v1.ListScreeningHitsRequest]) (*connect_go.Response[v1.ListScreeningHitsResponse], error)
+	// CreateWebhookEndpoint registers a merchant URL for transaction events.
+	CreateWebhookEndpoint(context.Context, *connect_go.Request[v1.CreateWebhookEndpointRequest]) (*connect_go.Response[v1.CreateWebhookEndpointResponse], error)
+	// ListWebhookEndpoints returns a merchant's webhook endpoints.
+	ListWebhookEndpoints(context.Context, *connect_go.Request[v1.ListWebhookEndpointsRequest]) (*connect_go.Response[v1.ListWebhookEndpointsResponse], error)
+	// DisableWebhookEndpoint stops deliveries to an endpoint, including pending retries.
+	DisableWebhookEndpoint(context.Context, *connect_go.Request[v1.DisableWebhookEndpointRequest]) (*connect_go.Response[v1.DisableWebhookEndpointResponse], error)
+	// ListWebhookDeliveries returns the delivery log of an endpoint.
+	ListWebhookDeliveries(context.Context, *connect_go.Request[v1.ListWebhookDeliveriesRequest]) (*connect_go.Response[v1.ListWebhookDeliveriesResponse], error)
+	// ReplayWebhookDelivery sends a DELIVERED or FAILED delivery again. A PENDING delivery
+	// whose sending was never started, such as a replay that failed, is started again.
+	ReplayWebhookDelivery(context.Context, *connect_go.Request[v1.ReplayWebhookDeliveryRequest]) (*connect_go.Response[v1.ReplayWebhookDeliveryResponse], error)
 }
 
 // NewTransactionServiceClient constructs a client for the transaction.v1.TransactionService
@@ -64,18 +179,150 @@ func NewTransactionServiceClient(httpClient connect_go.HTTPClient, baseURL strin
 			baseURL+TransactionServiceCreateTransferProcedure,
 			opts...,
 		),
+		quoteTransfer: connect_go.NewClient[v1.QuoteTransferRequest, v1.QuoteTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceQuoteTransferProcedure,
+			opts...,
+		),
 		getTransactionStatus: connect_go.NewClient[v1.GetTransactionStatusRequest, v1.GetTransactionStatusResponse](
 			httpClient,
 			baseURL+TransactionServiceGetTransactionStatusProcedure,
 			opts...,
 		),
+		listTransactions: connect_go.NewClient[v1.ListTransactionsRequest, v1.ListTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServiceListTransactionsProcedure,
+			opts...,
+		),
+		reverseTransfer: connect_go.NewClient[v1.ReverseTransferRequest, v1.ReverseTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceReverseTransferProcedure,
+			opts...,
+		),
+		refundTransfer: connect_go.NewClient[v1.RefundTransferRequest, v1.RefundTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceRefundTransferProcedure,
+			opts...,
+		),
+		authorizeHold: connect_go.NewClient[v1.AuthorizeHoldRequest, v1.AuthorizeHoldResponse](
+			httpClient,
+			baseURL+TransactionServiceAuthorizeHoldProcedure,
+			opts...,
+		),
+		captureHold: connect_go.NewClient[v1.CaptureHoldRequest, v1.CaptureHoldResponse](
+			httpClient,
+			baseURL+TransactionServiceCaptureHoldProcedure,
+			opts...,
+		),
+		voidHold: connect_go.NewClient[v1.VoidHoldRequest, v1.VoidHoldResponse](
+			httpClient,
+			baseURL+TransactionServiceVoidHoldProcedure,
+			opts...,
+		),
+		createScheduledTransfer: connect_go.NewClient[v1.CreateScheduledTransferRequest, v1.CreateScheduledTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceCreateScheduledTransferProcedure,
+			opts...,
+		),
+		listSchedules: connect_go.NewClient[v1.ListSchedulesRequest, v1.ListSchedulesResponse](
+			httpClient,
+			baseURL+TransactionServiceListSchedulesProcedure,
+			opts...,
+		),
+		cancelSchedule: connect_go.NewClient[v1.CancelScheduleRequest, v1.CancelScheduleResponse](
+			httpClient,
+			baseURL+TransactionServiceCancelScheduleProcedure,
+			opts...,
+		),
+		listScheduleRuns: connect_go.NewClient[v1.ListScheduleRunsRequest, v1.ListScheduleRunsResponse](
+			httpClient,
+			baseURL+TransactionServiceListScheduleRunsProcedure,
+			opts...,
+		),
+		createBatchTransfer: connect_go.NewClient[v1.CreateBatchTransferRequest, v1.CreateBatchTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceCreateBatchTransferProcedure,
+			opts...,
+		),
+		getBatchTransfer: connect_go.NewClient[v1.GetBatchTransferRequest, v1.GetBatchTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceGetBatchTransferProcedure,
+			opts...,
+		),
+		approveTransfer: connect_go.NewClient[v1.ApproveTransferRequest, v1.ApproveTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceApproveTransferProcedure,
+			opts...,
+		),
+		rejectTransfer: connect_go.NewClient[v1.RejectTransferRequest, v1.RejectTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceRejectTransferProcedure,
+			opts...,
+		),
+		getRiskDecision: connect_go.NewClient[v1.GetRiskDecisionRequest, v1.GetRiskDecisionResponse](
+			httpClient,
+			baseURL+TransactionServiceGetRiskDecisionProcedure,
+			opts...,
+		),
+		listScreeningHits: connect_go.NewClient[v1.ListScreeningHitsRequest, v1.ListScreeningHitsResponse](
+			httpClient,
+			baseURL+TransactionServiceListScreeningHitsProcedure,
+			opts...,
+		),
+		createWebhookEndpoint: connect_go.NewClient[v1.CreateWebhookEndpointRequest, v1.CreateWebhookEndpointResponse](
+			httpClient,
+			baseURL+TransactionServiceCreateWebhookEndpointProcedure,
+			opts...,
+		),
+		listWebhookEndpoints: connect_go.NewClient[v1.ListWebhookEndpointsRequest, v1.ListWebhookEndpointsResponse](
+			httpClient,
+			baseURL+TransactionServiceListWebhookEndpointsProcedure,
+			opts...,
+		),
+		disableWebhookEndpoint: connect_go.NewClient[v1.DisableWebhookEndpointRequest, v1.DisableWebhookEndpointResponse](
+			httpClient,
+			baseURL+TransactionServiceDisableWebhookEndpointProcedure,
+			opts...,
+		),
+		listWebhookDeliveries: connect_go.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+TransactionServiceListWebhookDeliveriesProcedure,
+			opts...,
+		),
+		replayWebhookDelivery: connect_go.NewClient[v1.ReplayWebhookDeliveryRequest, v1.ReplayWebhookDeliveryResponse](
+			httpClient,
+			baseURL+TransactionServiceReplayWebhookDeliveryProcedure,
+			opts...,
+		),
 	}
 }
 
 // transactionServiceClient implements TransactionServiceClient.
 type transactionServiceClient struct {
-	createTransfer       *connect_go.Client[v1.CreateTransferRequest, v1.CreateTransferResponse]
-	getTransactionStatus *connect_go.Client[v1.GetTransactionStatusRequest, v1.GetTransactionStatusResponse]
+	createTransfer          *connect_go.Client[v1.CreateTransferRequest, v1.CreateTransferResponse]
+	quoteTransfer           *connect_go.Client[v1.QuoteTransferRequest, v1.QuoteTransferResponse]
+	getTransactionStatus    *connect_go.Client[v1.GetTransactionStatusRequest, v1.GetTransactionStatusResponse]
+	listTransactions        *connect_go.Client[v1.ListTransactionsRequest, v1.ListTransactionsResponse]
+	reverseTransfer         *connect_go.Client[v1.ReverseTransferRequest, v1.ReverseTransferResponse]
+	refundTransfer          *connect_go.Client[v1.RefundTransferRequest, v1.RefundTransferResponse]
+	authorizeHold           *connect_go.Client[v1.AuthorizeHoldRequest, v1.AuthorizeHoldResponse]
+	captureHold             *connect_go.Client[v1.CaptureHoldRequest, v1.CaptureHoldResponse]
+	voidHold                *connect_go.Client[v1.VoidHoldRequest, v1.VoidHoldResponse]
+	createScheduledTransfer *connect_go.Client[v1.CreateScheduledTransferRequest, v1.CreateScheduledTransferResponse]
+	listSchedules           *connect_go.Client[v1.ListSchedulesRequest, v1.ListSchedulesResponse]
+	cancelSchedule          *connect_go.Client[v1.CancelScheduleRequest, v1.CancelScheduleResponse]
+	listScheduleRuns        *connect_go.Client[v1.ListScheduleRunsRequest, v1.ListScheduleRunsResponse]
+	createBatchTransfer     *connect_go.Client[v1.CreateBatchTransferRequest, v1.CreateBatchTransferResponse]
+	getBatchTransfer        *connect_go.Client[v1.GetBatchTransferRequest, v1.GetBatchTransferResponse]
+	approveTransfer         *connect_go.Client[v1.ApproveTransferRequest, v1.ApproveTransferResponse]
+	rejectTransfer          *connect_go.Client[v1.RejectTransferRequest, v1.RejectTransferResponse]
+	getRiskDecision         *connect_go.Client[v1.GetRiskDecisionRequest, v1.GetRiskDecisionResponse]
+	listScreeningHits       *connect_go.Client[v1.ListScreeningHitsRequest, v1.ListScreeningHitsResponse]
+	createWebhookEndpoint   *connect_go.Client[v1.CreateWebhookEndpointRequest, v1.CreateWebhookEndpointResponse]
+	listWebhookEndpoints    *connect_go.Client[v1.ListWebhookEndpointsRequest, v1.ListWebhookEndpointsResponse]
+	disableWebhookEndpoint  *connect_go.Client[v1.DisableWebhookEndpointRequest, v1.DisableWebhookEndpointResponse]
+	listWebhookDeliveries   *connect_go.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+	replayWebhookDelivery   *connect_go.Client[v1.ReplayWebhookDeliveryRequest, v1.ReplayWebhookDeliveryResponse]
 }
 
 // CreateTransfer calls transaction.v1.TransactionService.CreateTransfer.
@@ -83,17 +330,176 @@ func (c *transactionServiceClient) CreateTransfer(ctx context.Context, req *conn
 	return c.createTransfer.CallUnary(ctx, req)
 }
 
+// QuoteTransfer calls transaction.v1.TransactionService.QuoteTransfer.
+func (c *transactionServiceClient) QuoteTransfer(ctx context.Context, req *connect_go.Request[v1.QuoteTransferRequest]) (*connect_go.Response[v1.QuoteTransferResponse], error) {
+	return c.quoteTransfer.CallUnary(ctx, req)
+}
+
 // GetTransactionStatus calls transaction.v1.TransactionService.GetTransactionStatus.
 func (c *transactionServiceClient) GetTransactionStatus(ctx context.Context, req *connect_go.Request[v1.GetTransactionStatusRequest]) (*connect_go.Response[v1.GetTransactionStatusResponse], error) {
 	return c.getTransactionStatus.CallUnary(ctx, req)
 }
 
+// ListTransactions calls transaction.v1.TransactionService.ListTransactions.
+func (c *transactionServiceClient) ListTransactions(ctx context.Context, req *connect_go.Request[v1.ListTransactionsRequest]) (*connect_go.Response[v1.ListTransactionsResponse], error) {
+	return c.listTransactions.CallUnary(ctx, req)
+}
+
+// ReverseTransfer calls transaction.v1.TransactionService.ReverseTransfer.
+func (c *transactionServiceClient) ReverseTransfer(ctx context.Context, req *connect_go.Request[v1.ReverseTransferRequest]) (*connect_go.Response[v1.ReverseTransferResponse], error) {
+	return c.reverseTransfer.CallUnary(ctx, req)
+}
+
+// RefundTransfer calls transaction.v1.TransactionService.RefundTransfer.
+func (c *transactionServiceClient) RefundTransfer(ctx context.Context, req *connect_go.Request[v1.RefundTransferRequest]) (*connect_go.Response[v1.RefundTransferResponse], error) {
+	return c.refundTransfer.CallUnary(ctx, req)
+}
+
+// AuthorizeHold calls transaction.v1.TransactionService.AuthorizeHold.
+func (c *transactionServiceClient) AuthorizeHold(ctx context.Context, req *connect_go.Request[v1.AuthorizeHoldRequest]) (*connect_go.Response[v1.AuthorizeHoldResponse], error) {
+	return c.authorizeHold.CallUnary(ctx, req)
+}
+
+// CaptureHold calls transaction.v1.TransactionService.CaptureHold.
+func (c *transactionServiceClient) CaptureHold(ctx context.Context, req *connect_go.Request[v1.CaptureHoldRequest]) (*connect_go.Response[v1.CaptureHoldResponse], error) {
+	return c.captureHold.CallUnary(ctx, req)
+}
+
+// VoidHold calls transaction.v1.TransactionService.VoidHold.
+func (c *transactionServiceClient) VoidHold(ctx context.Context, req *connect_go.Request[v1.VoidHoldRequest]) (*connect_go.Response[v1.VoidHoldResponse], error) {
+	return c.voidHold.CallUnary(ctx, req)
+}
+
+// CreateScheduledTransfer calls transaction.v1.TransactionService.CreateScheduledTransfer.
+func (c *transactionServiceClient) CreateScheduledTransfer(ctx context.Context, req *connect_go.Request[v1.CreateScheduledTransferRequest]) (*connect_go.Response[v1.CreateScheduledTransferResponse], error) {
+	return c.createScheduledTransfer.CallUnary(ctx, req)
+}
+
+// ListSchedules calls transaction.v1.TransactionService.ListSchedules.
+func (c *transactionServiceClient) ListSchedules(ctx context.Context, req *connect_go.Request[v1.ListSchedulesRequest]) (*connect_go.Response[v1.ListSchedulesResponse], error) {
+	return c.listSchedules.CallUnary(ctx, req)
+}
+
+// CancelSchedule calls transaction.v1.TransactionService.CancelSchedule.
+func (c *transactionServiceClient) CancelSchedule(ctx context.Context, req *connect_go.Request[v1.CancelScheduleRequest]) (*connect_go.Response[v1.CancelScheduleResponse], error) {
+	return c.cancelSchedule.CallUnary(ctx, req)
+}
+
+// ListScheduleRuns calls transaction.v1.TransactionService.ListScheduleRuns.
+func (c *transactionServiceClient) ListScheduleRuns(ctx context.Context, req *connect_go.Request[v1.ListScheduleRunsRequest]) (*connect_go.Response[v1.ListScheduleRunsResponse], error) {
+	return c.listScheduleRuns.CallUnary(ctx, req)
+}
+
+// CreateBatchTransfer calls transaction.v1.TransactionService.CreateBatchTransfer.
+func (c *transactionServiceClient) CreateBatchTransfer(ctx context.Context, req *connect_go.Request[v1.CreateBatchTransferRequest]) (*connect_go.Response[v1.CreateBatchTransferResponse], error) {
+	return c.createBatchTransfer.CallUnary(ctx, req)
+}
+
+// GetBatchTransfer calls transaction.v1.TransactionService.GetBatchTransfer.
+func (c *transactionServiceClient) GetBatchTransfer(ctx context.Context, req *connect_go.Request[v1.GetBatchTransferRequest]) (*connect_go.Response[v1.GetBatchTransferResponse], error) {
+	return c.getBatchTransfer.CallUnary(ctx, req)
+}
+
+// ApproveTransfer calls transaction.v1.TransactionService.ApproveTransfer.
+func (c *transactionServiceClient) ApproveTransfer(ctx context.Context, req *connect_go.Request[v1.ApproveTransferRequest]) (*connect_go.Response[v1.ApproveTransferResponse], error) {
+	return c.approveTransfer.CallUnary(ctx, req)
+}
+
+// RejectTransfer calls transaction.v1.TransactionService.RejectTransfer.
+func (c *transactionServiceClient) RejectTransfer(ctx context.Context, req *connect_go.Request[v1.RejectTransferRequest]) (*connect_go.Response[v1.RejectTransferResponse], error) {
+	return c.rejectTransfer.CallUnary(ctx, req)
+}
+
+// GetRiskDecision calls transaction.v1.TransactionService.GetRiskDecision.
+func (c *transactionServiceClient) GetRiskDecision(ctx context.Context, req *connect_go.Request[v1.GetRiskDecisionRequest]) (*connect_go.Response[v1.GetRiskDecisionResponse], error) {
+	return c.getRiskDecision.CallUnary(ctx, req)
+}
+
+// ListScreeningHits calls transaction.v1.TransactionService.ListScreeningHits.
+func (c *transactionServiceClient) ListScreeningHits(ctx context.Context, req *connect_go.Request[v1.ListScreeningHitsRequest]) (*connect_go.Response[v1.ListScreeningHitsResponse], error) {
+	return c.listScreeningHits.CallUnary(ctx, req)
+}
+
+// CreateWebhookEndpoint calls transaction.v1.TransactionService.CreateWebhookEndpoint.
+func (c *transactionServiceClient) CreateWebhookEndpoint(ctx context.Context, req *connect_go.Request[v1.CreateWebhookEndpointRequest]) (*connect_go.Response[v1.CreateWebhookEndpointResponse], error) {
+	return c.createWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// ListWebhookEndpoints calls transaction.v1.TransactionService.ListWebhookEndpoints.
+func (c *transactionServiceClient) ListWebhookEndpoints(ctx context.Context, req *connect_go.Request[v1.ListWebhookEndpointsRequest]) (*connect_go.Response[v1.ListWebhookEndpointsResponse], error) {
+	return c.listWebhookEndpoints.CallUnary(ctx, req)
+}
+
+// DisableWebhookEndpoint calls transaction.v1.TransactionService.DisableWebhookEndpoint.
+func (c *transactionServiceClient) DisableWebhookEndpoint(ctx context.Context, req *connect_go.Request[v1.DisableWebhookEndpointRequest]) (*connect_go.Response[v1.DisableWebhookEndpointResponse], error) {
+	return c.disableWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls transaction.v1.TransactionService.ListWebhookDeliveries.
+func (c *transactionServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect_go.Request[v1.ListWebhookDeliveriesRequest]) (*connect_go.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// ReplayWebhookDelivery calls transaction.v1.TransactionService.ReplayWebhookDelivery.
+func (c *transactionServiceClient) ReplayWebhookDelivery(ctx context.Context, req *connect_go.Request[v1.ReplayWebhookDeliveryRequest]) (*connect_go.Response[v1.ReplayWebhookDeliveryResponse], error) {
+	return c.replayWebhookDelivery.CallUnary(ctx, req)
+}
+
 // TransactionServiceHandler is an implementation of the transaction.v1.TransactionService service.
 type TransactionServiceHandler interface {
 	// CreateTransfer initiates a funds transfer between two accounts.
 	CreateTransfer(context.Context, *connect_go.Request[v1.CreateTransferRequest]) (*connect_go.Response[v1.CreateTransferResponse], error)
+	// QuoteTransfer returns the fees for a transfer without moving money.
+	QuoteTransfer(context.Context, *connect_go.Request[v1.QuoteTransferRequest]) (*connect_go.Response[v1.QuoteTransferResponse], error)
 	// GetTransactionStatus returns the status of a previously created transaction.
 	GetTransactionStatus(context.Context, *connect_go.Request[v1.GetTransactionStatusRequest]) (*connect_go.Response[v1.GetTransactionStatusResponse], error)
+	// ListTransactions returns a page of an account's transaction history.
+	ListTransactions(context.Context, *connect_go.Request[v1.ListTransactionsRequest]) (*connect_go.Response[v1.ListTransactionsResponse], error)
+	// ReverseTransfer moves the unrefunded remainder of a completed transfer back to the sender.
+	ReverseTransfer(context.Context, *connect_go.Request[v1.ReverseTransferRequest]) (*connect_go.Response[v1.ReverseTransferResponse], error)
+	// RefundTransfer moves part of a completed transfer back to the sender.
+	RefundTransfer(context.Context, *connect_go.Request[v1.RefundTransferRequest]) (*connect_go.Response[v1.RefundTransferResponse], error)
+	// AuthorizeHold reserves funds on an account, reducing its available balance.
+	AuthorizeHold(context.Context, *connect_go.Request[v1.AuthorizeHoldRequest]) (*connect_go.Response[v1.AuthorizeHoldResponse], error)
+	// CaptureHold moves all or part of a hold to a recipient and releases the rest.
+	// A capture denied by the risk rules is returned FAILED with the hold still active. One
+	// held by the risk rules, matching the sanctions list or above the approval threshold is
+	// returned AWAITING_APPROVAL with the hold still active; it completes once released
+	// through ApproveTransfer.
+	CaptureHold(context.Context, *connect_go.Request[v1.CaptureHoldRequest]) (*connect_go.Response[v1.CaptureHoldResponse], error)
+	// VoidHold releases a hold without moving funds.
+	VoidHold(context.Context, *connect_go.Request[v1.VoidHoldRequest]) (*connect_go.Response[v1.VoidHoldResponse], error)
+	// CreateScheduledTransfer sets up a transfer that repeats on a cron schedule.
+	CreateScheduledTransfer(context.Context, *connect_go.Request[v1.CreateScheduledTransferRequest]) (*connect_go.Response[v1.CreateScheduledTransferResponse], error)
+	// ListSchedules returns the scheduled transfers of a sender account.
+	ListSchedules(context.Context, *connect_go.Request[v1.ListSchedulesRequest]) (*connect_go.Response[v1.ListSchedulesResponse], error)
+	// CancelSchedule stops a scheduled transfer.
+	CancelSchedule(context.Context, *connect_go.Request[v1.CancelScheduleRequest]) (*connect_go.Response[v1.CancelScheduleResponse], error)
+	// ListScheduleRuns returns the run history of a scheduled transfer.
+	ListScheduleRuns(context.Context, *connect_go.Request[v1.ListScheduleRunsRequest]) (*connect_go.Response[v1.ListScheduleRunsResponse], error)
+	// CreateBatchTransfer reserves the total of many payouts from one sender and pays them.
+	CreateBatchTransfer(context.Context, *connect_go.Request[v1.CreateBatchTransferRequest]) (*connect_go.Response[v1.CreateBatchTransferResponse], error)
+	// GetBatchTransfer returns the progress of a batch and the status of its items.
+	GetBatchTransfer(context.Context, *connect_go.Request[v1.GetBatchTransferRequest]) (*connect_go.Response[v1.GetBatchTransferResponse], error)
+	// ApproveTransfer lets a transfer that is AWAITING_APPROVAL proceed.
+	ApproveTransfer(context.Context, *connect_go.Request[v1.ApproveTransferRequest]) (*connect_go.Response[v1.ApproveTransferResponse], error)
+	// RejectTransfer stops a transfer that is AWAITING_APPROVAL without moving funds.
+	RejectTransfer(context.Context, *connect_go.Request[v1.RejectTransferRequest]) (*connect_go.Response[v1.RejectTransferResponse], error)
+	// GetRiskDecision returns how risk screening judged a transfer, for audit.
+	GetRiskDecision(context.Context, *connect_go.Request[v1.GetRiskDecisionRequest]) (*connect_go.Response[v1.GetRiskDecisionResponse], error)
+	// ListScreeningHits returns the sanctions list matches that held a transfer for review.
+	ListScreeningHits(context.Context, *connect_go.Request[v1.ListScreeningHitsRequest]) (*connect_go.Response[v1.ListScreeningHitsResponse], error)
+	// CreateWebhookEndpoint registers a merchant URL for transaction events.
+	CreateWebhookEndpoint(context.Context, *connect_go.Request[v1.CreateWebhookEndpointRequest]) (*connect_go.Response[v1.CreateWebhookEndpointResponse], error)
+	// ListWebhookEndpoints returns a merchant's webhook endpoints.
+	ListWebhookEndpoints(context.Context, *connect_go.Request[v1.ListWebhookEndpointsRequest]) (*connect_go.Response[v1.ListWebhookEndpointsResponse], error)
+	// DisableWebhookEndpoint stops deliveries to an endpoint, including pending retries.
+	DisableWebhookEndpoint(context.Context, *connect_go.Request[v1.DisableWebhookEndpointRequest]) (*connect_go.Response[v1.DisableWebhookEndpointResponse], error)
+	// ListWebhookDeliveries returns the delivery log of an endpoint.
+	ListWebhookDeliveries(context.Context, *connect_go.Request[v1.ListWebhookDeliveriesRequest]) (*connect_go.Response[v1.ListWebhookDeliveriesResponse], error)
+	// ReplayWebhookDelivery sends a DELIVERED or FAILED delivery again. A PENDING delivery
+	// whose sending was never started, such as a replay that failed, is started again.
+	ReplayWebhookDelivery(context.Context, *connect_go.Request[v1.ReplayWebhookDeliveryRequest]) (*connect_go.Response[v1.ReplayWebhookDeliveryResponse], error)
 }
 
 // NewTransactionServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -107,17 +513,171 @@ func NewTransactionServiceHandler(svc TransactionServiceHandler, opts ...connect
 		svc.CreateTransfer,
 		opts...,
 	)
+	transactionServiceQuoteTransferHandler := connect_go.NewUnaryHandler(
+		TransactionServiceQuoteTransferProcedure,
+		svc.QuoteTransfer,
+		opts...,
+	)
 	transactionServiceGetTransactionStatusHandler := connect_go.NewUnaryHandler(
 		TransactionServiceGetTransactionStatusProcedure,
 		svc.GetTransactionStatus,
 		opts...,
 	)
+	transactionServiceListTransactionsHandler := connect_go.NewUnaryHandler(
+		TransactionServiceListTransactionsProcedure,
+		svc.ListTransactions,
+		opts...,
+	)
+	transactionServiceReverseTransferHandler := connect_go.NewUnaryHandler(
+		TransactionServiceReverseTransferProcedure,
+		svc.ReverseTransfer,
+		opts...,
+	)
+	transactionServiceRefundTransferHandler := connect_go.NewUnaryHandler(
+		TransactionServiceRefundTransferProcedure,
+		svc.RefundTransfer,
+		opts...,
+	)
+	transactionServiceAuthorizeHoldHandler := connect_go.NewUnaryHandler(
+		TransactionServiceAuthorizeHoldProcedure,
+		svc.AuthorizeHold,
+		opts...,
+	)
+	transactionServiceCaptureHoldHandler := connect_go.NewUnaryHandler(
+		TransactionServiceCaptureHoldProcedure,
+		svc.CaptureHold,
+		opts...,
+	)
+	transactionServiceVoidHoldHandler := connect_go.NewUnaryHandler(
+		TransactionServiceVoidHoldProcedure,
+		svc.VoidHold,
+		opts...,
+	)
+	transactionServiceCreateScheduledTransferHandler := connect_go.NewUnaryHandler(
+		TransactionServiceCreateScheduledTransferProcedure,
+		svc.CreateScheduledTransfer,
+		opts...,
+	)
+	transactionServiceListSchedulesHandler := connect_go.NewUnaryHandler(
+		TransactionServiceListSchedulesProcedure,
+		svc.ListSchedules,
+		opts...,
+	)
+	transactionServiceCancelScheduleHandler := connect_go.NewUnaryHandler(
+		TransactionServiceCancelScheduleProcedure,
+		svc.CancelSchedule,
+		opts...,
+	)
+	transactionServiceListScheduleRunsHandler := connect_go.NewUnaryHandler(
+		TransactionServiceListScheduleRunsProcedure,
+		svc.ListScheduleRuns,
+		opts...,
+	)
+	transactionServiceCreateBatchTransferHandler := connect_go.NewUnaryHandler(
+		TransactionServiceCreateBatchTransferProcedure,
+		svc.CreateBatchTransfer,
+		opts...,
+	)
+	transactionServiceGetBatchTransferHandler := connect_go.NewUnaryHandler(
+		TransactionServiceGetBatchTransferProcedure,
+		svc.GetBatchTransfer,
+		opts...,
+	)
+	transactionServiceApproveTransferHandler := connect_go.NewUnaryHandler(
+		TransactionServiceApproveTransferProcedure,
+		svc.ApproveTransfer,
+		opts...,
+	)
+	transactionServiceRejectTransferHandler := connect_go.NewUnaryHandler(
+		TransactionServiceRejectTransferProcedure,
+		svc.RejectTransfer,
+		opts...,
+	)
+	transactionServiceGetRiskDecisionHandler := connect_go.NewUnaryHandler(
+		TransactionServiceGetRiskDecisionProcedure,
+		svc.GetRiskDecision,
+		opts...,
+	)
+	transactionServiceListScreeningHitsHandler := connect_go.NewUnaryHandler(
+		TransactionServiceListScreeningHitsProcedure,
+		svc.ListScreeningHits,
+		opts...,
+	)
+	transactionServiceCreateWebhookEndpointHandler := connect_go.NewUnaryHandler(
+		TransactionServiceCreateWebhookEndpointProcedure,
+		svc.CreateWebhookEndpoint,
+		opts...,
+	)
+	transactionServiceListWebhookEndpointsHandler := connect_go.NewUnaryHandler(
+		TransactionServiceListWebhookEndpointsProcedure,
+		svc.ListWebhookEndpoints,
+		opts...,
+	)
+	transactionServiceDisableWebhookEndpointHandler := connect_go.NewUnaryHandler(
+		TransactionServiceDisableWebhookEndpointProcedure,
+		svc.DisableWebhookEndpoint,
+		opts...,
+	)
+	transactionServiceListWebhookDeliveriesHandler := connect_go.NewUnaryHandler(
+		TransactionServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		opts...,
+	)
+	transactionServiceReplayWebhookDeliveryHandler := connect_go.NewUnaryHandler(
+		TransactionServiceReplayWebhookDeliveryProcedure,
+		svc.ReplayWebhookDelivery,
+		opts...,
+	)
 	return "/transaction.v1.TransactionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TransactionServiceCreateTransferProcedure:
 			transactionServiceCreateTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceQuoteTransferProcedure:
+			transactionServiceQuoteTransferHandler.ServeHTTP(w, r)
 		case TransactionServiceGetTransactionStatusProcedure:
 			transactionServiceGetTransactionStatusHandler.ServeHTTP(w, r)
+		case TransactionServiceListTransactionsProcedure:
+			transactionServiceListTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServiceReverseTransferProcedure:
+			transactionServiceReverseTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceRefundTransferProcedure:
+			transactionServiceRefundTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceAuthorizeHoldProcedure:
+			transactionServiceAuthorizeHoldHandler.ServeHTTP(w, r)
+		case TransactionServiceCaptureHoldProcedure:
+			transactionServiceCaptureHoldHandler.ServeHTTP(w, r)
+		case TransactionServiceVoidHoldProcedure:
+			transactionServiceVoidHoldHandler.ServeHTTP(w, r)
+		case TransactionServiceCreateScheduledTransferProcedure:
+			transactionServiceCreateScheduledTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceListSchedulesProcedure:
+			transactionServiceListSchedulesHandler.ServeHTTP(w, r)
+		case TransactionServiceCancelScheduleProcedure:
+			transactionServiceCancelScheduleHandler.ServeHTTP(w, r)
+		case TransactionServiceListScheduleRunsProcedure:
+			transactionServiceListScheduleRunsHandler.ServeHTTP(w, r)
+		case TransactionServiceCreateBatchTransferProcedure:
+			transactionServiceCreateBatchTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceGetBatchTransferProcedure:
+			transactionServiceGetBatchTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceApproveTransferProcedure:
+			transactionServiceApproveTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceRejectTransferProcedure:
+			transactionServiceRejectTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceGetRiskDecisionProcedure:
+			transactionServiceGetRiskDecisionHandler.ServeHTTP(w, r)
+		case TransactionServiceListScreeningHitsProcedure:
+			transactionServiceListScreeningHitsHandler.ServeHTTP(w, r)
+		case TransactionServiceCreateWebhookEndpointProcedure:
+			transactionServiceCreateWebhookEndpointHandler.ServeHTTP(w, r)
+		case TransactionServiceListWebhookEndpointsProcedure:
+			transactionServiceListWebhookEndpointsHandler.ServeHTTP(w, r)
+		case TransactionServiceDisableWebhookEndpointProcedure:
+			transactionServiceDisableWebhookEndpointHandler.ServeHTTP(w, r)
+		case TransactionServiceListWebhookDeliveriesProcedure:
+			transactionServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case TransactionServiceReplayWebhookDeliveryProcedure:
+			transactionServiceReplayWebhookDeliveryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -131,6 +691,94 @@ func (UnimplementedTransactionServiceHandler) CreateTransfer(context.Context, *c
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.CreateTransfer is not implemented"))
 }
 
+func (UnimplementedTransactionServiceHandler) QuoteTransfer(context.Context, *connect_go.Request[v1.QuoteTransferRequest]) (*connect_go.Response[v1.QuoteTransferResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.QuoteTransfer is not implemented"))
+}
+
 func (UnimplementedTransactionServiceHandler) GetTransactionStatus(context.Context, *connect_go.Request[v1.GetTransactionStatusRequest]) (*connect_go.Response[v1.GetTransactionStatusResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.GetTransactionStatus is not implemented"))
 }
+
+func (UnimplementedTransactionServiceHandler) ListTransactions(context.Context, *connect_go.Request[v1.ListTransactionsRequest]) (*connect_go.Response[v1.ListTransactionsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListTransactions is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ReverseTransfer(context.Context, *connect_go.Request[v1.ReverseTransferRequest]) (*connect_go.Response[v1.ReverseTransferResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ReverseTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) RefundTransfer(context.Context, *connect_go.Request[v1.RefundTransferRequest]) (*connect_go.Response[v1.RefundTransferResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.RefundTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) AuthorizeHold(context.Context, *connect_go.Request[v1.AuthorizeHoldRequest]) (*connect_go.Response[v1.AuthorizeHoldResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.AuthorizeHold is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) CaptureHold(context.Context, *connect_go.Request[v1.CaptureHoldRequest]) (*connect_go.Response[v1.CaptureHoldResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.CaptureHold is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) VoidHold(context.Context, *connect_go.Request[v1.VoidHoldRequest]) (*connect_go.Response[v1.VoidHoldResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.VoidHold is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) CreateScheduledTransfer(context.Context, *connect_go.Request[v1.CreateScheduledTransferRequest]) (*connect_go.Response[v1.CreateScheduledTransferResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.CreateScheduledTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListSchedules(context.Context, *connect_go.Request[v1.ListSchedulesRequest]) (*connect_go.Response[v1.ListSchedulesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListSchedules is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) CancelSchedule(context.Context, *connect_go.Request[v1.CancelScheduleRequest]) (*connect_go.Response[v1.CancelScheduleResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.CancelSchedule is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListScheduleRuns(context.Context, *connect_go.Request[v1.ListScheduleRunsRequest]) (*connect_go.Response[v1.ListScheduleRunsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListScheduleRuns is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) CreateBatchTransfer(context.Context, *connect_go.Request[v1.CreateBatchTransferRequest]) (*connect_go.Response[v1.CreateBatchTransferResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.CreateBatchTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) GetBatchTransfer(context.Context, *connect_go.Request[v1.GetBatchTransferRequest]) (*connect_go.Response[v1.GetBatchTransferResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.GetBatchTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ApproveTransfer(context.Context, *connect_go.Request[v1.ApproveTransferRequest]) (*connect_go.Response[v1.ApproveTransferResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ApproveTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) RejectTransfer(context.Context, *connect_go.Request[v1.RejectTransferRequest]) (*connect_go.Response[v1.RejectTransferResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.RejectTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) GetRiskDecision(context.Context, *connect_go.Request[v1.GetRiskDecisionRequest]) (*connect_go.Response[v1.GetRiskDecisionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.GetRiskDecision is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListScreeningHits(context.Context, *connect_go.Request[v1.ListScreeningHitsRequest]) (*connect_go.Response[v1.ListScreeningHitsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListScreeningHits is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) CreateWebhookEndpoint(context.Context, *connect_go.Request[v1.CreateWebhookEndpointRequest]) (*connect_go.Response[v1.CreateWebhookEndpointResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.CreateWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListWebhookEndpoints(context.Context, *connect_go.Request[v1.ListWebhookEndpointsRequest]) (*connect_go.Response[v1.ListWebhookEndpointsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListWebhookEndpoints is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DisableWebhookEndpoint(context.Context, *connect_go.Request[v1.DisableWebhookEndpointRequest]) (*connect_go.Response[v1.DisableWebhookEndpointResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.DisableWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListWebhookDeliveries(context.Context, *connect_go.Request[v1.ListWebhookDeliveriesRequest]) (*connect_go.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ReplayWebhookDelivery(context.Context, *connect_go.Request[v1.ReplayWebhookDeliveryRequest]) (*connect_go.Response[v1.ReplayWebhookDeliveryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transaction.v1.TransactionService.ReplayWebhookDelivery is not implemented"))
+}
@@ -0,0 +1,24 @@
+package approval
+
+import "time"
+
+// AnyCurrency is the Policy threshold key that applies to currencies without their own.
+const AnyCurrency = "*"
+
+// Policy decides which transfers need a second person's approval.
+type Policy struct {
+	// Thresholds maps a currency, or AnyCurrency, to the amount in minor units above
+	// which a transfer needs approval. Currencies without a threshold never need it.
+	Thresholds map[string]int64
+	// Timeout is how long a transfer waits for a decision before it is rejected.
+	Timeout time.Duration
+}
+
+// Requires reports whether a transfer of amount in currency needs approval.
+func (p Policy) Requires(currency string, amount int64) bool {
+	threshold, ok := p.Thresholds[currency]
+	if !ok {
+		threshold, ok = p.Thresholds[AnyCurrency]
+	}
+	return ok && amount > threshold
+}
@@ -0,0 +1,95 @@
+package broker
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// LoadWriterConfig reads the producer settings: KAFKA_ACKS (none, one or all),
+// KAFKA_COMPRESSION (none, gzip, snappy, lz4 or zstd), KAFKA_BATCH_SIZE,
+// KAFKA_BATCH_TIMEOUT, KAFKA_MAX_ATTEMPTS and KAFKA_IDEMPOTENT.
+func LoadWriterConfig() (WriterConfig, error) {
+	var cfg WriterConfig
+	switch v := os.Getenv("KAFKA_ACKS"); v {
+	case "", "all":
+		cfg.RequiredAcks = kafka.RequireAll
+	case "one":
+		cfg.RequiredAcks = kafka.RequireOne
+	case "none":
+		cfg.RequiredAcks = kafka.RequireNone
+	default:
+		return cfg, fmt.Errorf("invalid KAFKA_ACKS %q", v)
+	}
+	switch v := os.Getenv("KAFKA_COMPRESSION"); v {
+	case "", "none":
+	case "gzip":
+		cfg.Compression = kafka.Gzip
+	case "snappy":
+		cfg.Compression = kafka.Snappy
+	case "lz4":
+		cfg.Compression = kafka.Lz4
+	case "zstd":
+		cfg.Compression = kafka.Zstd
+	default:
+		return cfg, fmt.Errorf("invalid KAFKA_COMPRESSION %q", v)
+	}
+	if v := os.Getenv("KAFKA_BATCH_SIZE"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return cfg, fmt.Errorf("invalid KAFKA_BATCH_SIZE %q", v)
+		}
+		cfg.BatchSize = n
+	}
+	if v := os.Getenv("KAFKA_BATCH_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("invalid KAFKA_BATCH_TIMEOUT %q", v)
+		}
+		cfg.BatchTimeout = d
+	}
+	if v := os.Getenv("KAFKA_MAX_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return cfg, fmt.Errorf("invalid KAFKA_MAX_ATTEMPTS %q", v)
+		}
+		cfg.MaxAttempts = n
+	}
+	if v := os.Getenv("KAFKA_IDEMPOTENT"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid KAFKA_IDEMPOTENT %q", v)
+		}
+		cfg.Idempotent = b
+	}
+	return cfg, nil
+}
+
+// LoadConsumerConfig configures a consumer of topic in group from the writer settings
+// and KAFKA_CONSUMER_MAX_RETRIES and KAFKA_CONSUMER_RETRY_BACKOFF.
+func LoadConsumerConfig(brokers []string, topic, group string) (ConsumerConfig, error) {
+	cfg := ConsumerConfig{Brokers: brokers, Topic: topic, GroupID: group}
+	writer, err := LoadWriterConfig()
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Writer = writer
+	if v := os.Getenv("KAFKA_CONSUMER_MAX_RETRIES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return cfg, fmt.Errorf("invalid KAFKA_CONSUMER_MAX_RETRIES %q", v)
+		}
+		cfg.MaxRetries = n
+	}
+	if v := os.Getenv("KAFKA_CONSUMER_RETRY_BACKOFF"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("invalid KAFKA_CONSUMER_RETRY_BACKOFF %q", v)
+		}
+		cfg.RetryBackoff = d
+	}
+	return cfg, nil
+}
@@ -0,0 +1,201 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"golang.org/x/sync/errgroup"
+)
+
+// Headers the Consumer adds to messages it moves to the retry or dead-letter topic.
+const (
+	HeaderRetryCount    = "retry-count"
+	HeaderRetryAt       = "retry-at"
+	HeaderOriginalTopic = "original-topic"
+	HeaderError         = "error"
+)
+
+// Handler processes one consumed message.
+type Handler func(context.Context, Message) error
+
+// permanentError marks a handler error that retrying cannot fix.
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so the Consumer sends the message straight to the dead-letter
+// topic instead of retrying it.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// ConsumerConfig configures a Consumer. RetryTopic and DeadLetterTopic default to
+// "<Topic>.<GroupID>.retry" and "<Topic>.<GroupID>.dlt", so every consumer group
+// retries only its own failures.
+type ConsumerConfig struct {
+	Brokers         []string
+	Topic           string
+	GroupID         string
+	RetryTopic      string
+	DeadLetterTopic string
+	// MaxRetries is how often a failed message is retried before it is dead-lettered.
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry; it doubles on every retry.
+	RetryBackoff time.Duration
+	Writer       WriterConfig
+}
+
+// Consumer consumes a topic as a consumer group and commits a message only once it is
+// handled or moved on. A message whose handler fails is published to the retry topic
+// and handled again after a backoff, up to MaxRetries times, and then to the
+// dead-letter topic. Retried messages can overtake later ones, so handlers must not
+// depend on ordering and must be idempotent.
+type Consumer struct {
+	cfg        ConsumerConfig
+	main       *kafka.Reader
+	retry      *kafka.Reader
+	retries    *KafkaWriter
+	deadLetter *KafkaWriter
+}
+
+// NewConsumer creates a Consumer for cfg.
+func NewConsumer(cfg ConsumerConfig) *Consumer {
+	if cfg.RetryTopic == "" {
+		cfg.RetryTopic = cfg.Topic + "." + cfg.GroupID + ".retry"
+	}
+	if cfg.DeadLetterTopic == "" {
+		cfg.DeadLetterTopic = cfg.Topic + "." + cfg.GroupID + ".dlt"
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = 5
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = 30 * time.Second
+	}
+	reader := func(topic string) *kafka.Reader {
+		return kafka.NewReader(kafka.ReaderConfig{
+			Brokers: cfg.Brokers,
+			Topic:   topic,
+			GroupID: cfg.GroupID,
+		})
+	}
+	return &Consumer{
+		cfg:        cfg,
+		main:       reader(cfg.Topic),
+		retry:      reader(cfg.RetryTopic),
+		retries:    NewKafkaWriter(cfg.Brokers, cfg.RetryTopic, cfg.Writer),
+		deadLetter: NewKafkaWriter(cfg.Brokers, cfg.DeadLetterTopic, cfg.Writer),
+	}
+}
+
+// Run calls handle for every message of the topic and the retry topic until ctx is
+// cancelled.
+func (c *Consumer) Run(ctx context.Context, handle Handler) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error { return c.consume(ctx, c.main, handle) })
+	g.Go(func() error { return c.consume(ctx, c.retry, handle) })
+	return g.Wait()
+}
+
+// consume handles the messages of r in order.
+func (c *Consumer) consume(ctx context.Context, r *kafka.Reader, handle Handler) error {
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		msg := message(m)
+
+		// retried messages wait out their backoff; later ones are due no sooner
+		if at, err := time.Parse(time.RFC3339Nano, msg.Headers[HeaderRetryAt]); err == nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(time.Until(at)):
+			}
+		}
+
+		if err := handle(ctx, msg); err != nil {
+			slog.Error("failed to handle kafka message", "topic", m.Topic, "partition", m.Partition, "offset", m.Offset, "error", err)
+			if err := c.reroute(ctx, msg, err); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+		if err := r.CommitMessages(ctx, m); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// reroute publishes a message that failed with cause to the retry topic, or to the
+// dead-letter topic once its retries are used up or cause is permanent. Publishing is
+// itself retried with backoff, so a message is never committed without being moved on.
+func (c *Consumer) reroute(ctx context.Context, msg Message, cause error) error {
+	retries, _ := strconv.Atoi(msg.Headers[HeaderRetryCount])
+	headers := make(map[string]string, len(msg.Headers)+4)
+	for name, value := range msg.Headers {
+		headers[name] = value
+	}
+	if headers[HeaderOriginalTopic] == "" {
+		headers[HeaderOriginalTopic] = msg.Topic
+	}
+	headers[HeaderError] = cause.Error()
+
+	target := c.deadLetter
+	var permanent permanentError
+	if retries < c.cfg.MaxRetries && !errors.As(cause, &permanent) {
+		target = c.retries
+		headers[HeaderRetryCount] = strconv.Itoa(retries + 1)
+		headers[HeaderRetryAt] = time.Now().Add(c.cfg.RetryBackoff << retries).Format(time.RFC3339Nano)
+	} else {
+		delete(headers, HeaderRetryAt)
+	}
+
+	out := Message{Key: msg.Key, Value: msg.Value, Headers: headers}
+	for backoff := time.Second; ; backoff = min(2*backoff, time.Minute) {
+		err := target.Publish(ctx, out)
+		if err == nil {
+			if target == c.deadLetter {
+				slog.Warn("dead-lettered kafka message", "topic", c.cfg.DeadLetterTopic, "original_topic", headers[HeaderOriginalTopic], "error", cause)
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to reroute message: %w", err)
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// Close closes the readers and writers.
+func (c *Consumer) Close() error {
+	return errors.Join(c.main.Close(), c.retry.Close(), c.retries.Close(), c.deadLetter.Close())
+}
+
+// message converts a consumed kafka.Message.
+func message(m kafka.Message) Message {
+	msg := Message{Topic: m.Topic, Partition: m.Partition, Offset: m.Offset, Key: m.Key, Value: m.Value}
+	if len(m.Headers) > 0 {
+		msg.Headers = make(map[string]string, len(m.Headers))
+		for _, h := range m.Headers {
+			msg.Headers[h.Key] = string(h.Value)
+		}
+	}
+	return msg
+}
@@ -2,54 +2,97 @@ package broker
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"log/slog"
+	"sort"
+	"time"
 
 	"github.com/segmentio/kafka-go"
 )
 
+// WriterConfig tunes the producer. Zero values keep the kafka-go defaults, except
+// BatchTimeout which defaults to 10ms so the outbox relay is not held up a second per
+// batch.
+type WriterConfig struct {
+	RequiredAcks kafka.RequiredAcks
+	Compression  kafka.Compression
+	BatchSize    int
+	BatchTimeout time.Duration
+	MaxAttempts  int
+	// Idempotent requires acknowledgement from all in-sync replicas and retries sends.
+	// kafka-go has no idempotent producer, so a retried send can still be written twice;
+	// consumers dedupe by the event ID header.
+	Idempotent bool
+}
+
 // KafkaWriter wraps a segmentio kafka.Writer and topic information.
 type KafkaWriter struct {
 	writer *kafka.Writer
 	topic  string
 }
 
-// NewKafkaWriter initializes a KafkaWriter for the given brokers and topic.
-func NewKafkaWriter(brokers []string, topic string) *KafkaWriter {
+// NewKafkaWriter initializes a KafkaWriter for the given brokers and topic. Messages
+// are partitioned by a hash of their key, so messages with the same key stay in order.
+func NewKafkaWriter(brokers []string, topic string, cfg WriterConfig) *KafkaWriter {
+	if cfg.BatchTimeout <= 0 {
+		cfg.BatchTimeout = 10 * time.Millisecond
+	}
+	if cfg.Idempotent {
+		cfg.RequiredAcks = kafka.RequireAll
+		if cfg.MaxAttempts <= 0 {
+			cfg.MaxAttempts = 10
+		}
+	}
 	w := &kafka.Writer{
-		Addr:     kafka.TCP(brokers...),
-		Topic:    topic,
-		Balancer: &kafka.LeastBytes{},
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: cfg.RequiredAcks,
+		Compression:  cfg.Compression,
+		BatchSize:    cfg.BatchSize,
+		BatchTimeout: cfg.BatchTimeout,
+		MaxAttempts:  cfg.MaxAttempts,
 	}
 	return &KafkaWriter{writer: w, topic: topic}
 }
 
-// PublishTransactionEvent marshals eventPayload to JSON and publishes it to Kafka.
-func (k *KafkaWriter) PublishTransactionEvent(ctx context.Context, eventPayload interface{}) error {
+// Publish writes msg to the writer's topic; Topic, Partition and Offset of msg are
+// ignored.
+func (k *KafkaWriter) Publish(ctx context.Context, msg Message) error {
 	if k == nil || k.writer == nil {
 		return nil
 	}
 
-	b, err := json.Marshal(eventPayload)
-	if err != nil {
-		slog.Error("failed to marshal event payload", "error", err)
-		return err
-	}
-
-	msg := kafka.Message{
-		Key:   nil,
-		Value: b,
+	m := kafka.Message{Key: msg.Key, Value: msg.Value}
+	for name, value := range msg.Headers {
+		m.Headers = append(m.Headers, kafka.Header{Key: name, Value: []byte(value)})
 	}
+	// sorted so identical messages are written identically
+	sort.Slice(m.Headers, func(i, j int) bool { return m.Headers[i].Key < m.Headers[j].Key })
 
-	if err := k.writer.WriteMessages(ctx, msg); err != nil {
-		slog.Error("failed to write message to kafka", "error", err)
+	if err := k.writer.WriteMessages(ctx, m); err != nil {
+		// report the error of the one message rather than the per-message list
+		var errs kafka.WriteErrors
+		if errors.As(err, &errs) && len(errs) == 1 && errs[0] != nil {
+			err = errs[0]
+		}
+		slog.Error("failed to write message to kafka", "topic", k.topic, "error", err)
 		return err
 	}
-
-	slog.Info("published event to kafka", "topic", k.topic)
 	return nil
 }
 
+// Rejected reports whether a Publish error means the broker refused the message or
+// request outright, as opposed to being unreachable or temporarily unavailable.
+func Rejected(err error) bool {
+	var kerr kafka.Error
+	if errors.As(err, &kerr) {
+		return !kerr.Temporary()
+	}
+	var tooLarge kafka.MessageTooLargeError
+	return errors.As(err, &tooLarge)
+}
+
 // Close closes the underlying writer.
 func (k *KafkaWriter) Close() error {
 	if k == nil || k.writer == nil {
@@ -57,3 +100,13 @@ func (k *KafkaWriter) Close() error {
 	}
 	return k.writer.Close()
 }
+
+// Message is a Kafka message, as published or consumed.
+type Message struct {
+	Topic     string
+	Partition int
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   map[string]string
+}
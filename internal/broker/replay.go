@@ -0,0 +1,76 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Position is where ReadTopic starts in every partition: at the first message at or
+// after Time when it is set, and otherwise at Offset. Offsets before the start of a
+// partition, kafka.FirstOffset included, start at its first retained message.
+type Position struct {
+	Offset int64
+	Time   time.Time
+}
+
+// ReadTopic calls handle for the messages of every partition of topic from from up to
+// the end of the partition when it is reached. Partitions are read one after another
+// and nothing is committed, so a read can be repeated.
+func ReadTopic(ctx context.Context, brokers []string, topic string, from Position, handle Handler) error {
+	conn, err := kafka.DialContext(ctx, "tcp", brokers[0])
+	if err != nil {
+		return fmt.Errorf("failed to connect to kafka: %w", err)
+	}
+	partitions, err := conn.ReadPartitions(topic)
+	conn.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read partitions of %s: %w", topic, err)
+	}
+	for _, p := range partitions {
+		if err := readPartition(ctx, brokers, topic, p.ID, from, handle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readPartition reads one partition for ReadTopic.
+func readPartition(ctx context.Context, brokers []string, topic string, partition int, from Position, handle Handler) error {
+	leader, err := kafka.DialLeader(ctx, "tcp", brokers[0], topic, partition)
+	if err != nil {
+		return fmt.Errorf("failed to connect to leader of %s/%d: %w", topic, partition, err)
+	}
+	first, last, err := leader.ReadOffsets()
+	start := max(from.Offset, first)
+	if err == nil && !from.Time.IsZero() {
+		start, err = leader.ReadOffset(from.Time)
+	}
+	leader.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read offsets of %s/%d: %w", topic, partition, err)
+	}
+	if start >= last {
+		return nil
+	}
+
+	r := kafka.NewReader(kafka.ReaderConfig{Brokers: brokers, Topic: topic, Partition: partition})
+	defer r.Close()
+	if err := r.SetOffset(start); err != nil {
+		return fmt.Errorf("failed to seek %s/%d: %w", topic, partition, err)
+	}
+	for {
+		m, err := r.ReadMessage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to read %s/%d: %w", topic, partition, err)
+		}
+		if err := handle(ctx, message(m)); err != nil {
+			return err
+		}
+		if m.Offset+1 >= last {
+			return nil
+		}
+	}
+}
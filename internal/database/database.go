@@ -97,7 +97,33 @@ func Connect() error {
 
 // AutoMigrate migrates the schema for the provided models.
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(&models.Account{}, &models.Transaction{})
+	return db.AutoMigrate(
+		&models.Account{},
+		&models.Transaction{},
+		&models.IdempotencyKey{},
+		&models.JournalEntry{},
+		&models.JournalLine{},
+		&models.OutboxEvent{},
+		&models.Hold{},
+		&models.FxRate{},
+		&models.FxQuote{},
+		&models.TransferSchedule{},
+		&models.ScheduleRun{},
+		&models.TransferBatch{},
+		&models.BatchItem{},
+		&models.TransferLimit{},
+		&models.LimitUsage{},
+		&models.RiskDecision{},
+		&models.ScreeningHit{},
+		&models.AccountStatusChange{},
+		&models.OverdraftAccrual{},
+		&models.WebhookEndpoint{},
+		&models.WebhookDelivery{},
+		&models.WebhookAttempt{},
+		&models.ProcessedEvent{},
+		&models.AccountActivity{},
+		&models.AccountActivitySummary{},
+	)
 }
 
 // logWriter implements gorm logger Writer using slog.
@@ -0,0 +1,191 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	eventsv1 "FinTechPorto/gen/api/events/v1"
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/outbox"
+	"FinTechPorto/internal/tracecontext"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// SchemaVersion is the version of the payload schemas produced by this build. It is
+// raised whenever a payload changes incompatibly.
+const SchemaVersion = 1
+
+// Event types, one per Envelope payload. Each is produced by exactly one function of
+// this package, called in the DB transaction of the change it reports, and reaches the
+// broker through the outbox relay only.
+const (
+	TypeTransferCompleted         = "transfer.completed"
+	TypeTransferFailed            = "transfer.failed"
+	TypeTransferRejected          = "transfer.rejected"
+	TypeTransferReversed          = "transfer.reversed"
+	TypeBatchSettled              = "batch.settled"
+	TypeOverdraftThresholdCrossed = "account.overdraft_threshold_crossed"
+)
+
+// Message headers set on every event besides outbox.HeaderEventType, so consumers can
+// route, dedupe and trace an event without decoding it.
+const (
+	HeaderEventID       = "event-id"
+	HeaderSchemaVersion = "schema-version"
+	HeaderCorrelationID = "correlation-id"
+	HeaderContentType   = "content-type"
+)
+
+// ErrUnsupportedVersion is returned by Parse for an event newer than SchemaVersion.
+var ErrUnsupportedVersion = errors.New("unsupported event schema version")
+
+// TransferCompleted enqueues the completion of a transfer. holdID is set when the
+// transfer captured a hold.
+func TransferCompleted(tx *gorm.DB, tr *models.Transaction, holdID string) error {
+	return enqueue(tx, tr.SenderID, tr.ID, TypeTransferCompleted, &eventsv1.Envelope{
+		Payload: &eventsv1.Envelope_TransferCompleted{TransferCompleted: &eventsv1.TransferCompleted{
+			Transfer: Transfer(tr),
+			HoldId:   holdID,
+		}},
+	})
+}
+
+// TransferFailed enqueues the failure of a transfer.
+func TransferFailed(tx *gorm.DB, tr *models.Transaction, reason string) error {
+	return enqueue(tx, tr.SenderID, tr.ID, TypeTransferFailed, &eventsv1.Envelope{
+		Payload: &eventsv1.Envelope_TransferFailed{TransferFailed: &eventsv1.TransferFailed{
+			Transfer: Transfer(tr),
+			Reason:   reason,
+		}},
+	})
+}
+
+// TransferRejected enqueues the rejection of a transfer awaiting approval.
+func TransferRejected(tx *gorm.DB, tr *models.Transaction, approverID, reason string) error {
+	return enqueue(tx, tr.SenderID, tr.ID, TypeTransferRejected, &eventsv1.Envelope{
+		Payload: &eventsv1.Envelope_TransferRejected{TransferRejected: &eventsv1.TransferRejected{
+			Transfer:   Transfer(tr),
+			ApproverId: approverID,
+			Reason:     reason,
+		}},
+	})
+}
+
+// TransferReversed enqueues the return of amount of original to its sender. reversal is
+// the refund or reversal transaction, or nil for a batch clawback.
+func TransferReversed(tx *gorm.DB, original, reversal *models.Transaction, amount int64, reason string) error {
+	ev := &eventsv1.TransferReversed{
+		Original: Transfer(original),
+		Amount:   amount,
+		Reason:   reason,
+	}
+	if reversal != nil {
+		ev.Reversal = Transfer(reversal)
+	}
+	return enqueue(tx, original.SenderID, original.ID, TypeTransferReversed, &eventsv1.Envelope{
+		Payload: &eventsv1.Envelope_TransferReversed{TransferReversed: ev},
+	})
+}
+
+// BatchSettled enqueues the outcome of a batch.
+func BatchSettled(tx *gorm.DB, batch *models.TransferBatch) error {
+	return enqueue(tx, batch.SenderID, batch.ID, TypeBatchSettled, &eventsv1.Envelope{
+		Payload: &eventsv1.Envelope_BatchSettled{BatchSettled: &eventsv1.BatchSettled{
+			BatchId:       batch.ID,
+			SenderId:      batch.SenderID,
+			Currency:      batch.Currency,
+			Status:        batch.Status,
+			ItemCount:     int32(batch.ItemCount),
+			TotalAmount:   batch.TotalAmount,
+			TotalFee:      batch.TotalFee,
+			FailureReason: batch.FailureReason,
+		}},
+	})
+}
+
+// OverdraftThresholdCrossed enqueues an overdraft usage alert for acc. reference is the
+// journal reference of the posting that crossed the threshold.
+func OverdraftThresholdCrossed(tx *gorm.DB, acc *models.Account, reference string, usage, threshold int64) error {
+	return enqueue(tx, acc.ID, reference, TypeOverdraftThresholdCrossed, &eventsv1.Envelope{
+		Payload: &eventsv1.Envelope_OverdraftThresholdCrossed{OverdraftThresholdCrossed: &eventsv1.OverdraftThresholdCrossed{
+			AccountId:        acc.ID,
+			Currency:         acc.Currency,
+			Balance:          acc.Balance,
+			OverdraftLimit:   acc.OverdraftLimit,
+			UsagePercent:     usage,
+			ThresholdPercent: threshold,
+		}},
+	})
+}
+
+// Transfer converts a transaction to its event form.
+func Transfer(tr *models.Transaction) *eventsv1.Transfer {
+	return &eventsv1.Transfer{
+		TransactionId:         tr.ID,
+		Type:                  tr.Type,
+		Status:                tr.Status,
+		SenderId:              tr.SenderID,
+		RecipientId:           tr.RecipientID,
+		Amount:                tr.Amount,
+		Currency:              tr.Currency,
+		CounterAmount:         tr.CounterAmount,
+		CounterCurrency:       tr.CounterCurrency,
+		FeeAmount:             tr.FeeAmount,
+		Memo:                  tr.Memo,
+		OriginalTransactionId: tr.OriginalTransactionID,
+		CreatedAt:             timestamppb.New(tr.CreatedAt),
+	}
+}
+
+// enqueue fills in the envelope of env and writes it to the outbox as canonical JSON.
+// The event joins the trace of the request or workflow that tx was opened with.
+func enqueue(tx *gorm.DB, aggregateID, correlationID, eventType string, env *eventsv1.Envelope) error {
+	env.EventId = uuid.New().String()
+	env.EventType = eventType
+	env.SchemaVersion = SchemaVersion
+	env.OccurredAt = timestamppb.New(time.Now())
+	env.CorrelationId = correlationID
+	env.AggregateId = aggregateID
+
+	b, err := Marshal(env)
+	if err != nil {
+		return err
+	}
+	headers := map[string]string{
+		HeaderEventID:       env.EventId,
+		HeaderSchemaVersion: strconv.Itoa(SchemaVersion),
+		HeaderCorrelationID: correlationID,
+		HeaderContentType:   "application/json",
+		tracecontext.Header: tracecontext.Child(tracecontext.FromContext(tx.Statement.Context)),
+	}
+	return outbox.Enqueue(tx, aggregateID, eventType, json.RawMessage(b), headers)
+}
+
+// Marshal serializes env as canonical protobuf JSON.
+func Marshal(env *eventsv1.Envelope) ([]byte, error) {
+	b, err := protojson.Marshal(env)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return b, nil
+}
+
+// Parse decodes an event serialized by Marshal. Unknown fields are ignored so older
+// consumers keep working when payloads gain fields.
+func Parse(b []byte) (*eventsv1.Envelope, error) {
+	var env eventsv1.Envelope
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, &env); err != nil {
+		return nil, fmt.Errorf("failed to parse event: %w", err)
+	}
+	if env.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, env.SchemaVersion)
+	}
+	return &env, nil
+}
@@ -0,0 +1,185 @@
+package events
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	eventsv1 "FinTechPorto/gen/api/events/v1"
+	"FinTechPorto/internal/models"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testEnvelope(eventType string) *eventsv1.Envelope {
+	return &eventsv1.Envelope{
+		EventId:       "5b0e7c1e-9f57-4d3c-a1a8-2f4e6d7c8b90",
+		EventType:     eventType,
+		SchemaVersion: SchemaVersion,
+		OccurredAt:    timestamppb.New(time.Date(2024, 3, 1, 12, 30, 0, 123_000_000, time.UTC)),
+		CorrelationId: "tx-1",
+		AggregateId:   "sender",
+	}
+}
+
+func testTransfer() *models.Transaction {
+	return &models.Transaction{
+		ID:              "tx-1",
+		Type:            "TRANSFER",
+		Status:          "COMPLETED",
+		SenderID:        "sender",
+		RecipientID:     "recipient",
+		Amount:          10_000,
+		Currency:        "USD",
+		CounterAmount:   9_210,
+		CounterCurrency: "EUR",
+		FeeAmount:       50,
+		Memo:            "invoice 42",
+		CreatedAt:       time.Date(2024, 3, 1, 12, 29, 59, 0, time.UTC),
+	}
+}
+
+func TestMarshalParseRoundTrip(t *testing.T) {
+	tr := Transfer(testTransfer())
+	refund := testTransfer()
+	refund.ID, refund.Type, refund.OriginalTransactionID = "tx-2", "REFUND", "tx-1"
+	refund.SenderID, refund.RecipientID = refund.RecipientID, refund.SenderID
+
+	tests := []struct {
+		eventType string
+		payload   func(env *eventsv1.Envelope)
+	}{
+		{TypeTransferCompleted, func(env *eventsv1.Envelope) {
+			env.Payload = &eventsv1.Envelope_TransferCompleted{TransferCompleted: &eventsv1.TransferCompleted{Transfer: tr, HoldId: "hold-1"}}
+		}},
+		{TypeTransferFailed, func(env *eventsv1.Envelope) {
+			env.Payload = &eventsv1.Envelope_TransferFailed{TransferFailed: &eventsv1.TransferFailed{Transfer: tr, Reason: "recipient account is frozen"}}
+		}},
+		{TypeTransferRejected, func(env *eventsv1.Envelope) {
+			env.Payload = &eventsv1.Envelope_TransferRejected{TransferRejected: &eventsv1.TransferRejected{Transfer: tr, ApproverId: "approver", Reason: "unknown payee"}}
+		}},
+		{TypeTransferReversed, func(env *eventsv1.Envelope) {
+			env.Payload = &eventsv1.Envelope_TransferReversed{TransferReversed: &eventsv1.TransferReversed{
+				Original: tr,
+				Reversal: Transfer(refund),
+				Amount:   4_000,
+				Reason:   "partial refund",
+			}}
+		}},
+		{TypeBatchSettled, func(env *eventsv1.Envelope) {
+			env.Payload = &eventsv1.Envelope_BatchSettled{BatchSettled: &eventsv1.BatchSettled{
+				BatchId:     "batch-1",
+				SenderId:    "sender",
+				Currency:    "USD",
+				Status:      "PARTIALLY_COMPLETED",
+				ItemCount:   3,
+				TotalAmount: 30_000,
+				TotalFee:    150,
+			}}
+		}},
+		{TypeOverdraftThresholdCrossed, func(env *eventsv1.Envelope) {
+			env.Payload = &eventsv1.Envelope_OverdraftThresholdCrossed{OverdraftThresholdCrossed: &eventsv1.OverdraftThresholdCrossed{
+				AccountId:        "sender",
+				Currency:         "USD",
+				Balance:          -8_500,
+				OverdraftLimit:   10_000,
+				UsagePercent:     85,
+				ThresholdPercent: 80,
+			}}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			env := testEnvelope(tt.eventType)
+			tt.payload(env)
+
+			b, err := Marshal(env)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			got, err := Parse(b)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !proto.Equal(got, env) {
+				t.Errorf("Parse(Marshal()) = %v, want %v", got, env)
+			}
+		})
+	}
+}
+
+func TestMarshalUsesJSONNames(t *testing.T) {
+	env := testEnvelope(TypeTransferFailed)
+	env.Payload = &eventsv1.Envelope_TransferFailed{TransferFailed: &eventsv1.TransferFailed{Transfer: Transfer(testTransfer()), Reason: "declined"}}
+	b, err := Marshal(env)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, want := range []string{`"eventType":"transfer.failed"`, `"schemaVersion":1`, `"transferFailed":`, `"occurredAt":"2024-03-01T12:30:00.123Z"`} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("Marshal() = %s, want it to contain %s", b, want)
+		}
+	}
+}
+
+func TestParseIgnoresUnknownFields(t *testing.T) {
+	b := []byte(`{"eventId":"evt-1","eventType":"transfer.failed","schemaVersion":1,"addedLater":true,` +
+		`"transferFailed":{"reason":"declined","riskScore":0.9,"transfer":{"transactionId":"tx-1","channel":"api"}}}`)
+	env, err := Parse(b)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := env.GetTransferFailed().GetTransfer().GetTransactionId(); got != "tx-1" {
+		t.Errorf("Parse() transaction ID = %q, want tx-1", got)
+	}
+	if got := env.GetTransferFailed().GetReason(); got != "declined" {
+		t.Errorf("Parse() reason = %q, want declined", got)
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want error
+	}{
+		{name: "newer schema version", body: `{"eventId":"evt-1","schemaVersion":2}`, want: ErrUnsupportedVersion},
+		{name: "not json", body: `transfer.completed`},
+		{name: "wrong field type", body: `{"eventId":"evt-1","schemaVersion":"one"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, err := Parse([]byte(tt.body))
+			if err == nil {
+				t.Fatalf("Parse() = %v, want an error", env)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	tr := testTransfer()
+	got := Transfer(tr)
+	want := &eventsv1.Transfer{
+		TransactionId:   "tx-1",
+		Type:            "TRANSFER",
+		Status:          "COMPLETED",
+		SenderId:        "sender",
+		RecipientId:     "recipient",
+		Amount:          10_000,
+		Currency:        "USD",
+		CounterAmount:   9_210,
+		CounterCurrency: "EUR",
+		FeeAmount:       50,
+		Memo:            "invoice 42",
+		CreatedAt:       timestamppb.New(tr.CreatedAt),
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("Transfer() = %v, want %v", got, want)
+	}
+}
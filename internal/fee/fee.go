@@ -0,0 +1,213 @@
+package fee
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"FinTechPorto/internal/currency"
+)
+
+// Products priced by the fee schedule.
+const (
+	// ProductTransfer is charged on transfers created through CreateTransfer.
+	ProductTransfer = "TRANSFER"
+	// ProductBatch is charged on each item of a batch transfer.
+	ProductBatch = "BATCH"
+)
+
+// Wildcard matches any product or corridor in a rule.
+const Wildcard = "*"
+
+// ErrInvalidSchedule is returned when a fee schedule is malformed.
+var ErrInvalidSchedule = errors.New("invalid fee schedule")
+
+// Tier overrides a rule's flat fee and percentage for amounts up to UpTo, inclusive.
+// A zero UpTo has no upper bound.
+type Tier struct {
+	UpTo int64            `json:"up_to"`
+	Flat map[string]int64 `json:"flat"`
+	Bps  int64            `json:"bps"`
+}
+
+// Rule prices one product in one corridor. Corridor is "<sell>-<buy>", for example
+// "USD-IDR", or "*" for any corridor. Flat, Min and Max are keyed by the currency the
+// fee is charged in, which is always the sender's currency, and are in minor units.
+type Rule struct {
+	Product  string           `json:"product"`
+	Corridor string           `json:"corridor"`
+	Flat     map[string]int64 `json:"flat"`
+	Bps      int64            `json:"bps"`
+	Tiers    []Tier           `json:"tiers"`
+	Min      map[string]int64 `json:"min"`
+	Max      map[string]int64 `json:"max"`
+}
+
+// name identifies the rule in a Breakdown.
+func (r Rule) name() string {
+	return r.Product + "/" + r.Corridor
+}
+
+// Schedule is an immutable set of fee rules. The zero Schedule charges nothing.
+type Schedule struct {
+	rules []Rule
+}
+
+// NewSchedule validates rules and returns a Schedule that applies them.
+func NewSchedule(rules []Rule) (*Schedule, error) {
+	for i, r := range rules {
+		if r.Product == "" || r.Corridor == "" {
+			return nil, fmt.Errorf("%w: rule %d needs a product and a corridor", ErrInvalidSchedule, i)
+		}
+		if r.Bps < 0 || r.Bps > 10_000 {
+			return nil, fmt.Errorf("%w: rule %s has bps outside 0-10000", ErrInvalidSchedule, r.name())
+		}
+		amounts := []struct {
+			what   string
+			values map[string]int64
+		}{{"flat", r.Flat}, {"min", r.Min}, {"max", r.Max}}
+		for _, a := range amounts {
+			if err := checkAmounts(a.values); err != nil {
+				return nil, fmt.Errorf("%w: rule %s has a %s %v", ErrInvalidSchedule, r.name(), a.what, err)
+			}
+		}
+		for cur, maxFee := range r.Max {
+			if minFee, ok := r.Min[cur]; ok && maxFee < minFee {
+				return nil, fmt.Errorf("%w: rule %s has a max below its min for %s", ErrInvalidSchedule, r.name(), cur)
+			}
+		}
+		for j, t := range r.Tiers {
+			if t.Bps < 0 || t.Bps > 10_000 {
+				return nil, fmt.Errorf("%w: tier %d of rule %s has bps outside 0-10000", ErrInvalidSchedule, j, r.name())
+			}
+			if err := checkAmounts(t.Flat); err != nil {
+				return nil, fmt.Errorf("%w: tier %d of rule %s has a flat %v", ErrInvalidSchedule, j, r.name(), err)
+			}
+			if j > 0 && (r.Tiers[j-1].UpTo == 0 || (t.UpTo != 0 && t.UpTo <= r.Tiers[j-1].UpTo)) {
+				return nil, fmt.Errorf("%w: tiers of rule %s must be in ascending order", ErrInvalidSchedule, r.name())
+			}
+		}
+	}
+	return &Schedule{rules: rules}, nil
+}
+
+// checkAmounts reports the first negative fee amount in amounts.
+func checkAmounts(amounts map[string]int64) error {
+	for cur, v := range amounts {
+		if v < 0 {
+			return fmt.Errorf("of %d %s, want at least 0", v, cur)
+		}
+	}
+	return nil
+}
+
+// LoadFile reads a Schedule from a JSON file of the form
+// {"rules": [{"product": "TRANSFER", "corridor": "USD-IDR", "flat": {"USD": 100}, "bps": 50,
+// "min": {"USD": 150}, "max": {"USD": 2500}}]}.
+func LoadFile(path string) (*Schedule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fee schedule: %w", err)
+	}
+	var f struct {
+		Rules []Rule `json:"rules"`
+	}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse fee schedule: %w", err)
+	}
+	return NewSchedule(f.Rules)
+}
+
+// Breakdown itemises the fee charged on an amount. Total is Flat plus Percentage plus
+// Adjustment, where Adjustment lifts the fee to the minimum or caps it at the maximum.
+type Breakdown struct {
+	Rule       string `json:"rule"`
+	Currency   string `json:"currency"`
+	Flat       int64  `json:"flat"`
+	Percentage int64  `json:"percentage"`
+	Adjustment int64  `json:"adjustment"`
+	Total      int64  `json:"total"`
+}
+
+// Corridor returns the corridor key for a transfer from sell into buy.
+func Corridor(sell, buy string) string {
+	return sell + "-" + buy
+}
+
+// Compute returns the fee for sending amount minor units of sell to a recipient in
+// buy. The most specific matching rule applies; without one the fee is zero.
+func (s *Schedule) Compute(product, sell, buy string, amount int64) (*Breakdown, error) {
+	if err := currency.Validate(sell); err != nil {
+		return nil, err
+	}
+	if err := currency.Validate(buy); err != nil {
+		return nil, err
+	}
+
+	b := &Breakdown{Currency: sell}
+	rule, ok := s.match(product, Corridor(sell, buy))
+	if !ok {
+		return b, nil
+	}
+	b.Rule = rule.name()
+
+	flat, bps := rule.Flat, rule.Bps
+	for _, t := range rule.Tiers {
+		if t.UpTo == 0 || amount <= t.UpTo {
+			flat, bps = t.Flat, t.Bps
+			break
+		}
+	}
+	b.Flat = flat[sell]
+	b.Percentage = percentage(amount, bps)
+
+	fee := b.Flat + b.Percentage
+	total := fee
+	if minFee, ok := rule.Min[sell]; ok && total < minFee {
+		total = minFee
+	}
+	if maxFee, ok := rule.Max[sell]; ok && total > maxFee {
+		total = maxFee
+	}
+	b.Adjustment = total - fee
+	b.Total = total
+	return b, nil
+}
+
+// match returns the most specific rule for product and corridor. An exact product
+// beats a wildcard product, then an exact corridor beats a wildcard corridor.
+func (s *Schedule) match(product, corridor string) (Rule, bool) {
+	if s == nil {
+		return Rule{}, false
+	}
+	best, bestScore := Rule{}, -1
+	for _, r := range s.rules {
+		score := 0
+		switch {
+		case strings.EqualFold(r.Product, product):
+			score += 2
+		case r.Product != Wildcard:
+			continue
+		}
+		switch {
+		case strings.EqualFold(r.Corridor, corridor):
+			score++
+		case r.Corridor != Wildcard:
+			continue
+		}
+		if score > bestScore {
+			best, bestScore = r, score
+		}
+	}
+	return best, bestScore >= 0
+}
+
+// percentage returns bps basis points of amount, rounded half up.
+func percentage(amount, bps int64) int64 {
+	v := new(big.Int).Mul(big.NewInt(amount), big.NewInt(bps))
+	v.Add(v, big.NewInt(5_000))
+	return v.Quo(v, big.NewInt(10_000)).Int64()
+}
@@ -0,0 +1,146 @@
+package fee
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestComputeClamp(t *testing.T) {
+	rule := func(min, max map[string]int64) []Rule {
+		return []Rule{{
+			Product:  ProductTransfer,
+			Corridor: Wildcard,
+			Flat:     map[string]int64{"USD": 100},
+			Bps:      50,
+			Min:      min,
+			Max:      max,
+		}}
+	}
+	usd := func(v int64) map[string]int64 { return map[string]int64{"USD": v} }
+
+	tests := []struct {
+		name           string
+		rules          []Rule
+		amount         int64
+		wantPercentage int64
+		wantAdjustment int64
+		wantTotal      int64
+	}{
+		{name: "below min is lifted", rules: rule(usd(150), usd(2500)), amount: 1_000, wantPercentage: 5, wantAdjustment: 45, wantTotal: 150},
+		{name: "at min is kept", rules: rule(usd(150), usd(2500)), amount: 10_000, wantPercentage: 50, wantTotal: 150},
+		{name: "between min and max is kept", rules: rule(usd(150), usd(2500)), amount: 100_000, wantPercentage: 500, wantTotal: 600},
+		{name: "at max is kept", rules: rule(usd(150), usd(2500)), amount: 480_000, wantPercentage: 2_400, wantTotal: 2_500},
+		{name: "above max is capped", rules: rule(usd(150), usd(2500)), amount: 1_000_000, wantPercentage: 5_000, wantAdjustment: -2_600, wantTotal: 2_500},
+		{name: "min in another currency is ignored", rules: rule(map[string]int64{"EUR": 150}, nil), amount: 1_000, wantPercentage: 5, wantTotal: 105},
+		{name: "percentage rounds half up", rules: rule(nil, nil), amount: 1_100, wantPercentage: 6, wantTotal: 106},
+		{name: "percentage rounds below half down", rules: rule(nil, nil), amount: 1_099, wantPercentage: 5, wantTotal: 105},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSchedule(tt.rules)
+			if err != nil {
+				t.Fatalf("NewSchedule() error = %v", err)
+			}
+			b, err := s.Compute(ProductTransfer, "USD", "IDR", tt.amount)
+			if err != nil {
+				t.Fatalf("Compute() error = %v", err)
+			}
+			if b.Flat != 100 || b.Percentage != tt.wantPercentage || b.Adjustment != tt.wantAdjustment || b.Total != tt.wantTotal {
+				t.Errorf("Compute() = flat %d + percentage %d + adjustment %d = %d, want 100 + %d + %d = %d",
+					b.Flat, b.Percentage, b.Adjustment, b.Total, tt.wantPercentage, tt.wantAdjustment, tt.wantTotal)
+			}
+			if b.Flat+b.Percentage+b.Adjustment != b.Total {
+				t.Errorf("Compute() breakdown does not add up to Total %d", b.Total)
+			}
+		})
+	}
+}
+
+func TestComputeTiersAndMatching(t *testing.T) {
+	s, err := NewSchedule([]Rule{
+		{Product: Wildcard, Corridor: Wildcard, Flat: map[string]int64{"USD": 1}},
+		{Product: ProductTransfer, Corridor: Wildcard, Flat: map[string]int64{"USD": 2}},
+		{
+			Product:  ProductTransfer,
+			Corridor: "USD-IDR",
+			Tiers: []Tier{
+				{UpTo: 10_000, Flat: map[string]int64{"USD": 50}},
+				{UpTo: 100_000, Bps: 100},
+				{Bps: 10},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewSchedule() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		product  string
+		buy      string
+		amount   int64
+		wantRule string
+		want     int64
+	}{
+		{name: "first tier up to its bound", product: ProductTransfer, buy: "IDR", amount: 10_000, wantRule: "TRANSFER/USD-IDR", want: 50},
+		{name: "second tier above the first bound", product: ProductTransfer, buy: "IDR", amount: 10_001, wantRule: "TRANSFER/USD-IDR", want: 100},
+		{name: "unbounded last tier", product: ProductTransfer, buy: "IDR", amount: 1_000_000, wantRule: "TRANSFER/USD-IDR", want: 1_000},
+		{name: "exact product beats wildcard corridor", product: ProductTransfer, buy: "EUR", amount: 10_000, wantRule: "TRANSFER/*", want: 2},
+		{name: "wildcard product", product: ProductBatch, buy: "IDR", amount: 10_000, wantRule: "*/*", want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := s.Compute(tt.product, "USD", tt.buy, tt.amount)
+			if err != nil {
+				t.Fatalf("Compute() error = %v", err)
+			}
+			if b.Rule != tt.wantRule || b.Total != tt.want {
+				t.Errorf("Compute() = %s %d, want %s %d", b.Rule, b.Total, tt.wantRule, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeWithoutRule(t *testing.T) {
+	var s *Schedule
+	b, err := s.Compute(ProductTransfer, "USD", "USD", 10_000)
+	if err != nil {
+		t.Fatalf("Compute() error = %v", err)
+	}
+	if b.Total != 0 || b.Rule != "" {
+		t.Errorf("Compute() = %s %d, want no rule and no fee", b.Rule, b.Total)
+	}
+	if _, err := s.Compute(ProductTransfer, "usd", "USD", 10_000); err == nil {
+		t.Error("Compute() with a lower-case currency error = nil, want an error")
+	}
+}
+
+func TestNewScheduleRejects(t *testing.T) {
+	usd := func(v int64) map[string]int64 { return map[string]int64{"USD": v} }
+	tests := []struct {
+		name string
+		rule Rule
+	}{
+		{name: "no product", rule: Rule{Corridor: Wildcard}},
+		{name: "bps above 10000", rule: Rule{Product: Wildcard, Corridor: Wildcard, Bps: 10_001}},
+		{name: "negative bps", rule: Rule{Product: Wildcard, Corridor: Wildcard, Bps: -1}},
+		{name: "negative flat", rule: Rule{Product: Wildcard, Corridor: Wildcard, Flat: usd(-1)}},
+		{name: "negative min", rule: Rule{Product: Wildcard, Corridor: Wildcard, Min: usd(-1)}},
+		{name: "negative max", rule: Rule{Product: Wildcard, Corridor: Wildcard, Max: usd(-1)}},
+		{name: "max below min", rule: Rule{Product: Wildcard, Corridor: Wildcard, Min: usd(500), Max: usd(300)}},
+		{name: "negative tier flat", rule: Rule{Product: Wildcard, Corridor: Wildcard, Tiers: []Tier{{Flat: usd(-1)}}}},
+		{name: "tiers out of order", rule: Rule{Product: Wildcard, Corridor: Wildcard, Tiers: []Tier{{UpTo: 100}, {UpTo: 50}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewSchedule([]Rule{tt.rule}); !errors.Is(err, ErrInvalidSchedule) {
+				t.Errorf("NewSchedule() error = %v, want %v", err, ErrInvalidSchedule)
+			}
+		})
+	}
+
+	// min and max in different currencies do not constrain each other
+	if _, err := NewSchedule([]Rule{{Product: Wildcard, Corridor: Wildcard, Min: usd(500), Max: map[string]int64{"EUR": 300}}}); err != nil {
+		t.Errorf("NewSchedule() error = %v, want nil", err)
+	}
+}
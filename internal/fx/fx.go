@@ -0,0 +1,241 @@
+package fx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"FinTechPorto/internal/currency"
+)
+
+var (
+	// ErrRateNotFound is returned when no rate is known for a currency pair.
+	ErrRateNotFound = errors.New("fx rate not found")
+	// ErrInvalidRate is returned when a rate is not a positive decimal.
+	ErrInvalidRate = errors.New("invalid fx rate")
+	// ErrInvalidSpread is returned when a spread is not between 0 and 9999 basis points.
+	ErrInvalidSpread = errors.New("invalid fx spread")
+)
+
+// Rate is the mid-market price of one unit of Base in units of Quote, in major units.
+type Rate struct {
+	Base  string `json:"base"`
+	Quote string `json:"quote"`
+	Rate  string `json:"rate"`
+}
+
+// rateFile is the layout of the file passed to LoadFile.
+type rateFile struct {
+	SpreadBps *int64 `json:"spread_bps"`
+	Rates     []Rate `json:"rates"`
+}
+
+// pair identifies a directed currency pair.
+type pair struct {
+	base  string
+	quote string
+}
+
+// Book is an in-memory, concurrency-safe table of mid-market rates plus the spread
+// charged on top of them and how long quoted rates stay locked.
+type Book struct {
+	mu        sync.RWMutex
+	rates     map[pair]*big.Rat
+	spreadBps int64
+	quoteTTL  time.Duration
+}
+
+// NewBook creates an empty Book that charges spreadBps basis points and locks quotes
+// for quoteTTL.
+func NewBook(spreadBps int64, quoteTTL time.Duration) *Book {
+	return &Book{rates: make(map[pair]*big.Rat), spreadBps: spreadBps, quoteTTL: quoteTTL}
+}
+
+// QuoteTTL returns how long a quoted rate stays locked.
+func (b *Book) QuoteTTL() time.Duration {
+	return b.quoteTTL
+}
+
+// LoadFile replaces the book's rates with those in a JSON file of the form
+// {"spread_bps": 50, "rates": [{"base": "USD", "quote": "IDR", "rate": "15650.25"}]}.
+// spread_bps is optional and overrides the configured spread when present.
+func (b *Book) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read rate file: %w", err)
+	}
+	var f rateFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("failed to parse rate file: %w", err)
+	}
+
+	if f.SpreadBps != nil {
+		if err := ValidateSpread(*f.SpreadBps); err != nil {
+			return err
+		}
+	}
+	rates := make(map[pair]*big.Rat, len(f.Rates))
+	for _, r := range f.Rates {
+		rat, err := validateRate(r)
+		if err != nil {
+			return err
+		}
+		rates[pair{r.Base, r.Quote}] = rat
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rates = rates
+	if f.SpreadBps != nil {
+		b.spreadBps = *f.SpreadBps
+	}
+	return nil
+}
+
+// Set stores the mid-market rate for base/quote.
+func (b *Book) Set(r Rate) error {
+	rat, err := validateRate(r)
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rates[pair{r.Base, r.Quote}] = rat
+	return nil
+}
+
+// Rates returns every configured rate sorted by pair.
+func (b *Book) Rates() []Rate {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	out := make([]Rate, 0, len(b.rates))
+	for p, rat := range b.rates {
+		out = append(out, Rate{Base: p.base, Quote: p.quote, Rate: FormatRate(rat)})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Base != out[j].Base {
+			return out[i].Base < out[j].Base
+		}
+		return out[i].Quote < out[j].Quote
+	})
+	return out
+}
+
+// SpreadBps returns the spread charged on conversions, in basis points.
+func (b *Book) SpreadBps() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.spreadBps
+}
+
+// MidRate returns the mid-market rate to convert from into to, inverting the
+// opposite pair when only that one is configured.
+func (b *Book) MidRate(from, to string) (*big.Rat, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if r, ok := b.rates[pair{from, to}]; ok {
+		return new(big.Rat).Set(r), nil
+	}
+	if r, ok := b.rates[pair{to, from}]; ok {
+		return new(big.Rat).Inv(r), nil
+	}
+	return nil, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+}
+
+// Conversion is the result of pricing a conversion with the book.
+type Conversion struct {
+	MidRate   *big.Rat
+	Rate      *big.Rat
+	SpreadBps int64
+	BuyAmount int64
+}
+
+// Convert prices selling sellAmount minor units of from for to. The customer rate is
+// the mid rate reduced by the spread, and the bought amount is rounded down.
+func (b *Book) Convert(from, to string, sellAmount int64) (*Conversion, error) {
+	mid, err := b.MidRate(from, to)
+	if err != nil {
+		return nil, err
+	}
+	spread := b.SpreadBps()
+
+	rate := new(big.Rat).Mul(mid, big.NewRat(10_000-spread, 10_000))
+	return &Conversion{
+		MidRate:   mid,
+		Rate:      rate,
+		SpreadBps: spread,
+		BuyAmount: ConvertAmount(sellAmount, from, to, rate),
+	}, nil
+}
+
+// ConvertAmount converts amount minor units of from into minor units of to at rate,
+// rounding down.
+func ConvertAmount(amount int64, from, to string, rate *big.Rat) int64 {
+	v := new(big.Rat).Mul(big.NewRat(amount, 1), rate)
+	shift := currency.MinorUnits(to) - currency.MinorUnits(from)
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil)
+	if shift >= 0 {
+		v.Mul(v, new(big.Rat).SetInt(scale))
+	} else {
+		v.Quo(v, new(big.Rat).SetInt(scale))
+	}
+	return new(big.Int).Quo(v.Num(), v.Denom()).Int64()
+}
+
+// ParseRate parses a positive decimal rate such as "15650.25".
+func ParseRate(s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || r.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+	return r, nil
+}
+
+// FormatRate renders a rate with ten decimal places.
+func FormatRate(r *big.Rat) string {
+	return r.FloatString(10)
+}
+
+// ValidateRate reports whether Set would accept r, so callers can check a rate before
+// storing it.
+func ValidateRate(r Rate) error {
+	_, err := validateRate(r)
+	return err
+}
+
+// ValidateSpread checks that a spread in basis points leaves a positive customer rate
+// no better than mid-market.
+func ValidateSpread(bps int64) error {
+	if bps < 0 || bps >= 10_000 {
+		return fmt.Errorf("%w: %d bps is outside 0-9999", ErrInvalidSpread, bps)
+	}
+	return nil
+}
+
+// validateRate checks the currencies and parses the rate of r.
+func validateRate(r Rate) (*big.Rat, error) {
+	if err := currency.Validate(r.Base); err != nil {
+		return nil, err
+	}
+	if err := currency.Validate(r.Quote); err != nil {
+		return nil, err
+	}
+	if r.Base == r.Quote {
+		return nil, fmt.Errorf("%w: %s/%s is not a currency pair", ErrInvalidRate, r.Base, r.Quote)
+	}
+	return ParseRate(r.Rate)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
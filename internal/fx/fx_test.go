@@ -0,0 +1,150 @@
+package fx
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"FinTechPorto/internal/currency"
+)
+
+func rat(t *testing.T, s string) *big.Rat {
+	t.Helper()
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		t.Fatalf("invalid rational %q", s)
+	}
+	return r
+}
+
+func TestConvertAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		from   string
+		to     string
+		rate   string
+		want   int64
+	}{
+		{name: "same exponent", amount: 10_000, from: "USD", to: "IDR", rate: "15650.25", want: 156_502_500},
+		{name: "same exponent rounds down", amount: 1, from: "USD", to: "EUR", rate: "0.9199", want: 0},
+		{name: "fraction rounds down not half up", amount: 10_000, from: "USD", to: "EUR", rate: "0.91999", want: 9_199},
+		{name: "to fewer minor units", amount: 1_000, from: "USD", to: "JPY", rate: "150.129", want: 1_501},
+		{name: "to fewer minor units exact", amount: 1_000, from: "USD", to: "JPY", rate: "150", want: 1_500},
+		{name: "from fewer minor units", amount: 1_000, from: "JPY", to: "USD", rate: "0.0066666", want: 666},
+		{name: "to more minor units", amount: 100, from: "USD", to: "KWD", rate: "0.3075", want: 307},
+		{name: "from more minor units", amount: 1_000, from: "KWD", to: "USD", rate: "3.25199", want: 325},
+		{name: "zero amount", amount: 0, from: "USD", to: "JPY", rate: "150.129", want: 0},
+		{name: "large amount is exact", amount: 9_000_000_000_000, from: "USD", to: "IDR", rate: "1.0000000001", want: 9_000_000_000_900},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertAmount(tt.amount, tt.from, tt.to, rat(t, tt.rate)); got != tt.want {
+				t.Errorf("ConvertAmount(%d %s->%s at %s) = %d, want %d", tt.amount, tt.from, tt.to, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	b := NewBook(50, 0)
+	if err := b.Set(Rate{Base: "USD", Quote: "IDR", Rate: "15650.25"}); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		sell     int64
+		spread   int64
+		wantRate string
+		wantBuy  int64
+	}{
+		{name: "direct pair at mid", from: "USD", to: "IDR", sell: 10_000, wantRate: "15650.2500000000", wantBuy: 156_502_500},
+		{name: "direct pair less spread", from: "USD", to: "IDR", sell: 10_000, spread: 50, wantRate: "15571.9987500000", wantBuy: 155_719_987},
+		{name: "inverted pair at mid", from: "IDR", to: "USD", sell: 156_502_500, wantRate: "0.0000638967", wantBuy: 10_000},
+		{name: "inverted pair less spread", from: "IDR", to: "USD", sell: 156_502_500, spread: 50, wantRate: "0.0000635773", wantBuy: 9_950},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b.spreadBps = tt.spread
+			c, err := b.Convert(tt.from, tt.to, tt.sell)
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if got := FormatRate(c.Rate); got != tt.wantRate {
+				t.Errorf("Convert() Rate = %s, want %s", got, tt.wantRate)
+			}
+			if c.BuyAmount != tt.wantBuy {
+				t.Errorf("Convert() BuyAmount = %d, want %d", c.BuyAmount, tt.wantBuy)
+			}
+			if c.SpreadBps != tt.spread {
+				t.Errorf("Convert() SpreadBps = %d, want %d", c.SpreadBps, tt.spread)
+			}
+		})
+	}
+
+	if _, err := b.Convert("USD", "EUR", 10_000); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Convert() of an unknown pair error = %v, want %v", err, ErrRateNotFound)
+	}
+}
+
+func TestValidateRate(t *testing.T) {
+	tests := []struct {
+		name string
+		rate Rate
+		want error
+	}{
+		{name: "valid", rate: Rate{Base: "USD", Quote: "IDR", Rate: "15650.25"}},
+		{name: "fraction", rate: Rate{Base: "USD", Quote: "EUR", Rate: "1/3"}},
+		{name: "zero", rate: Rate{Base: "USD", Quote: "IDR", Rate: "0"}, want: ErrInvalidRate},
+		{name: "negative", rate: Rate{Base: "USD", Quote: "IDR", Rate: "-1.5"}, want: ErrInvalidRate},
+		{name: "not a number", rate: Rate{Base: "USD", Quote: "IDR", Rate: "abc"}, want: ErrInvalidRate},
+		{name: "same currency", rate: Rate{Base: "USD", Quote: "USD", Rate: "1"}, want: ErrInvalidRate},
+		{name: "lower-case currency", rate: Rate{Base: "usd", Quote: "IDR", Rate: "1"}, want: currency.ErrInvalidCurrency},
+		{name: "unknown currency", rate: Rate{Base: "USD", Quote: "XYZ", Rate: "1"}, want: currency.ErrInvalidCurrency},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateRate(tt.rate); !errors.Is(err, tt.want) {
+				t.Errorf("ValidateRate() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFileSpread(t *testing.T) {
+	tests := []struct {
+		name    string
+		spread  string
+		want    error
+		wantBps int64
+	}{
+		{name: "absent keeps configured", spread: "", wantBps: 50},
+		{name: "zero", spread: `"spread_bps": 0,`, wantBps: 0},
+		{name: "largest", spread: `"spread_bps": 9999,`, wantBps: 9999},
+		{name: "negative", spread: `"spread_bps": -1,`, want: ErrInvalidSpread, wantBps: 50},
+		{name: "whole rate", spread: `"spread_bps": 10000,`, want: ErrInvalidSpread, wantBps: 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rates.json")
+			data := `{` + tt.spread + `"rates": [{"base": "USD", "quote": "IDR", "rate": "15650.25"}]}`
+			if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			b := NewBook(50, 0)
+			if err := b.LoadFile(path); !errors.Is(err, tt.want) {
+				t.Fatalf("LoadFile() error = %v, want %v", err, tt.want)
+			}
+			if got := b.SpreadBps(); got != tt.wantBps {
+				t.Errorf("SpreadBps() = %d, want %d", got, tt.wantBps)
+			}
+			if tt.want != nil && len(b.Rates()) != 0 {
+				t.Errorf("Rates() after a rejected file = %v, want none", b.Rates())
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+
+	"FinTechPorto/internal/models"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrInsufficientFunds is returned when the available balance cannot cover a debit.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrHoldNotActive is returned when capturing or releasing a hold that is no longer active.
+	ErrHoldNotActive = errors.New("hold is not active")
+)
+
+// AvailableBalance is what acc can still spend: the ledger balance not reserved by
+// active holds plus the unused part of its overdraft.
+func AvailableBalance(acc *models.Account) int64 {
+	return acc.Balance - acc.HeldAmount + acc.OverdraftLimit
+}
+
+// CheckFunds returns ErrInsufficientFunds if acc cannot be debited amount. acc should
+// have been loaded with a row lock in the same DB transaction as the debit.
+func CheckFunds(acc *models.Account, amount int64) error {
+	if AvailableBalance(acc) < amount {
+		return ErrInsufficientFunds
+	}
+	return nil
+}
+
+// PlaceHold reserves hold.Amount on acc and stores hold as ACTIVE. acc must be row-locked
+// in tx.
+func PlaceHold(tx *gorm.DB, acc *models.Account, hold *models.Hold) error {
+	if err := CheckFunds(acc, hold.Amount); err != nil {
+		return err
+	}
+
+	hold.AccountID = acc.ID
+	hold.Currency = acc.Currency
+	hold.Status = "ACTIVE"
+	if err := tx.Create(hold).Error; err != nil {
+		return fmt.Errorf("failed to create hold: %w", err)
+	}
+	if err := tx.Model(&models.Account{}).Where("id = ?", acc.ID).
+		Update("held_amount", gorm.Expr("held_amount + ?", hold.Amount)).Error; err != nil {
+		return fmt.Errorf("failed to reserve held amount: %w", err)
+	}
+	acc.HeldAmount += hold.Amount
+	return nil
+}
+
+// ReleaseHold moves an ACTIVE hold to status and returns its full amount to the
+// available balance. hold must be row-locked in tx.
+func ReleaseHold(tx *gorm.DB, hold *models.Hold, status string) error {
+	if hold.Status != "ACTIVE" {
+		return fmt.Errorf("%w: hold is %s", ErrHoldNotActive, hold.Status)
+	}
+
+	if err := tx.Model(&models.Account{}).Where("id = ?", hold.AccountID).
+		Update("held_amount", gorm.Expr("held_amount - ?", hold.Amount)).Error; err != nil {
+		return fmt.Errorf("failed to release held amount: %w", err)
+	}
+	hold.Status = status
+	if err := tx.Model(hold).Updates(map[string]interface{}{
+		"status":                 hold.Status,
+		"captured_amount":        hold.CapturedAmount,
+		"capture_transaction_id": hold.CaptureTransactionID,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to update hold: %w", err)
+	}
+	return nil
+}
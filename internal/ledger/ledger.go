@@ -0,0 +1,206 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"FinTechPorto/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Side is the direction of a journal line. Wallet accounts are liabilities of
+// the house, so a debit decreases an account balance and a credit increases it.
+type Side string
+
+const (
+	Debit  Side = "DEBIT"
+	Credit Side = "CREDIT"
+)
+
+// System account purposes. Each purpose has one house account per currency.
+const (
+	// PurposeTransit holds funds between the debit and credit legs of a workflow transfer.
+	PurposeTransit = "transit"
+	// PurposeOpeningBalance is the counterparty for balances loaded outside a transfer.
+	PurposeOpeningBalance = "opening-balance"
+	// PurposeFx is the house position that buys and sells currency in cross-currency transfers.
+	PurposeFx = "fx"
+	// PurposeFees is the house revenue account that collects transfer fees.
+	PurposeFees = "fees"
+	// PurposeOverdraftInterest is the house revenue account that collects overdraft interest.
+	PurposeOverdraftInterest = "overdraft-interest"
+)
+
+var (
+	// ErrUnbalanced is returned when debits and credits of a posting differ for some currency.
+	ErrUnbalanced = errors.New("journal entry does not balance")
+	// ErrInvalidLine is returned when a journal line is malformed.
+	ErrInvalidLine = errors.New("invalid journal line")
+)
+
+// systemNamespace seeds the deterministic IDs of system accounts.
+var systemNamespace = uuid.MustParse("6f1c2a1e-4b5d-4c1e-9a57-3d2f0b7e8c11")
+
+// balanceKey identifies a cached balance touched by a posting.
+type balanceKey struct {
+	accountID string
+	currency  string
+}
+
+// Line is a single debit or credit of a posting.
+type Line struct {
+	AccountID string
+	Currency  string
+	Side      Side
+	Amount    int64
+}
+
+// Post validates lines, writes them as one journal entry and applies them to the
+// cached account balances. tx must be an open DB transaction so the entry and the
+// balance updates commit together with the caller's other writes.
+func Post(tx *gorm.DB, reference, description string, lines ...Line) (*models.JournalEntry, error) {
+	if err := validate(lines); err != nil {
+		return nil, err
+	}
+
+	entry := models.JournalEntry{
+		Reference:   reference,
+		Description: description,
+	}
+	deltas := make(map[balanceKey]int64)
+	for _, l := range lines {
+		entry.Lines = append(entry.Lines, models.JournalLine{
+			AccountID: l.AccountID,
+			Side:      string(l.Side),
+			Amount:    l.Amount,
+			Currency:  l.Currency,
+		})
+		k := balanceKey{accountID: l.AccountID, currency: l.Currency}
+		if l.Side == Debit {
+			deltas[k] -= l.Amount
+		} else {
+			deltas[k] += l.Amount
+		}
+	}
+
+	if err := tx.Create(&entry).Error; err != nil {
+		return nil, fmt.Errorf("failed to create journal entry: %w", err)
+	}
+
+	// apply in a stable order to keep lock acquisition consistent across postings
+	keys := make([]balanceKey, 0, len(deltas))
+	for k := range deltas {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].accountID != keys[j].accountID {
+			return keys[i].accountID < keys[j].accountID
+		}
+		return keys[i].currency < keys[j].currency
+	})
+	for _, k := range keys {
+		var acc models.Account
+		res := tx.Model(&acc).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "balance"}, {Name: "overdraft_limit"}}}).
+			Where("id = ? AND currency = ?", k.accountID, k.currency).
+			Update("balance", gorm.Expr("balance + ?", deltas[k]))
+		if res.Error != nil {
+			return nil, fmt.Errorf("failed to update balance of %s: %w", k.accountID, res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return nil, fmt.Errorf("%w: account %s not found in %s", ErrInvalidLine, k.accountID, k.currency)
+		}
+		if deltas[k] < 0 && acc.OverdraftLimit > 0 {
+			acc.Currency = k.currency
+			if err := alertOverdraft(tx, &acc, reference, acc.Balance-deltas[k]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &entry, nil
+}
+
+// Posted reports whether the entry identified by reference and description has been
+// posted, so that steps which may run more than once post it only once.
+func Posted(tx *gorm.DB, reference, description string) (bool, error) {
+	var n int64
+	if err := tx.Model(&models.JournalEntry{}).Where("reference = ? AND description = ?", reference, description).Count(&n).Error; err != nil {
+		return false, fmt.Errorf("failed to look up journal entry: %w", err)
+	}
+	return n > 0, nil
+}
+
+// validate checks that every line is well-formed and that debits equal credits per currency.
+func validate(lines []Line) error {
+	if len(lines) < 2 {
+		return fmt.Errorf("%w: a posting needs at least two lines", ErrInvalidLine)
+	}
+	sums := make(map[string]int64)
+	for _, l := range lines {
+		if l.AccountID == "" || l.Currency == "" {
+			return fmt.Errorf("%w: missing account or currency", ErrInvalidLine)
+		}
+		if l.Amount <= 0 {
+			return fmt.Errorf("%w: amount must be positive", ErrInvalidLine)
+		}
+		switch l.Side {
+		case Debit:
+			sums[l.Currency] -= l.Amount
+		case Credit:
+			sums[l.Currency] += l.Amount
+		default:
+			return fmt.Errorf("%w: unknown side %q", ErrInvalidLine, l.Side)
+		}
+	}
+	for currency, sum := range sums {
+		if sum != 0 {
+			return fmt.Errorf("%w: %s is off by %d", ErrUnbalanced, currency, sum)
+		}
+	}
+	return nil
+}
+
+// Transfer returns the two lines that move amount from one account to another.
+func Transfer(fromID, toID, currency string, amount int64) []Line {
+	return []Line{
+		{AccountID: fromID, Currency: currency, Side: Debit, Amount: amount},
+		{AccountID: toID, Currency: currency, Side: Credit, Amount: amount},
+	}
+}
+
+// SystemAccount returns the house account for purpose in currency, creating it on
+// first use. System account IDs are derived from purpose and currency so concurrent
+// callers always resolve to the same row.
+func SystemAccount(tx *gorm.DB, purpose, currency string) (*models.Account, error) {
+	acc := models.Account{
+		ID:       uuid.NewSHA1(systemNamespace, []byte(purpose+":"+currency)).String(),
+		UserID:   "system:" + purpose,
+		Currency: currency,
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&acc).Error; err != nil {
+		return nil, fmt.Errorf("failed to create %s account: %w", purpose, err)
+	}
+	if err := tx.Where("id = ?", acc.ID).First(&acc).Error; err != nil {
+		return nil, fmt.Errorf("failed to load %s account: %w", purpose, err)
+	}
+	return &acc, nil
+}
+
+// DerivedBalance recomputes an account balance from its journal lines. It should
+// always equal the cached models.Account.Balance.
+func DerivedBalance(tx *gorm.DB, accountID string) (int64, error) {
+	var balance int64
+	err := tx.Model(&models.JournalLine{}).
+		Where("account_id = ?", accountID).
+		Select("COALESCE(SUM(CASE WHEN side = ? THEN amount ELSE -amount END), 0)", string(Credit)).
+		Scan(&balance).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to derive balance: %w", err)
+	}
+	return balance, nil
+}
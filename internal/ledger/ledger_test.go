@@ -0,0 +1,111 @@
+package ledger
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPostRejects(t *testing.T) {
+	const alice, bob, fx = "alice", "bob", "fx"
+	line := func(account, currency string, side Side, amount int64) Line {
+		return Line{AccountID: account, Currency: currency, Side: side, Amount: amount}
+	}
+
+	tests := []struct {
+		name  string
+		lines []Line
+		want  error
+	}{
+		{
+			name:  "debit above credit",
+			lines: []Line{line(alice, "USD", Debit, 1_000), line(bob, "USD", Credit, 999)},
+			want:  ErrUnbalanced,
+		},
+		{
+			name:  "credit above debit",
+			lines: []Line{line(alice, "USD", Debit, 1_000), line(bob, "USD", Credit, 1_001)},
+			want:  ErrUnbalanced,
+		},
+		{
+			// the totals match, but each currency must balance on its own
+			name:  "balanced only across currencies",
+			lines: []Line{line(alice, "USD", Debit, 1_000), line(bob, "EUR", Credit, 1_000)},
+			want:  ErrUnbalanced,
+		},
+		{
+			name: "one currency of an fx posting off",
+			lines: []Line{
+				line(alice, "USD", Debit, 1_000), line(fx, "USD", Credit, 1_000),
+				line(fx, "EUR", Debit, 920), line(bob, "EUR", Credit, 921),
+			},
+			want: ErrUnbalanced,
+		},
+		{
+			name:  "single line",
+			lines: []Line{line(alice, "USD", Debit, 1_000)},
+			want:  ErrInvalidLine,
+		},
+		{
+			name:  "zero amount",
+			lines: []Line{line(alice, "USD", Debit, 0), line(bob, "USD", Credit, 0)},
+			want:  ErrInvalidLine,
+		},
+		{
+			name:  "negative amount",
+			lines: []Line{line(alice, "USD", Debit, -1_000), line(bob, "USD", Credit, -1_000)},
+			want:  ErrInvalidLine,
+		},
+		{
+			name:  "missing currency",
+			lines: []Line{line(alice, "", Debit, 1_000), line(bob, "", Credit, 1_000)},
+			want:  ErrInvalidLine,
+		},
+		{
+			name:  "unknown side",
+			lines: []Line{line(alice, "USD", Debit, 1_000), line(bob, "USD", "REFUND", 1_000)},
+			want:  ErrInvalidLine,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// lines are validated before anything is written, so no database is needed
+			entry, err := Post(nil, "ref", "test posting", tt.lines...)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Post() error = %v, want %v", err, tt.want)
+			}
+			if entry != nil {
+				t.Errorf("Post() entry = %+v, want nil", entry)
+			}
+		})
+	}
+}
+
+func TestValidateBalanced(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []Line
+	}{
+		{name: "transfer", lines: Transfer("alice", "bob", "USD", 1_000)},
+		{name: "transfer with fee", lines: append(Transfer("alice", "bob", "USD", 1_000), Transfer("alice", "fees", "USD", 25)...)},
+		{
+			name: "fx posting",
+			lines: append(Transfer("alice", "fx", "USD", 1_000),
+				Transfer("fx", "bob", "EUR", 920)...),
+		},
+		{
+			name: "split credit",
+			lines: []Line{
+				{AccountID: "alice", Currency: "USD", Side: Debit, Amount: 1_000},
+				{AccountID: "bob", Currency: "USD", Side: Credit, Amount: 600},
+				{AccountID: "carol", Currency: "USD", Side: Credit, Amount: 400},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validate(tt.lines); err != nil {
+				t.Errorf("validate() error = %v, want nil", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package ledger
+
+import (
+	"math/big"
+
+	"FinTechPorto/internal/events"
+	"FinTechPorto/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// OverdraftAlertThresholds are the percentages of the overdraft limit whose crossing
+// raises an OverdraftThresholdCrossed event, in ascending order.
+var OverdraftAlertThresholds = []int64{50, 80, 100}
+
+// OverdraftUsage returns how much of its overdraft a balance uses, in percent of limit.
+func OverdraftUsage(balance, limit int64) int64 {
+	if balance >= 0 || limit <= 0 {
+		return 0
+	}
+	v := new(big.Int).Mul(big.NewInt(-balance), big.NewInt(100))
+	return v.Quo(v, big.NewInt(limit)).Int64()
+}
+
+// DailyOverdraftInterest is one day of interest at rateBps a year on a negative balance,
+// rounded half up to the minor unit. A balance that is not negative accrues nothing.
+func DailyOverdraftInterest(balance, rateBps int64) int64 {
+	if balance >= 0 || rateBps <= 0 {
+		return 0
+	}
+	const denom = 10_000 * 365
+	v := new(big.Int).Mul(big.NewInt(-balance), big.NewInt(rateBps))
+	v.Add(v, big.NewInt(denom/2))
+	return v.Quo(v, big.NewInt(denom)).Int64()
+}
+
+// alertOverdraft enqueues an OverdraftThresholdCrossed event for the highest threshold
+// that the posting reference moved acc past, from previous to acc.Balance, if any.
+func alertOverdraft(tx *gorm.DB, acc *models.Account, reference string, previous int64) error {
+	before := OverdraftUsage(previous, acc.OverdraftLimit)
+	after := OverdraftUsage(acc.Balance, acc.OverdraftLimit)
+	var crossed int64
+	for _, t := range OverdraftAlertThresholds {
+		if before < t && after >= t {
+			crossed = t
+		}
+	}
+	if crossed == 0 {
+		return nil
+	}
+	return events.OverdraftThresholdCrossed(tx, acc, reference, after, crossed)
+}
@@ -0,0 +1,64 @@
+package ledger
+
+import (
+	"errors"
+
+	"FinTechPorto/internal/models"
+)
+
+// Account statuses. An ACTIVE account can send and receive; FROZEN can do neither;
+// DEBIT_BLOCKED can only receive and CREDIT_BLOCKED can only send. CLOSED is final.
+const (
+	StatusActive        = "ACTIVE"
+	StatusFrozen        = "FROZEN"
+	StatusDebitBlocked  = "DEBIT_BLOCKED"
+	StatusCreditBlocked = "CREDIT_BLOCKED"
+	StatusClosed        = "CLOSED"
+)
+
+var (
+	// ErrAccountFrozen is returned when debiting or crediting a FROZEN account.
+	ErrAccountFrozen = errors.New("account is frozen")
+	// ErrDebitBlocked is returned when debiting a DEBIT_BLOCKED account.
+	ErrDebitBlocked = errors.New("account is blocked for debits")
+	// ErrCreditBlocked is returned when crediting a CREDIT_BLOCKED account.
+	ErrCreditBlocked = errors.New("account is blocked for credits")
+	// ErrAccountClosed is returned when an operation targets a closed account.
+	ErrAccountClosed = errors.New("account is closed")
+)
+
+// ValidStatus reports whether status is one of the account statuses.
+func ValidStatus(status string) bool {
+	switch status {
+	case StatusActive, StatusFrozen, StatusDebitBlocked, StatusCreditBlocked, StatusClosed:
+		return true
+	}
+	return false
+}
+
+// CheckDebit returns an error if the status of acc does not allow debits. Compensating
+// postings that return funds to where they came from are exempt and do not call it.
+func CheckDebit(acc *models.Account) error {
+	switch acc.Status {
+	case StatusFrozen:
+		return ErrAccountFrozen
+	case StatusDebitBlocked:
+		return ErrDebitBlocked
+	case StatusClosed:
+		return ErrAccountClosed
+	}
+	return nil
+}
+
+// CheckCredit returns an error if the status of acc does not allow credits.
+func CheckCredit(acc *models.Account) error {
+	switch acc.Status {
+	case StatusFrozen:
+		return ErrAccountFrozen
+	case StatusCreditBlocked:
+		return ErrCreditBlocked
+	case StatusClosed:
+		return ErrAccountClosed
+	}
+	return nil
+}
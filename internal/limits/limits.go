@@ -0,0 +1,231 @@
+package limits
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"FinTechPorto/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// Limit scopes. An account is bound by the limits of its own ID, of its UserID and of its Tier.
+const (
+	ScopeAccount = "ACCOUNT"
+	ScopeUser    = "USER"
+	ScopeTier    = "TIER"
+)
+
+// Limit kinds reported in an Error.
+const (
+	KindPerTransaction = "PER_TRANSACTION"
+	KindDailyAmount    = "DAILY_AMOUNT"
+	KindMonthlyAmount  = "MONTHLY_AMOUNT"
+	KindDailyCount     = "DAILY_COUNT"
+)
+
+var (
+	// ErrLimitExceeded is returned when a debit would exceed a transfer limit.
+	ErrLimitExceeded = errors.New("transfer limit exceeded")
+	// ErrInvalidLimit is returned when a limit is malformed.
+	ErrInvalidLimit = errors.New("invalid transfer limit")
+)
+
+// Error describes the limit a debit would exceed and what is still allowed under it.
+// It matches ErrLimitExceeded. For DAILY_COUNT, Limit, Used and Remaining count
+// transactions; otherwise they are amounts in minor units of Currency.
+type Error struct {
+	Scope     string
+	ScopeID   string
+	Kind      string
+	Currency  string
+	Limit     int64
+	Used      int64
+	Remaining int64
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s %s limit of %s %s, %d remaining",
+		ErrLimitExceeded, e.Kind, e.Scope, e.ScopeID, e.Currency, e.Remaining)
+}
+
+func (e *Error) Unwrap() error {
+	return ErrLimitExceeded
+}
+
+// Usage is what has been counted against a limit in the current day and month.
+type Usage struct {
+	DailyAmount   int64
+	MonthlyAmount int64
+	DailyCount    int64
+}
+
+// Status is a limit that applies to an account together with its current usage.
+type Status struct {
+	Limit models.TransferLimit
+	Usage Usage
+}
+
+// Validate checks that l names a known scope and has no negative caps.
+func Validate(l *models.TransferLimit) error {
+	switch l.Scope {
+	case ScopeAccount, ScopeUser, ScopeTier:
+	default:
+		return fmt.Errorf("%w: unknown scope %q", ErrInvalidLimit, l.Scope)
+	}
+	if l.ScopeID == "" {
+		return fmt.Errorf("%w: scope id is required", ErrInvalidLimit)
+	}
+	if l.PerTransaction < 0 || l.DailyAmount < 0 || l.MonthlyAmount < 0 || l.DailyCount < 0 {
+		return fmt.Errorf("%w: limits must not be negative", ErrInvalidLimit)
+	}
+	return nil
+}
+
+// Check returns an *Error if debiting amount from acc now would exceed any limit that
+// applies to it. It takes a transaction-scoped advisory lock on the account's user, so
+// tx must be an open DB transaction and concurrent checks for the same user serialize
+// until it commits.
+func Check(tx *gorm.DB, acc *models.Account, amount int64) error {
+	statuses, err := load(tx, acc, true)
+	if err != nil {
+		return err
+	}
+	for _, s := range statuses {
+		if err := exceeds(s, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Consume checks amount against the limits of acc and records it as usage under
+// reference. Call it in the same DB transaction as the debit it guards.
+func Consume(tx *gorm.DB, acc *models.Account, amount int64, reference string) error {
+	if err := Check(tx, acc, amount); err != nil {
+		return err
+	}
+	return Record(tx, acc, amount, reference)
+}
+
+// Record counts amount against the limits of acc under reference without checking them.
+func Record(tx *gorm.DB, acc *models.Account, amount int64, reference string) error {
+	usage := models.LimitUsage{
+		AccountID: acc.ID,
+		UserID:    acc.UserID,
+		Currency:  acc.Currency,
+		Amount:    amount,
+		Reference: reference,
+	}
+	if err := tx.Create(&usage).Error; err != nil {
+		return fmt.Errorf("failed to record limit usage: %w", err)
+	}
+	return nil
+}
+
+// Release removes the usage recorded under reference, for example when the debit it
+// guarded is refunded.
+func Release(tx *gorm.DB, reference string) error {
+	if err := tx.Where("reference = ?", reference).Delete(&models.LimitUsage{}).Error; err != nil {
+		return fmt.Errorf("failed to release limit usage: %w", err)
+	}
+	return nil
+}
+
+// Statuses returns every limit that applies to acc with its current usage.
+func Statuses(tx *gorm.DB, acc *models.Account) ([]Status, error) {
+	return load(tx, acc, false)
+}
+
+// load fetches the limits that apply to acc and measures the usage each is checked
+// against, optionally under the user's advisory lock.
+func load(tx *gorm.DB, acc *models.Account, lock bool) ([]Status, error) {
+	if lock {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "transfer-limits:"+acc.UserID).Error; err != nil {
+			return nil, fmt.Errorf("failed to lock transfer limits: %w", err)
+		}
+	}
+
+	var rows []models.TransferLimit
+	err := tx.Where("currency = ? AND ((scope = ? AND scope_id = ?) OR (scope = ? AND scope_id = ?) OR (scope = ? AND scope_id = ?))",
+		acc.Currency,
+		ScopeAccount, acc.ID,
+		ScopeUser, acc.UserID,
+		ScopeTier, acc.Tier,
+	).Order("id").Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transfer limits: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	// ACCOUNT and TIER limits share the account's usage; USER limits count the user's.
+	usage := make(map[string]*Usage, 2)
+	statuses := make([]Status, 0, len(rows))
+	for _, l := range rows {
+		column, value := "account_id", acc.ID
+		if l.Scope == ScopeUser {
+			column, value = "user_id", acc.UserID
+		}
+		u, ok := usage[column]
+		if !ok {
+			if u, err = measure(tx, column, value, acc.Currency); err != nil {
+				return nil, err
+			}
+			usage[column] = u
+		}
+		statuses = append(statuses, Status{Limit: l, Usage: *u})
+	}
+	return statuses, nil
+}
+
+// measure sums the usage in currency recorded against column = value since the start
+// of the current UTC day and month.
+func measure(tx *gorm.DB, column, value, currency string) (*Usage, error) {
+	now := time.Now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	var u Usage
+	err := tx.Model(&models.LimitUsage{}).
+		Select("COALESCE(SUM(CASE WHEN created_at >= ? THEN amount ELSE 0 END), 0) AS daily_amount, "+
+			"COALESCE(SUM(amount), 0) AS monthly_amount, "+
+			"COUNT(*) FILTER (WHERE created_at >= ?) AS daily_count", day, day).
+		Where(column+" = ? AND currency = ? AND created_at >= ?", value, currency, month).
+		Scan(&u).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to measure limit usage: %w", err)
+	}
+	return &u, nil
+}
+
+// exceeds returns an *Error for the first cap of s that a debit of amount would break.
+func exceeds(s Status, amount int64) error {
+	l := s.Limit
+	fail := func(kind string, limit, used int64) error {
+		return &Error{
+			Scope:     l.Scope,
+			ScopeID:   l.ScopeID,
+			Kind:      kind,
+			Currency:  l.Currency,
+			Limit:     limit,
+			Used:      used,
+			Remaining: max(limit-used, 0),
+		}
+	}
+	if l.PerTransaction > 0 && amount > l.PerTransaction {
+		return fail(KindPerTransaction, l.PerTransaction, 0)
+	}
+	if l.DailyCount > 0 && s.Usage.DailyCount+1 > int64(l.DailyCount) {
+		return fail(KindDailyCount, int64(l.DailyCount), s.Usage.DailyCount)
+	}
+	if l.DailyAmount > 0 && amount > l.DailyAmount-s.Usage.DailyAmount {
+		return fail(KindDailyAmount, l.DailyAmount, s.Usage.DailyAmount)
+	}
+	if l.MonthlyAmount > 0 && amount > l.MonthlyAmount-s.Usage.MonthlyAmount {
+		return fail(KindMonthlyAmount, l.MonthlyAmount, s.Usage.MonthlyAmount)
+	}
+	return nil
+}
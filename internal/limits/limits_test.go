@@ -0,0 +1,75 @@
+package limits
+
+import (
+	"errors"
+	"testing"
+
+	"FinTechPorto/internal/models"
+)
+
+func TestExceeds(t *testing.T) {
+	limit := models.TransferLimit{
+		Scope:          ScopeAccount,
+		ScopeID:        "acc-1",
+		Currency:       "USD",
+		PerTransaction: 1_000,
+		DailyAmount:    5_000,
+		MonthlyAmount:  20_000,
+		DailyCount:     3,
+	}
+
+	tests := []struct {
+		name          string
+		limit         models.TransferLimit
+		usage         Usage
+		amount        int64
+		wantKind      string
+		wantUsed      int64
+		wantRemaining int64
+	}{
+		{name: "at per-transaction cap", limit: limit, amount: 1_000},
+		{name: "over per-transaction cap", limit: limit, amount: 1_001, wantKind: KindPerTransaction, wantRemaining: 1_000},
+		{name: "last transfer of the day", limit: limit, usage: Usage{DailyCount: 2}, amount: 1},
+		{name: "over daily count", limit: limit, usage: Usage{DailyCount: 3}, amount: 1, wantKind: KindDailyCount, wantUsed: 3},
+		{name: "up to daily amount", limit: limit, usage: Usage{DailyAmount: 4_000, MonthlyAmount: 4_000}, amount: 1_000},
+		{name: "over daily amount", limit: limit, usage: Usage{DailyAmount: 4_000, MonthlyAmount: 4_000}, amount: 1_001,
+			wantKind: KindPerTransaction, wantRemaining: 1_000},
+		{name: "over daily amount within per-transaction", limit: limit, usage: Usage{DailyAmount: 4_500, MonthlyAmount: 4_500}, amount: 501,
+			wantKind: KindDailyAmount, wantUsed: 4_500, wantRemaining: 500},
+		{name: "up to monthly amount", limit: limit, usage: Usage{MonthlyAmount: 19_000}, amount: 1_000},
+		{name: "over monthly amount", limit: limit, usage: Usage{MonthlyAmount: 19_500}, amount: 501,
+			wantKind: KindMonthlyAmount, wantUsed: 19_500, wantRemaining: 500},
+		{name: "usage already over the cap", limit: limit, usage: Usage{DailyAmount: 6_000, MonthlyAmount: 6_000}, amount: 1,
+			wantKind: KindDailyAmount, wantUsed: 6_000},
+		{name: "per-transaction before count", limit: limit, usage: Usage{DailyCount: 3}, amount: 1_001,
+			wantKind: KindPerTransaction, wantRemaining: 1_000},
+		{name: "zero caps are unlimited", limit: models.TransferLimit{Scope: ScopeTier, ScopeID: "basic", Currency: "USD"},
+			usage: Usage{DailyAmount: 1 << 40, MonthlyAmount: 1 << 40, DailyCount: 1 << 20}, amount: 1 << 40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := exceeds(Status{Limit: tt.limit, Usage: tt.usage}, tt.amount)
+			if tt.wantKind == "" {
+				if err != nil {
+					t.Fatalf("exceeds() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrLimitExceeded) {
+				t.Fatalf("exceeds() error = %v, want %v", err, ErrLimitExceeded)
+			}
+			var lerr *Error
+			if !errors.As(err, &lerr) {
+				t.Fatalf("exceeds() error = %T, want *Error", err)
+			}
+			if lerr.Kind != tt.wantKind || lerr.Used != tt.wantUsed || lerr.Remaining != tt.wantRemaining {
+				t.Errorf("exceeds() = %s used %d remaining %d, want %s used %d remaining %d",
+					lerr.Kind, lerr.Used, lerr.Remaining, tt.wantKind, tt.wantUsed, tt.wantRemaining)
+			}
+			if lerr.Scope != tt.limit.Scope || lerr.ScopeID != tt.limit.ScopeID || lerr.Currency != tt.limit.Currency {
+				t.Errorf("exceeds() reported %s %s %s, want %s %s %s",
+					lerr.Scope, lerr.ScopeID, lerr.Currency, tt.limit.Scope, tt.limit.ScopeID, tt.limit.Currency)
+			}
+		})
+	}
+}
@@ -1,20 +1,39 @@
 package models
 
 import (
+	"errors"
 	"time"
 
+	"FinTechPorto/internal/fee"
+	"FinTechPorto/internal/risk"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
-// Account represents a wallet account with a UUID primary key.
+// ErrImmutable is returned when code attempts to modify an append-only record.
+var ErrImmutable = errors.New("record is immutable")
+
+// Account represents a wallet account with a UUID primary key. Balance is the ledger
+// balance; HeldAmount is the part of it reserved by active holds. Tier selects the
+// TIER-scoped transfer limits that apply to the account. HolderName is the legal name
+// screened against the sanctions list. Status is one of the ledger.Status* values and
+// every change to it is recorded as an AccountStatusChange. OverdraftLimit is how far
+// below zero Balance may go, and OverdraftRateBps the annual interest in basis points
+// accrued daily while it is negative.
 type Account struct {
-	ID        string `gorm:"type:uuid;primaryKey"`
-	UserID    string `gorm:"index;not null"`
-	Balance   int64  `gorm:"not null"`
-	Currency  string `gorm:"size:3;not null"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID               string `gorm:"type:uuid;primaryKey"`
+	UserID           string `gorm:"index;not null"`
+	Balance          int64  `gorm:"not null"`
+	HeldAmount       int64  `gorm:"not null;default:0"`
+	Currency         string `gorm:"size:3;not null"`
+	Status           string `gorm:"size:32;not null;default:ACTIVE"`
+	Tier             string `gorm:"size:32;not null;default:STANDARD"`
+	HolderName       string `gorm:"size:255;not null;default:''"`
+	OverdraftLimit   int64  `gorm:"not null;default:0"`
+	OverdraftRateBps int64  `gorm:"not null;default:0"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 // BeforeCreate hook to set a UUID when creating an Account.
@@ -25,16 +44,39 @@ func (a *Account) BeforeCreate(tx *gorm.DB) (err error) {
 	return nil
 }
 
-// Transaction represents a transfer between two accounts.
+// Transaction represents a transfer between two accounts. Type is TRANSFER, REFUND or
+// REVERSAL; refunds and reversals point at the transfer they undo through
+// OriginalTransactionID, and the original's RefundedAmount sums the refunds that have
+// completed. A refund's SettledAt records when it was applied to the original. Cross-currency transfers record the amount the recipient receives in
+// CounterAmount/CounterCurrency and the applied FxRate. FeeAmount is charged to the
+// sender on top of Amount and itemised in FeeBreakdown. Transfers above the approval
+// threshold wait in AWAITING_APPROVAL until ApproverID, who must not be InitiatorID,
+// approves them or they end REJECTED. The composite indexes back statement queries
+// that page through an account's history by (created_at, id).
 type Transaction struct {
-	ID          string    `gorm:"type:uuid;primaryKey"`
-	SenderID    string    `gorm:"index;not null"`
-	RecipientID string    `gorm:"index;not null"`
-	Amount      int64     `gorm:"not null"`
-	Currency    string    `gorm:"size:3;not null"`
-	Status      string    `gorm:"size:32;not null"`
-	Memo        string    `gorm:"size:1024"`
-	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	ID                    string `gorm:"type:uuid;primaryKey;index:idx_transactions_sender_created,priority:3;index:idx_transactions_recipient_created,priority:3"`
+	SenderID              string `gorm:"not null;index:idx_transactions_sender_created,priority:1"`
+	RecipientID           string `gorm:"not null;index:idx_transactions_recipient_created,priority:1"`
+	Amount                int64  `gorm:"not null"`
+	Currency              string `gorm:"size:3;not null"`
+	Status                string `gorm:"size:32;not null"`
+	Memo                  string `gorm:"size:1024"`
+	FailureReason         string `gorm:"size:1024"`
+	WorkflowID            string `gorm:"size:255;index"`
+	Type                  string `gorm:"size:32;not null;default:TRANSFER"`
+	OriginalTransactionID string `gorm:"size:36;index"`
+	RefundedAmount        int64  `gorm:"not null;default:0"`
+	SettledAt             *time.Time
+	CounterAmount         int64          `gorm:"not null;default:0"`
+	CounterCurrency       string         `gorm:"size:3"`
+	FxRate                string         `gorm:"size:32"`
+	FxQuoteID             string         `gorm:"size:36"`
+	FeeAmount             int64          `gorm:"not null;default:0"`
+	FeeBreakdown          *fee.Breakdown `gorm:"serializer:json;type:jsonb"`
+	InitiatorID           string         `gorm:"size:255"`
+	ApproverID            string         `gorm:"size:255"`
+	CreatedAt             time.Time      `gorm:"autoCreateTime;index:idx_transactions_sender_created,priority:2;index:idx_transactions_recipient_created,priority:2"`
+	UpdatedAt             time.Time      `gorm:"autoUpdateTime"`
 }
 
 // BeforeCreate hook to set a UUID when creating a Transaction.
@@ -44,3 +86,417 @@ func (t *Transaction) BeforeCreate(tx *gorm.DB) (err error) {
 	}
 	return nil
 }
+
+// IdempotencyKey records a client-supplied key together with a fingerprint of the
+// request it was first used with and the transaction it produced.
+type IdempotencyKey struct {
+	SenderID      string    `gorm:"primaryKey;size:64"`
+	Key           string    `gorm:"primaryKey;size:255"`
+	Fingerprint   string    `gorm:"size:64;not null"`
+	TransactionID string    `gorm:"size:255;not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// JournalEntry is an immutable posting. Its lines must balance per currency. Reference
+// and Description identify a posting, so one posted twice by a retry is rejected.
+type JournalEntry struct {
+	ID          string        `gorm:"type:uuid;primaryKey"`
+	Reference   string        `gorm:"size:255;index;uniqueIndex:idx_journal_entry_posting"`
+	Description string        `gorm:"size:1024;uniqueIndex:idx_journal_entry_posting"`
+	Lines       []JournalLine `gorm:"foreignKey:EntryID"`
+	CreatedAt   time.Time     `gorm:"autoCreateTime"`
+}
+
+// BeforeCreate hook to set a UUID when creating a JournalEntry.
+func (e *JournalEntry) BeforeCreate(tx *gorm.DB) (err error) {
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// BeforeUpdate rejects updates; journal entries are append-only.
+func (e *JournalEntry) BeforeUpdate(tx *gorm.DB) (err error) {
+	return ErrImmutable
+}
+
+// BeforeDelete rejects deletes; journal entries are append-only.
+func (e *JournalEntry) BeforeDelete(tx *gorm.DB) (err error) {
+	return ErrImmutable
+}
+
+// JournalLine debits or credits a single account as part of a JournalEntry.
+// Amount is always positive; Side is either "DEBIT" or "CREDIT".
+type JournalLine struct {
+	ID        uint      `gorm:"primaryKey"`
+	EntryID   string    `gorm:"type:uuid;index;not null"`
+	AccountID string    `gorm:"type:uuid;index;not null"`
+	Side      string    `gorm:"size:6;not null"`
+	Amount    int64     `gorm:"not null"`
+	Currency  string    `gorm:"size:3;not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// BeforeUpdate rejects updates; journal lines are append-only.
+func (l *JournalLine) BeforeUpdate(tx *gorm.DB) (err error) {
+	return ErrImmutable
+}
+
+// BeforeDelete rejects deletes; journal lines are append-only.
+func (l *JournalLine) BeforeDelete(tx *gorm.DB) (err error) {
+	return ErrImmutable
+}
+
+// OutboxEvent is an event written in the same DB transaction as the change it
+// describes. The outbox relay publishes it to Kafka, keyed by AggregateID and with
+// Headers as message headers, and then sets PublishedAt. An event the broker keeps
+// rejecting is dead-lettered instead: DeadLetteredAt is set and the relay skips it until
+// it is replayed, or for good once DroppedAt is set.
+type OutboxEvent struct {
+	ID             uint64            `gorm:"primaryKey;autoIncrement"`
+	AggregateID    string            `gorm:"size:64;index;not null"`
+	EventType      string            `gorm:"size:64;not null"`
+	Payload        []byte            `gorm:"type:jsonb;not null"`
+	Headers        map[string]string `gorm:"serializer:json;type:jsonb"`
+	Attempts       int               `gorm:"not null;default:0"`
+	LastError      string            `gorm:"size:1024"`
+	CreatedAt      time.Time         `gorm:"autoCreateTime"`
+	PublishedAt    *time.Time        `gorm:"index"`
+	DeadLetteredAt *time.Time        `gorm:"index"`
+	DroppedAt      *time.Time
+}
+
+// Hold reserves funds on an account until it is captured, voided or expires. Status is
+// ACTIVE, CAPTURED, VOIDED or EXPIRED; while ACTIVE, Amount counts towards the
+// account's HeldAmount.
+type Hold struct {
+	ID                   string    `gorm:"type:uuid;primaryKey"`
+	AccountID            string    `gorm:"type:uuid;index;not null"`
+	Amount               int64     `gorm:"not null"`
+	CapturedAmount       int64     `gorm:"not null;default:0"`
+	Currency             string    `gorm:"size:3;not null"`
+	Status               string    `gorm:"size:32;not null"`
+	Reference            string    `gorm:"size:255"`
+	CaptureTransactionID string    `gorm:"size:36"`
+	ExpiresAt            time.Time `gorm:"not null;index"`
+	CreatedAt            time.Time `gorm:"autoCreateTime"`
+	UpdatedAt            time.Time `gorm:"autoUpdateTime"`
+}
+
+// BeforeCreate hook to set a UUID when creating a Hold.
+func (h *Hold) BeforeCreate(tx *gorm.DB) (err error) {
+	if h.ID == "" {
+		h.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// FxRate is a mid-market rate set through the admin API. Rates stored here override
+// those loaded from the rate file at startup.
+type FxRate struct {
+	Base      string    `gorm:"primaryKey;size:3"`
+	Quote     string    `gorm:"primaryKey;size:3"`
+	Rate      string    `gorm:"size:32;not null"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// FxQuote locks a conversion rate for a limited time. A quote can back exactly one
+// transfer; TransactionID is set when it is used.
+type FxQuote struct {
+	ID            string    `gorm:"type:uuid;primaryKey"`
+	SellCurrency  string    `gorm:"size:3;not null"`
+	BuyCurrency   string    `gorm:"size:3;not null"`
+	SellAmount    int64     `gorm:"not null"`
+	BuyAmount     int64     `gorm:"not null"`
+	MidRate       string    `gorm:"size:32;not null"`
+	Rate          string    `gorm:"size:32;not null"`
+	SpreadBps     int64     `gorm:"not null"`
+	TransactionID string    `gorm:"size:36"`
+	ExpiresAt     time.Time `gorm:"not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// BeforeCreate hook to set a UUID when creating an FxQuote.
+func (q *FxQuote) BeforeCreate(tx *gorm.DB) (err error) {
+	if q.ID == "" {
+		q.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TransferSchedule is a standing order that repeats a transfer on a cron schedule
+// through a Temporal schedule. Status is ACTIVE, COMPLETED or CANCELLED; it completes
+// once MaxRuns runs have fired or EndAt has passed. OnInsufficientFunds is SKIP or RETRY.
+type TransferSchedule struct {
+	ID                  string `gorm:"type:uuid;primaryKey"`
+	SenderID            string `gorm:"type:uuid;index;not null"`
+	RecipientID         string `gorm:"type:uuid;not null"`
+	Amount              int64  `gorm:"not null"`
+	Currency            string `gorm:"size:3;not null"`
+	Memo                string `gorm:"size:1024"`
+	CronExpression      string `gorm:"size:255;not null"`
+	TimeZone            string `gorm:"size:64"`
+	StartAt             *time.Time
+	EndAt               *time.Time
+	MaxRuns             int       `gorm:"not null;default:0"`
+	RunCount            int       `gorm:"not null;default:0"`
+	OnInsufficientFunds string    `gorm:"size:16;not null;default:SKIP"`
+	Status              string    `gorm:"size:32;not null"`
+	TemporalScheduleID  string    `gorm:"size:255"`
+	CreatedAt           time.Time `gorm:"autoCreateTime"`
+	UpdatedAt           time.Time `gorm:"autoUpdateTime"`
+}
+
+// BeforeCreate hook to set a UUID when creating a TransferSchedule.
+func (s *TransferSchedule) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// ScheduleRun records one firing of a TransferSchedule. TransactionID is the transfer
+// of the latest attempt; earlier attempts stay in the transaction history as FAILED.
+// Status is PENDING, COMPLETED, FAILED or SKIPPED.
+type ScheduleRun struct {
+	ID            string    `gorm:"type:uuid;primaryKey"`
+	ScheduleID    string    `gorm:"type:uuid;index:idx_schedule_runs_schedule_created,priority:1;not null"`
+	TransactionID string    `gorm:"size:36;index"`
+	Status        string    `gorm:"size:32;not null"`
+	Attempts      int       `gorm:"not null;default:0"`
+	FailureReason string    `gorm:"size:1024"`
+	CreatedAt     time.Time `gorm:"autoCreateTime;index:idx_schedule_runs_schedule_created,priority:2"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+// BeforeCreate hook to set a UUID when creating a ScheduleRun.
+func (r *ScheduleRun) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TransferBatch pays many recipients from one sender. The sum of its items and their
+// fees is reserved from the sender before any item is paid, and whatever is not paid
+// out is returned when the batch settles. Mode is ALL_OR_NOTHING or BEST_EFFORT; Status
+// is PENDING, PROCESSING, COMPLETED, PARTIALLY_COMPLETED or FAILED.
+type TransferBatch struct {
+	ID            string    `gorm:"type:uuid;primaryKey"`
+	SenderID      string    `gorm:"type:uuid;index;not null"`
+	Currency      string    `gorm:"size:3;not null"`
+	Mode          string    `gorm:"size:32;not null"`
+	Status        string    `gorm:"size:32;not null"`
+	ItemCount     int       `gorm:"not null"`
+	TotalAmount   int64     `gorm:"not null"`
+	TotalFee      int64     `gorm:"not null;default:0"`
+	FailureReason string    `gorm:"size:1024"`
+	WorkflowID    string    `gorm:"size:255"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
+
+// BeforeCreate hook to set a UUID when creating a TransferBatch.
+func (b *TransferBatch) BeforeCreate(tx *gorm.DB) (err error) {
+	if b.ID == "" {
+		b.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// BatchItem is one payout of a TransferBatch. Its status is that of its Transaction.
+type BatchItem struct {
+	ID            string `gorm:"type:uuid;primaryKey"`
+	BatchID       string `gorm:"type:uuid;not null;uniqueIndex:idx_batch_items_batch_seq,priority:1"`
+	Seq           int    `gorm:"not null;uniqueIndex:idx_batch_items_batch_seq,priority:2"`
+	RecipientID   string `gorm:"type:uuid;not null"`
+	Amount        int64  `gorm:"not null"`
+	FeeAmount     int64  `gorm:"not null;default:0"`
+	Reference     string `gorm:"size:255"`
+	TransactionID string `gorm:"size:36;not null;index"`
+}
+
+// BeforeCreate hook to set a UUID when creating a BatchItem.
+func (i *BatchItem) BeforeCreate(tx *gorm.DB) (err error) {
+	if i.ID == "" {
+		i.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// TransferLimit caps what can be sent in Currency. Scope is ACCOUNT, USER or TIER and
+// ScopeID the account ID, user ID or tier name it applies to. USER limits count the
+// usage of all of the user's accounts in Currency; ACCOUNT and TIER limits count each
+// account on its own. Zero means no limit for that dimension.
+type TransferLimit struct {
+	ID             uint      `gorm:"primaryKey"`
+	Scope          string    `gorm:"size:16;not null;uniqueIndex:idx_transfer_limits_scope,priority:1"`
+	ScopeID        string    `gorm:"size:255;not null;uniqueIndex:idx_transfer_limits_scope,priority:2"`
+	Currency       string    `gorm:"size:3;not null;uniqueIndex:idx_transfer_limits_scope,priority:3"`
+	PerTransaction int64     `gorm:"not null;default:0"`
+	DailyAmount    int64     `gorm:"not null;default:0"`
+	MonthlyAmount  int64     `gorm:"not null;default:0"`
+	DailyCount     int       `gorm:"not null;default:0"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+// LimitUsage records an outgoing amount counted against transfer limits. It is written
+// in the same DB transaction as the debit and deleted when the debit is compensated.
+type LimitUsage struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	AccountID string    `gorm:"type:uuid;not null;index:idx_limit_usages_account_created,priority:1"`
+	UserID    string    `gorm:"not null;index:idx_limit_usages_user_created,priority:1"`
+	Currency  string    `gorm:"size:3;not null;index:idx_limit_usages_user_created,priority:2"`
+	Amount    int64     `gorm:"not null"`
+	Reference string    `gorm:"size:255;not null;index"`
+	CreatedAt time.Time `gorm:"autoCreateTime;index:idx_limit_usages_account_created,priority:2;index:idx_limit_usages_user_created,priority:3"`
+}
+
+// RiskDecision records the outcome of screening a transfer before its debit: ALLOW,
+// REVIEW or DENY, with the rules that matched. There is one per screened transaction.
+type RiskDecision struct {
+	ID            uint64     `gorm:"primaryKey;autoIncrement"`
+	TransactionID string     `gorm:"type:uuid;not null;uniqueIndex"`
+	Outcome       string     `gorm:"size:16;not null"`
+	Hits          []risk.Hit `gorm:"serializer:json;type:jsonb"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+}
+
+// ScreeningHit records a sanctions list entry that matched a party of a transfer. Party
+// is SENDER or RECIPIENT. A transfer with hits is held for manual review.
+type ScreeningHit struct {
+	ID            uint64    `gorm:"primaryKey;autoIncrement"`
+	TransactionID string    `gorm:"type:uuid;not null;index"`
+	Party         string    `gorm:"size:16;not null"`
+	AccountID     string    `gorm:"type:uuid;not null"`
+	HolderName    string    `gorm:"size:255;not null"`
+	EntryUID      string    `gorm:"size:64;not null"`
+	EntryName     string    `gorm:"size:255;not null"`
+	MatchedName   string    `gorm:"size:255;not null"`
+	Score         float64   `gorm:"not null"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// AccountStatusChange records one change of an account's status, who made it and why.
+type AccountStatusChange struct {
+	ID         uint64    `gorm:"primaryKey;autoIncrement"`
+	AccountID  string    `gorm:"type:uuid;not null;index"`
+	FromStatus string    `gorm:"size:32;not null"`
+	ToStatus   string    `gorm:"size:32;not null"`
+	Reason     string    `gorm:"size:1024;not null"`
+	ChangedBy  string    `gorm:"size:255"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// OverdraftAccrual records the interest charged on an overdrawn account for one day.
+// Day is the UTC date of the accrual run as YYYY-MM-DD.
+type OverdraftAccrual struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	AccountID string    `gorm:"type:uuid;not null;uniqueIndex:idx_overdraft_accruals_account_day,priority:1"`
+	Day       string    `gorm:"size:10;not null;uniqueIndex:idx_overdraft_accruals_account_day,priority:2"`
+	Balance   int64     `gorm:"not null"`
+	RateBps   int64     `gorm:"not null"`
+	Amount    int64     `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// WebhookEndpoint is a merchant's URL that receives the events of the merchant's
+// accounts. EventTypes limits which events are sent; empty means all. Secret signs
+// every delivery. Status is ACTIVE or DISABLED.
+type WebhookEndpoint struct {
+	ID         string    `gorm:"type:uuid;primaryKey"`
+	MerchantID string    `gorm:"index;not null"`
+	URL        string    `gorm:"size:2048;not null"`
+	Secret     string    `gorm:"size:128;not null"`
+	EventTypes []string  `gorm:"serializer:json;type:jsonb"`
+	Status     string    `gorm:"size:32;not null;default:ACTIVE"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+}
+
+// BeforeCreate hook to set a UUID when creating a WebhookEndpoint.
+func (e *WebhookEndpoint) BeforeCreate(tx *gorm.DB) (err error) {
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// WebhookDelivery is one event to be sent to one endpoint. EventID identifies the
+// broker message it came from, so a redelivered message is not sent twice. Status is
+// PENDING, DELIVERED or FAILED; Replays counts the manual replays.
+type WebhookDelivery struct {
+	ID             string `gorm:"type:uuid;primaryKey"`
+	EndpointID     string `gorm:"type:uuid;not null;uniqueIndex:idx_webhook_deliveries_endpoint_event,priority:1"`
+	EventID        string `gorm:"size:255;not null;uniqueIndex:idx_webhook_deliveries_endpoint_event,priority:2"`
+	EventType      string `gorm:"size:64;not null"`
+	Payload        []byte `gorm:"type:jsonb;not null"`
+	Status         string `gorm:"size:32;not null;index"`
+	Attempts       int    `gorm:"not null;default:0"`
+	LastStatusCode int
+	LastError      string `gorm:"size:1024"`
+	Replays        int    `gorm:"not null;default:0"`
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+// BeforeCreate hook to set a UUID when creating a WebhookDelivery.
+func (d *WebhookDelivery) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == "" {
+		d.ID = uuid.New().String()
+	}
+	return nil
+}
+
+// WebhookAttempt logs one HTTP request of a WebhookDelivery. StatusCode is zero when
+// no response was received.
+type WebhookAttempt struct {
+	ID         uint64    `gorm:"primaryKey;autoIncrement"`
+	DeliveryID string    `gorm:"type:uuid;index;not null"`
+	StatusCode int       `gorm:"not null;default:0"`
+	Error      string    `gorm:"size:1024"`
+	DurationMs int64     `gorm:"not null"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// ProcessedEvent records that a consumer applied an event, so redelivered and replayed
+// events are applied once.
+type ProcessedEvent struct {
+	Consumer  string    `gorm:"size:64;primaryKey"`
+	EventID   string    `gorm:"size:64;primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// AccountActivity is one entry of an account's activity feed, projected from transfer
+// events. Direction is DEBIT or CREDIT when the event moved Amount, fees included, on
+// the account, and NONE for transfers that failed or were rejected before moving funds.
+// Memo is the transfer memo, or the reason of a failure, rejection or reversal.
+type AccountActivity struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	EventID        string    `gorm:"size:64;not null;uniqueIndex:idx_activity_event_account"`
+	AccountID      string    `gorm:"size:64;not null;uniqueIndex:idx_activity_event_account;index:idx_activity_account_time,priority:1"`
+	EventType      string    `gorm:"size:64;not null"`
+	TransactionID  string    `gorm:"size:64;index;not null"`
+	CounterpartyID string    `gorm:"size:64"`
+	Direction      string    `gorm:"size:8;not null"`
+	Amount         int64     `gorm:"not null"`
+	Currency       string    `gorm:"size:3;not null"`
+	Status         string    `gorm:"size:32"`
+	Memo           string    `gorm:"size:1024"`
+	OccurredAt     time.Time `gorm:"not null;index:idx_activity_account_time,priority:2"`
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+}
+
+// AccountActivitySummary totals the projected activity of an account. Net movement is
+// TotalCredits - TotalDebits.
+type AccountActivitySummary struct {
+	AccountID     string    `gorm:"size:64;primaryKey"`
+	Currency      string    `gorm:"size:3;not null"`
+	TotalCredits  int64     `gorm:"not null;default:0"`
+	TotalDebits   int64     `gorm:"not null;default:0"`
+	ActivityCount int64     `gorm:"not null;default:0"`
+	LastEventAt   time.Time `gorm:"not null"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime"`
+}
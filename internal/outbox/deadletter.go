@@ -0,0 +1,71 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"FinTechPorto/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// ErrDeadLetterNotFound is returned when no dead-lettered, undropped event has the ID.
+var ErrDeadLetterNotFound = errors.New("dead-lettered event not found")
+
+// deadLettered scopes a query to events that are dead-lettered and not dropped.
+func deadLettered(db *gorm.DB) *gorm.DB {
+	return db.Where("dead_lettered_at IS NOT NULL AND dropped_at IS NULL")
+}
+
+// ListDeadLetters returns up to limit dead-lettered events, oldest first.
+func ListDeadLetters(ctx context.Context, db *gorm.DB, limit int) ([]models.OutboxEvent, error) {
+	var evs []models.OutboxEvent
+	if err := db.WithContext(ctx).Scopes(deadLettered).Order("id").Limit(limit).Find(&evs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list dead-lettered events: %w", err)
+	}
+	return evs, nil
+}
+
+// GetDeadLetter returns the dead-lettered event id.
+func GetDeadLetter(ctx context.Context, db *gorm.DB, id uint64) (*models.OutboxEvent, error) {
+	var ev models.OutboxEvent
+	if err := db.WithContext(ctx).Scopes(deadLettered).Where("id = ?", id).First(&ev).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDeadLetterNotFound
+		}
+		return nil, fmt.Errorf("failed to get dead-lettered event: %w", err)
+	}
+	return &ev, nil
+}
+
+// ReplayDeadLetter hands the dead-lettered event id back to the relay with a fresh set
+// of attempts. The account's later events may already be published, so it reaches
+// consumers out of order.
+func ReplayDeadLetter(ctx context.Context, db *gorm.DB, id uint64) error {
+	res := db.WithContext(ctx).Model(&models.OutboxEvent{}).Scopes(deadLettered).Where("id = ?", id).Updates(map[string]interface{}{
+		"dead_lettered_at": nil,
+		"attempts":         0,
+	})
+	if res.Error != nil {
+		return fmt.Errorf("failed to replay dead-lettered event: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrDeadLetterNotFound
+	}
+	return nil
+}
+
+// DropDeadLetter gives up on the dead-lettered event id; it is kept for the record but
+// never published.
+func DropDeadLetter(ctx context.Context, db *gorm.DB, id uint64) error {
+	res := db.WithContext(ctx).Model(&models.OutboxEvent{}).Scopes(deadLettered).Where("id = ?", id).Update("dropped_at", time.Now())
+	if res.Error != nil {
+		return fmt.Errorf("failed to drop dead-lettered event: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrDeadLetterNotFound
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"FinTechPorto/internal/broker"
+	"FinTechPorto/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// relayLockKey is the advisory lock that keeps a single relay draining at a time,
+// which preserves per-account ordering when several service instances run.
+const relayLockKey = 7_401_532
+
+// HeaderEventType is the message header carrying the event type; the relay sets it on
+// every message.
+const HeaderEventType = "event-type"
+
+// Enqueue marshals payload and writes it to the outbox within tx. aggregateID is the
+// account events are ordered by and becomes the message key; headers are sent along
+// as message headers.
+func Enqueue(tx *gorm.DB, aggregateID, eventType string, payload interface{}, headers map[string]string) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox event: %w", err)
+	}
+	ev := models.OutboxEvent{
+		AggregateID: aggregateID,
+		EventType:   eventType,
+		Payload:     b,
+		Headers:     headers,
+	}
+	if err := tx.Create(&ev).Error; err != nil {
+		return fmt.Errorf("failed to write outbox event: %w", err)
+	}
+	return nil
+}
+
+// Relay drains unpublished outbox events to Kafka. Delivery is at-least-once: an
+// event is marked published only after the broker acknowledged it.
+type Relay struct {
+	DB        *gorm.DB
+	Broker    *broker.KafkaWriter
+	BatchSize int
+	Interval  time.Duration
+	// MaxAttempts is how often the broker may reject an event before it is
+	// dead-lettered. Events are never dead-lettered while the broker is unreachable.
+	MaxAttempts int
+}
+
+// NewRelay creates a Relay with default batch size, polling interval and attempts.
+func NewRelay(db *gorm.DB, b *broker.KafkaWriter) *Relay {
+	return &Relay{DB: db, Broker: b, BatchSize: 100, Interval: time.Second, MaxAttempts: 3}
+}
+
+// Run polls the outbox until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := r.Drain(ctx)
+		if err != nil {
+			slog.Error("outbox relay failed", "error", err)
+		}
+		// keep draining while full batches are coming back
+		if err == nil && n == r.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Drain publishes one batch of pending events in insertion order and returns how
+// many were published. Once publishing fails for an account, that account's later
+// events are left for the next pass so they are never delivered out of order. A
+// dead-lettered event no longer holds them back.
+func (r *Relay) Drain(ctx context.Context) (int, error) {
+	var published int
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", relayLockKey).Scan(&locked).Error; err != nil {
+			return fmt.Errorf("failed to acquire relay lock: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		var events []models.OutboxEvent
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("published_at IS NULL AND dead_lettered_at IS NULL").
+			Order("id").
+			Limit(r.BatchSize).
+			Find(&events).Error; err != nil {
+			return fmt.Errorf("failed to load outbox events: %w", err)
+		}
+
+		blocked := make(map[string]bool)
+		for _, ev := range events {
+			if blocked[ev.AggregateID] {
+				continue
+			}
+			if err := r.Broker.Publish(ctx, message(ev)); err != nil {
+				updates := map[string]interface{}{
+					"attempts":   gorm.Expr("attempts + 1"),
+					"last_error": err.Error(),
+				}
+				if broker.Rejected(err) && ev.Attempts+1 >= r.MaxAttempts {
+					updates["dead_lettered_at"] = time.Now()
+					slog.Warn("dead-lettered outbox event", "id", ev.ID, "event_type", ev.EventType, "aggregate_id", ev.AggregateID, "error", err)
+				} else {
+					blocked[ev.AggregateID] = true
+				}
+				if uerr := tx.Model(&models.OutboxEvent{}).Where("id = ?", ev.ID).Updates(updates).Error; uerr != nil {
+					return fmt.Errorf("failed to record outbox attempt: %w", uerr)
+				}
+				continue
+			}
+			if err := tx.Model(&models.OutboxEvent{}).Where("id = ?", ev.ID).Updates(map[string]interface{}{
+				"attempts":     gorm.Expr("attempts + 1"),
+				"published_at": time.Now(),
+			}).Error; err != nil {
+				return fmt.Errorf("failed to mark outbox event published: %w", err)
+			}
+			published++
+		}
+		return nil
+	})
+	return published, err
+}
+
+// message builds the Kafka message of ev.
+func message(ev models.OutboxEvent) broker.Message {
+	headers := make(map[string]string, len(ev.Headers)+1)
+	for name, value := range ev.Headers {
+		headers[name] = value
+	}
+	headers[HeaderEventType] = ev.EventType
+	return broker.Message{Key: []byte(ev.AggregateID), Value: ev.Payload, Headers: headers}
+}
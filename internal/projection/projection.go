@@ -0,0 +1,152 @@
+package projection
+
+import (
+	"context"
+	"fmt"
+
+	eventsv1 "FinTechPorto/gen/api/events/v1"
+	"FinTechPorto/internal/broker"
+	"FinTechPorto/internal/events"
+	"FinTechPorto/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Projector projects transfer events into the account activity read model.
+type Projector struct {
+	DB *gorm.DB
+	// Name identifies the projector in models.ProcessedEvent.
+	Name string
+}
+
+// NewProjector creates a Projector recording processed events under name.
+func NewProjector(db *gorm.DB, name string) *Projector {
+	return &Projector{DB: db, Name: name}
+}
+
+// Run consumes c until ctx is cancelled.
+func (p *Projector) Run(ctx context.Context, c *broker.Consumer) error {
+	return c.Run(ctx, p.Handle)
+}
+
+// Handle applies one broker message. Messages that are not events of a known schema
+// version cannot succeed on retry and are dead-lettered.
+func (p *Projector) Handle(ctx context.Context, msg broker.Message) error {
+	env, err := events.Parse(msg.Value)
+	if err != nil {
+		return broker.Permanent(err)
+	}
+	return p.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return Apply(tx, p.Name, env)
+	})
+}
+
+// Apply writes the activities of env and adds them to the account summaries within tx,
+// unless consumer has applied the event before.
+func Apply(tx *gorm.DB, consumer string, env *eventsv1.Envelope) error {
+	activities := Activities(env)
+	if len(activities) == 0 {
+		return nil
+	}
+
+	res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.ProcessedEvent{Consumer: consumer, EventID: env.EventId})
+	if res.Error != nil {
+		return fmt.Errorf("failed to record processed event: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		// already applied
+		return nil
+	}
+
+	if err := tx.Create(&activities).Error; err != nil {
+		return fmt.Errorf("failed to write account activity: %w", err)
+	}
+	for _, a := range activities {
+		summary := models.AccountActivitySummary{
+			AccountID:     a.AccountID,
+			Currency:      a.Currency,
+			ActivityCount: 1,
+			LastEventAt:   a.OccurredAt,
+		}
+		switch a.Direction {
+		case "CREDIT":
+			summary.TotalCredits = a.Amount
+		case "DEBIT":
+			summary.TotalDebits = a.Amount
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "account_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"total_credits":  gorm.Expr("account_activity_summaries.total_credits + excluded.total_credits"),
+				"total_debits":   gorm.Expr("account_activity_summaries.total_debits + excluded.total_debits"),
+				"activity_count": gorm.Expr("account_activity_summaries.activity_count + 1"),
+				"last_event_at":  gorm.Expr("GREATEST(account_activity_summaries.last_event_at, excluded.last_event_at)"),
+				"updated_at":     gorm.Expr("excluded.updated_at"),
+			}),
+		}).Create(&summary).Error; err != nil {
+			return fmt.Errorf("failed to update account activity summary: %w", err)
+		}
+	}
+	return nil
+}
+
+// Activities returns the account activity entries env projects to, or nil for events
+// that do not concern account activity.
+func Activities(env *eventsv1.Envelope) []models.AccountActivity {
+	entry := func(t *eventsv1.Transfer, accountID, counterpartyID, direction string, amount int64, currency, memo string) models.AccountActivity {
+		return models.AccountActivity{
+			EventID:        env.EventId,
+			AccountID:      accountID,
+			EventType:      env.EventType,
+			TransactionID:  t.GetTransactionId(),
+			CounterpartyID: counterpartyID,
+			Direction:      direction,
+			Amount:         amount,
+			Currency:       currency,
+			Status:         t.GetStatus(),
+			Memo:           memo,
+			OccurredAt:     env.GetOccurredAt().AsTime(),
+		}
+	}
+
+	switch p := env.Payload.(type) {
+	case *eventsv1.Envelope_TransferCompleted:
+		t := p.TransferCompleted.GetTransfer()
+		creditAmount, creditCurrency := t.GetAmount(), t.GetCurrency()
+		if t.GetCounterCurrency() != "" && t.GetCounterCurrency() != t.GetCurrency() {
+			creditAmount, creditCurrency = t.GetCounterAmount(), t.GetCounterCurrency()
+		}
+		return []models.AccountActivity{
+			entry(t, t.GetSenderId(), t.GetRecipientId(), "DEBIT", t.GetAmount()+t.GetFeeAmount(), t.GetCurrency(), t.GetMemo()),
+			entry(t, t.GetRecipientId(), t.GetSenderId(), "CREDIT", creditAmount, creditCurrency, t.GetMemo()),
+		}
+	case *eventsv1.Envelope_TransferFailed:
+		t := p.TransferFailed.GetTransfer()
+		return []models.AccountActivity{
+			entry(t, t.GetSenderId(), t.GetRecipientId(), "NONE", t.GetAmount(), t.GetCurrency(), p.TransferFailed.GetReason()),
+		}
+	case *eventsv1.Envelope_TransferRejected:
+		t := p.TransferRejected.GetTransfer()
+		return []models.AccountActivity{
+			entry(t, t.GetSenderId(), t.GetRecipientId(), "NONE", t.GetAmount(), t.GetCurrency(), p.TransferRejected.GetReason()),
+		}
+	case *eventsv1.Envelope_TransferReversed:
+		ev := p.TransferReversed
+		if r := ev.GetReversal(); r != nil {
+			return []models.AccountActivity{
+				entry(r, r.GetSenderId(), r.GetRecipientId(), "DEBIT", ev.GetAmount(), r.GetCurrency(), ev.GetReason()),
+				entry(r, r.GetRecipientId(), r.GetSenderId(), "CREDIT", ev.GetAmount(), r.GetCurrency(), ev.GetReason()),
+			}
+		}
+		// a batch clawback moves the funds back to transit and the batch settlement
+		// refunds them to the sender with the item's fee; the reservation of unpaid items
+		// is never projected, so only the sender's refund of this paid item is
+		o := ev.GetOriginal()
+		return []models.AccountActivity{
+			entry(o, o.GetRecipientId(), o.GetSenderId(), "DEBIT", ev.GetAmount(), o.GetCurrency(), ev.GetReason()),
+			entry(o, o.GetSenderId(), o.GetRecipientId(), "CREDIT", ev.GetAmount()+o.GetFeeAmount(), o.GetCurrency(), ev.GetReason()),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	eventsv1 "FinTechPorto/gen/api/events/v1"
+	"FinTechPorto/internal/events"
+	"FinTechPorto/internal/models"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var occurredAt = time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+func envelope(eventType string, payload func(env *eventsv1.Envelope)) *eventsv1.Envelope {
+	env := &eventsv1.Envelope{
+		EventId:       "evt-1",
+		EventType:     eventType,
+		SchemaVersion: events.SchemaVersion,
+		OccurredAt:    timestamppb.New(occurredAt),
+	}
+	payload(env)
+	return env
+}
+
+func transfer() *eventsv1.Transfer {
+	return &eventsv1.Transfer{
+		TransactionId: "tx-1",
+		Type:          "TRANSFER",
+		Status:        "COMPLETED",
+		SenderId:      "sender",
+		RecipientId:   "recipient",
+		Amount:        10_000,
+		Currency:      "USD",
+		FeeAmount:     50,
+		Memo:          "invoice 42",
+	}
+}
+
+// activity is the part of a models.AccountActivity that depends on the event.
+type activity struct {
+	AccountID      string
+	CounterpartyID string
+	Direction      string
+	Amount         int64
+	Currency       string
+	TransactionID  string
+	Memo           string
+}
+
+func TestActivities(t *testing.T) {
+	fx := transfer()
+	fx.CounterAmount, fx.CounterCurrency = 9_210, "EUR"
+	sameCurrency := transfer()
+	sameCurrency.CounterAmount, sameCurrency.CounterCurrency = 10_000, "USD"
+	failed := transfer()
+	failed.Status = "FAILED"
+	refund := transfer()
+	refund.TransactionId, refund.Type, refund.OriginalTransactionId = "tx-2", "REFUND", "tx-1"
+	refund.SenderId, refund.RecipientId, refund.FeeAmount = "recipient", "sender", 0
+	batchItem := transfer()
+	batchItem.Status = "REVERSED"
+
+	tests := []struct {
+		name string
+		env  *eventsv1.Envelope
+		want []activity
+	}{
+		{
+			name: "completed transfer debits the fee with the amount",
+			env: envelope(events.TypeTransferCompleted, func(env *eventsv1.Envelope) {
+				env.Payload = &eventsv1.Envelope_TransferCompleted{TransferCompleted: &eventsv1.TransferCompleted{Transfer: transfer()}}
+			}),
+			want: []activity{
+				{"sender", "recipient", "DEBIT", 10_050, "USD", "tx-1", "invoice 42"},
+				{"recipient", "sender", "CREDIT", 10_000, "USD", "tx-1", "invoice 42"},
+			},
+		},
+		{
+			name: "cross-currency transfer credits the counter amount",
+			env: envelope(events.TypeTransferCompleted, func(env *eventsv1.Envelope) {
+				env.Payload = &eventsv1.Envelope_TransferCompleted{TransferCompleted: &eventsv1.TransferCompleted{Transfer: fx}}
+			}),
+			want: []activity{
+				{"sender", "recipient", "DEBIT", 10_050, "USD", "tx-1", "invoice 42"},
+				{"recipient", "sender", "CREDIT", 9_210, "EUR", "tx-1", "invoice 42"},
+			},
+		},
+		{
+			name: "counter currency equal to the currency is not an fx transfer",
+			env: envelope(events.TypeTransferCompleted, func(env *eventsv1.Envelope) {
+				env.Payload = &eventsv1.Envelope_TransferCompleted{TransferCompleted: &eventsv1.TransferCompleted{Transfer: sameCurrency}}
+			}),
+			want: []activity{
+				{"sender", "recipient", "DEBIT", 10_050, "USD", "tx-1", "invoice 42"},
+				{"recipient", "sender", "CREDIT", 10_000, "USD", "tx-1", "invoice 42"},
+			},
+		},
+		{
+			name: "failed transfer moves nothing",
+			env: envelope(events.TypeTransferFailed, func(env *eventsv1.Envelope) {
+				env.Payload = &eventsv1.Envelope_TransferFailed{TransferFailed: &eventsv1.TransferFailed{Transfer: failed, Reason: "recipient account is frozen"}}
+			}),
+			want: []activity{
+				{"sender", "recipient", "NONE", 10_000, "USD", "tx-1", "recipient account is frozen"},
+			},
+		},
+		{
+			name: "rejected transfer moves nothing",
+			env: envelope(events.TypeTransferRejected, func(env *eventsv1.Envelope) {
+				env.Payload = &eventsv1.Envelope_TransferRejected{TransferRejected: &eventsv1.TransferRejected{Transfer: failed, ApproverId: "approver", Reason: "unknown payee"}}
+			}),
+			want: []activity{
+				{"sender", "recipient", "NONE", 10_000, "USD", "tx-1", "unknown payee"},
+			},
+		},
+		{
+			name: "refund moves the refunded amount back",
+			env: envelope(events.TypeTransferReversed, func(env *eventsv1.Envelope) {
+				env.Payload = &eventsv1.Envelope_TransferReversed{TransferReversed: &eventsv1.TransferReversed{
+					Original: transfer(),
+					Reversal: refund,
+					Amount:   4_000,
+					Reason:   "partial refund",
+				}}
+			}),
+			want: []activity{
+				{"recipient", "sender", "DEBIT", 4_000, "USD", "tx-2", "partial refund"},
+				{"sender", "recipient", "CREDIT", 4_000, "USD", "tx-2", "partial refund"},
+			},
+		},
+		{
+			name: "batch clawback refunds the sender the amount and the fee",
+			env: envelope(events.TypeTransferReversed, func(env *eventsv1.Envelope) {
+				env.Payload = &eventsv1.Envelope_TransferReversed{TransferReversed: &eventsv1.TransferReversed{
+					Original: batchItem,
+					Amount:   10_000,
+					Reason:   "batch aborted",
+				}}
+			}),
+			want: []activity{
+				{"recipient", "sender", "DEBIT", 10_000, "USD", "tx-1", "batch aborted"},
+				{"sender", "recipient", "CREDIT", 10_050, "USD", "tx-1", "batch aborted"},
+			},
+		},
+		{
+			name: "batch settlement is not account activity",
+			env: envelope(events.TypeBatchSettled, func(env *eventsv1.Envelope) {
+				env.Payload = &eventsv1.Envelope_BatchSettled{BatchSettled: &eventsv1.BatchSettled{BatchId: "batch-1", SenderId: "sender", Status: "FAILED"}}
+			}),
+		},
+		{
+			name: "overdraft alert is not account activity",
+			env: envelope(events.TypeOverdraftThresholdCrossed, func(env *eventsv1.Envelope) {
+				env.Payload = &eventsv1.Envelope_OverdraftThresholdCrossed{OverdraftThresholdCrossed: &eventsv1.OverdraftThresholdCrossed{AccountId: "sender", UsagePercent: 85, ThresholdPercent: 80}}
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Activities(tt.env)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Activities() returned %d entries, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, a := range got {
+				g := activity{a.AccountID, a.CounterpartyID, a.Direction, a.Amount, a.Currency, a.TransactionID, a.Memo}
+				if g != tt.want[i] {
+					t.Errorf("Activities()[%d] = %+v, want %+v", i, g, tt.want[i])
+				}
+				checkEnvelopeFields(t, a, tt.env)
+			}
+		})
+	}
+}
+
+// checkEnvelopeFields checks the fields every entry copies from its envelope.
+func checkEnvelopeFields(t *testing.T, a models.AccountActivity, env *eventsv1.Envelope) {
+	t.Helper()
+	if a.EventID != env.EventId || a.EventType != env.EventType || !a.OccurredAt.Equal(occurredAt) {
+		t.Errorf("entry event = %s %s at %v, want %s %s at %v", a.EventID, a.EventType, a.OccurredAt, env.EventId, env.EventType, occurredAt)
+	}
+}
+
+func TestActivitiesNetOutAcrossABatchClawback(t *testing.T) {
+	item := transfer()
+	paid := envelope(events.TypeTransferCompleted, func(env *eventsv1.Envelope) {
+		env.Payload = &eventsv1.Envelope_TransferCompleted{TransferCompleted: &eventsv1.TransferCompleted{Transfer: item}}
+	})
+	clawedBack := envelope(events.TypeTransferReversed, func(env *eventsv1.Envelope) {
+		env.Payload = &eventsv1.Envelope_TransferReversed{TransferReversed: &eventsv1.TransferReversed{Original: item, Amount: item.Amount, Reason: "batch aborted"}}
+	})
+
+	// the settlement returns the amount and the fee to the sender, so an item paid and
+	// clawed back leaves every account where it started
+	net := make(map[string]int64)
+	for _, env := range []*eventsv1.Envelope{paid, clawedBack} {
+		for _, a := range Activities(env) {
+			switch a.Direction {
+			case "CREDIT":
+				net[a.AccountID] += a.Amount
+			case "DEBIT":
+				net[a.AccountID] -= a.Amount
+			}
+		}
+	}
+	for account, n := range net {
+		if n != 0 {
+			t.Errorf("net movement of %s = %d, want 0", account, n)
+		}
+	}
+}
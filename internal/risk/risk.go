@@ -0,0 +1,188 @@
+package risk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+// Outcomes of a screening, from least to most severe.
+const (
+	Allow  = "ALLOW"
+	Review = "REVIEW"
+	Deny   = "DENY"
+)
+
+// ErrInvalidRules is returned when a rules file is malformed.
+var ErrInvalidRules = errors.New("invalid risk rules")
+
+// Payment is one earlier outgoing transfer of the sender.
+type Payment struct {
+	RecipientID string
+	Amount      int64
+	Currency    string
+	CreatedAt   time.Time
+}
+
+// Input is the transfer being screened and the sender history the rules judge it by.
+type Input struct {
+	SenderID    string
+	RecipientID string
+	Amount      int64
+	Currency    string
+	Now         time.Time
+	// KnownRecipient is true if the sender has paid RecipientID before.
+	KnownRecipient bool
+	// History holds the sender's transfers since Now minus the engine's Lookback,
+	// excluding the one being screened.
+	History []Payment
+}
+
+// Rule judges a transfer. Evaluate returns a reason when the rule matches.
+type Rule interface {
+	Name() string
+	// Action is the outcome a match leads to: REVIEW or DENY.
+	Action() string
+	// Lookback is how much sender history the rule needs.
+	Lookback() time.Duration
+	Evaluate(in Input) (reason string, matched bool)
+}
+
+// Hit records that a rule matched.
+type Hit struct {
+	Rule   string `json:"rule"`
+	Action string `json:"action"`
+	Reason string `json:"reason"`
+}
+
+// Decision is the outcome of screening a transfer and the rules that led to it.
+type Decision struct {
+	Outcome string
+	Hits    []Hit
+}
+
+// Factory builds a rule of one type from its JSON config. base holds the fields every
+// rule has.
+type Factory func(base Base, raw json.RawMessage) (Rule, error)
+
+// Base holds the config fields shared by every rule type.
+type Base struct {
+	RuleName   string `json:"name"`
+	Type       string `json:"type"`
+	RuleAction string `json:"action"`
+}
+
+func (b Base) Name() string   { return b.RuleName }
+func (b Base) Action() string { return b.RuleAction }
+
+// factories maps rule types to their constructors.
+var factories = map[string]Factory{}
+
+// Register makes a rule type available to LoadFile. It panics if typ is registered twice.
+func Register(typ string, f Factory) {
+	if _, ok := factories[typ]; ok {
+		panic("risk: rule type " + typ + " registered twice")
+	}
+	factories[typ] = f
+}
+
+// Engine screens transfers against a fixed set of rules. The zero Engine allows everything.
+type Engine struct {
+	rules []Rule
+}
+
+// NewEngine returns an Engine that applies rules.
+func NewEngine(rules ...Rule) *Engine {
+	return &Engine{rules: rules}
+}
+
+// LoadFile reads rules from a JSON file of the form
+// {"rules": [{"name": "burst", "type": "velocity", "action": "REVIEW", "window": "10m", "max_count": 5}]}.
+// The type of each rule selects the Factory registered for it.
+func LoadFile(path string) (*Engine, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read risk rules: %w", err)
+	}
+	var f struct {
+		Rules []json.RawMessage `json:"rules"`
+	}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse risk rules: %w", err)
+	}
+
+	rules := make([]Rule, 0, len(f.Rules))
+	seen := make(map[string]bool, len(f.Rules))
+	for i, raw := range f.Rules {
+		var base Base
+		if err := json.Unmarshal(raw, &base); err != nil {
+			return nil, fmt.Errorf("%w: rule %d: %v", ErrInvalidRules, i, err)
+		}
+		if base.RuleName == "" || seen[base.RuleName] {
+			return nil, fmt.Errorf("%w: rule %d needs a unique name", ErrInvalidRules, i)
+		}
+		seen[base.RuleName] = true
+		if base.RuleAction != Review && base.RuleAction != Deny {
+			return nil, fmt.Errorf("%w: rule %s has action %q, want REVIEW or DENY", ErrInvalidRules, base.RuleName, base.RuleAction)
+		}
+		factory, ok := factories[base.Type]
+		if !ok {
+			return nil, fmt.Errorf("%w: rule %s has unknown type %q", ErrInvalidRules, base.RuleName, base.Type)
+		}
+		rule, err := factory(base, raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: rule %s: %v", ErrInvalidRules, base.RuleName, err)
+		}
+		rules = append(rules, rule)
+	}
+	return NewEngine(rules...), nil
+}
+
+// Lookback is the longest history any rule needs.
+func (e *Engine) Lookback() time.Duration {
+	var d time.Duration
+	if e == nil {
+		return d
+	}
+	for _, r := range e.rules {
+		d = max(d, r.Lookback())
+	}
+	return d
+}
+
+// Screen evaluates every rule against in. The outcome is the most severe action of the
+// rules that matched, or ALLOW if none did.
+func (e *Engine) Screen(in Input) *Decision {
+	d := &Decision{Outcome: Allow}
+	if e == nil {
+		return d
+	}
+	for _, r := range e.rules {
+		reason, matched := r.Evaluate(in)
+		if !matched {
+			continue
+		}
+		d.Hits = append(d.Hits, Hit{Rule: r.Name(), Action: r.Action(), Reason: reason})
+		if severity(r.Action()) > severity(d.Outcome) {
+			d.Outcome = r.Action()
+		}
+	}
+	sort.SliceStable(d.Hits, func(i, j int) bool {
+		return severity(d.Hits[i].Action) > severity(d.Hits[j].Action)
+	})
+	return d
+}
+
+// severity orders outcomes so the strictest one wins.
+func severity(outcome string) int {
+	switch outcome {
+	case Deny:
+		return 2
+	case Review:
+		return 1
+	}
+	return 0
+}
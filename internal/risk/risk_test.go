@@ -0,0 +1,153 @@
+package risk
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// newRule builds a rule of typ with action from its JSON config.
+func newRule(t *testing.T, typ, action, config string) Rule {
+	t.Helper()
+	r, err := factories[typ](Base{RuleName: typ, Type: typ, RuleAction: action}, json.RawMessage(config))
+	if err != nil {
+		t.Fatalf("failed to build %s rule: %v", typ, err)
+	}
+	return r
+}
+
+// payments returns n earlier USD payments of amount to recipient, ago before now.
+func payments(n int, amount int64, ago time.Duration) []Payment {
+	ps := make([]Payment, n)
+	for i := range ps {
+		ps[i] = Payment{RecipientID: "acc-b", Amount: amount, Currency: "USD", CreatedAt: now.Add(-ago)}
+	}
+	return ps
+}
+
+func TestRuleOutcomes(t *testing.T) {
+	const (
+		velocity     = `{"window": "10m", "max_count": 3, "max_amount": {"USD": 100000}}`
+		newRecipient = `{"min_amount": {"USD": 50000}}`
+		roundAmount  = `{"multiple": {"USD": 10000}, "window": "1h", "min_count": 3}`
+	)
+	base := Input{SenderID: "acc-a", RecipientID: "acc-b", Currency: "USD", Now: now, KnownRecipient: true}
+	with := func(amount int64, known bool, history []Payment) Input {
+		in := base
+		in.Amount, in.KnownRecipient, in.History = amount, known, history
+		return in
+	}
+
+	tests := []struct {
+		name   string
+		typ    string
+		config string
+		in     Input
+		match  bool
+	}{
+		{name: "velocity under count", typ: TypeVelocity, config: velocity, in: with(100, true, payments(2, 100, time.Minute))},
+		{name: "velocity over count", typ: TypeVelocity, config: velocity, in: with(100, true, payments(3, 100, time.Minute)), match: true},
+		{name: "velocity ignores history outside window", typ: TypeVelocity, config: velocity, in: with(100, true, payments(5, 100, time.Hour))},
+		{name: "velocity at amount", typ: TypeVelocity, config: velocity, in: with(50_000, true, payments(1, 50_000, time.Minute))},
+		{name: "velocity over amount", typ: TypeVelocity, config: velocity, in: with(50_001, true, payments(1, 50_000, time.Minute)), match: true},
+		{name: "new recipient below threshold", typ: TypeNewRecipientLargeAmount, config: newRecipient, in: with(49_999, false, nil)},
+		{name: "new recipient at threshold", typ: TypeNewRecipientLargeAmount, config: newRecipient, in: with(50_000, false, nil), match: true},
+		{name: "known recipient at threshold", typ: TypeNewRecipientLargeAmount, config: newRecipient, in: with(50_000, true, nil)},
+		{name: "round amount under count", typ: TypeRoundAmount, config: roundAmount, in: with(20_000, true, payments(1, 10_000, time.Minute))},
+		{name: "round amount at count", typ: TypeRoundAmount, config: roundAmount, in: with(20_000, true, payments(2, 10_000, time.Minute)), match: true},
+		{name: "round amount not round", typ: TypeRoundAmount, config: roundAmount, in: with(20_001, true, payments(2, 10_000, time.Minute))},
+		{name: "round amount history not round", typ: TypeRoundAmount, config: roundAmount, in: with(20_000, true, payments(2, 10_001, time.Minute))},
+	}
+	for _, tt := range tests {
+		for _, action := range []string{Review, Deny} {
+			t.Run(tt.name+"/"+action, func(t *testing.T) {
+				d := NewEngine(newRule(t, tt.typ, action, tt.config)).Screen(tt.in)
+				want := Allow
+				if tt.match {
+					want = action
+				}
+				if d.Outcome != want {
+					t.Fatalf("Screen() Outcome = %s, want %s (hits %v)", d.Outcome, want, d.Hits)
+				}
+				if tt.match != (len(d.Hits) == 1) {
+					t.Fatalf("Screen() Hits = %v, want a hit: %v", d.Hits, tt.match)
+				}
+				if tt.match && (d.Hits[0].Rule != tt.typ || d.Hits[0].Action != action || d.Hits[0].Reason == "") {
+					t.Errorf("Screen() hit = %+v, want rule %s with action %s and a reason", d.Hits[0], tt.typ, action)
+				}
+			})
+		}
+	}
+}
+
+func TestScreenMostSevereWins(t *testing.T) {
+	e := NewEngine(
+		newRule(t, TypeNewRecipientLargeAmount, Review, `{"min_amount": {"USD": 100}}`),
+		newRule(t, TypeRoundAmount, Deny, `{"multiple": {"USD": 100}, "window": "1h"}`),
+	)
+	in := Input{SenderID: "acc-a", RecipientID: "acc-b", Currency: "USD", Now: now}
+
+	tests := []struct {
+		name      string
+		amount    int64
+		want      string
+		wantRules []string
+	}{
+		{name: "no rule matches", amount: 99, want: Allow},
+		{name: "review only", amount: 101, want: Review, wantRules: []string{TypeNewRecipientLargeAmount}},
+		{name: "deny beats review", amount: 200, want: Deny, wantRules: []string{TypeRoundAmount, TypeNewRecipientLargeAmount}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in.Amount = tt.amount
+			d := e.Screen(in)
+			if d.Outcome != tt.want {
+				t.Errorf("Screen() Outcome = %s, want %s", d.Outcome, tt.want)
+			}
+			if len(d.Hits) != len(tt.wantRules) {
+				t.Fatalf("Screen() Hits = %v, want rules %v", d.Hits, tt.wantRules)
+			}
+			for i, h := range d.Hits {
+				if h.Rule != tt.wantRules[i] {
+					t.Errorf("Screen() hit %d = %s, want %s", i, h.Rule, tt.wantRules[i])
+				}
+			}
+		})
+	}
+
+	var nilEngine *Engine
+	if d := nilEngine.Screen(in); d.Outcome != Allow {
+		t.Errorf("nil Engine Screen() Outcome = %s, want %s", d.Outcome, Allow)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		wantErr bool
+	}{
+		{name: "valid", rules: `[{"name": "burst", "type": "velocity", "action": "REVIEW", "window": "10m", "max_count": 5}]`},
+		{name: "allow action", rules: `[{"name": "burst", "type": "velocity", "action": "ALLOW", "window": "10m", "max_count": 5}]`, wantErr: true},
+		{name: "unknown type", rules: `[{"name": "x", "type": "geo", "action": "DENY"}]`, wantErr: true},
+		{name: "duplicate name", rules: `[{"name": "x", "type": "round_amount", "action": "DENY", "multiple": {"USD": 100}, "window": "1h"},
+			{"name": "x", "type": "round_amount", "action": "DENY", "multiple": {"USD": 100}, "window": "1h"}]`, wantErr: true},
+		{name: "velocity without caps", rules: `[{"name": "burst", "type": "velocity", "action": "REVIEW", "window": "10m"}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rules.json")
+			if err := os.WriteFile(path, []byte(`{"rules": `+tt.rules+`}`), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadFile(path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadFile() error = %v, want error: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package risk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Built-in rule types.
+const (
+	TypeVelocity                = "velocity"
+	TypeNewRecipientLargeAmount = "new_recipient_large_amount"
+	TypeRoundAmount             = "round_amount"
+)
+
+func init() {
+	Register(TypeVelocity, newVelocity)
+	Register(TypeNewRecipientLargeAmount, newNewRecipientLargeAmount)
+	Register(TypeRoundAmount, newRoundAmount)
+}
+
+// duration is a time.Duration written as a string such as "10m" in rule config.
+type duration time.Duration
+
+func (d *duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(v)
+	return nil
+}
+
+// velocity matches when the sender makes more than MaxCount transfers, or sends more than
+// MaxAmount in a currency, within Window. The transfer being screened counts.
+type velocity struct {
+	Base
+	Window    duration         `json:"window"`
+	MaxCount  int              `json:"max_count"`
+	MaxAmount map[string]int64 `json:"max_amount"`
+}
+
+func newVelocity(base Base, raw json.RawMessage) (Rule, error) {
+	r := &velocity{Base: base}
+	if err := json.Unmarshal(raw, r); err != nil {
+		return nil, err
+	}
+	if r.Window <= 0 {
+		return nil, errors.New("window must be positive")
+	}
+	if r.MaxCount <= 0 && len(r.MaxAmount) == 0 {
+		return nil, errors.New("needs max_count or max_amount")
+	}
+	return r, nil
+}
+
+func (r *velocity) Lookback() time.Duration { return time.Duration(r.Window) }
+
+func (r *velocity) Evaluate(in Input) (string, bool) {
+	since := in.Now.Add(-time.Duration(r.Window))
+	count, total := 1, in.Amount
+	for _, p := range in.History {
+		if p.CreatedAt.Before(since) {
+			continue
+		}
+		count++
+		if p.Currency == in.Currency {
+			total += p.Amount
+		}
+	}
+	if r.MaxCount > 0 && count > r.MaxCount {
+		return fmt.Sprintf("%d transfers within %s, limit %d", count, time.Duration(r.Window), r.MaxCount), true
+	}
+	if limit, ok := r.MaxAmount[in.Currency]; ok && total > limit {
+		return fmt.Sprintf("%d %s sent within %s, limit %d", total, in.Currency, time.Duration(r.Window), limit), true
+	}
+	return "", false
+}
+
+// newRecipientLargeAmount matches a first transfer to a recipient of at least MinAmount.
+type newRecipientLargeAmount struct {
+	Base
+	MinAmount map[string]int64 `json:"min_amount"`
+}
+
+func newNewRecipientLargeAmount(base Base, raw json.RawMessage) (Rule, error) {
+	r := &newRecipientLargeAmount{Base: base}
+	if err := json.Unmarshal(raw, r); err != nil {
+		return nil, err
+	}
+	if len(r.MinAmount) == 0 {
+		return nil, errors.New("needs min_amount")
+	}
+	return r, nil
+}
+
+func (r *newRecipientLargeAmount) Lookback() time.Duration { return 0 }
+
+func (r *newRecipientLargeAmount) Evaluate(in Input) (string, bool) {
+	limit, ok := r.MinAmount[in.Currency]
+	if !ok || in.KnownRecipient || in.Amount < limit {
+		return "", false
+	}
+	return fmt.Sprintf("first transfer to %s is %d %s, threshold %d", in.RecipientID, in.Amount, in.Currency, limit), true
+}
+
+// roundAmount flags structuring: at least MinCount transfers within Window, the one
+// being screened included, that are exact multiples of Multiple in their currency.
+type roundAmount struct {
+	Base
+	Multiple map[string]int64 `json:"multiple"`
+	Window   duration         `json:"window"`
+	MinCount int              `json:"min_count"`
+}
+
+func newRoundAmount(base Base, raw json.RawMessage) (Rule, error) {
+	r := &roundAmount{Base: base}
+	if err := json.Unmarshal(raw, r); err != nil {
+		return nil, err
+	}
+	if len(r.Multiple) == 0 {
+		return nil, errors.New("needs multiple")
+	}
+	for cur, m := range r.Multiple {
+		if m <= 0 {
+			return nil, fmt.Errorf("multiple for %s must be positive", cur)
+		}
+	}
+	if r.Window <= 0 {
+		return nil, errors.New("window must be positive")
+	}
+	if r.MinCount <= 0 {
+		r.MinCount = 1
+	}
+	return r, nil
+}
+
+func (r *roundAmount) Lookback() time.Duration { return time.Duration(r.Window) }
+
+func (r *roundAmount) round(amount int64, currency string) bool {
+	m, ok := r.Multiple[currency]
+	return ok && amount > 0 && amount%m == 0
+}
+
+func (r *roundAmount) Evaluate(in Input) (string, bool) {
+	if !r.round(in.Amount, in.Currency) {
+		return "", false
+	}
+	since := in.Now.Add(-time.Duration(r.Window))
+	count := 1
+	for _, p := range in.History {
+		if !p.CreatedAt.Before(since) && r.round(p.Amount, p.Currency) {
+			count++
+		}
+	}
+	if count < r.MinCount {
+		return "", false
+	}
+	return fmt.Sprintf("%d round-amount transfers within %s", count, time.Duration(r.Window)), true
+}
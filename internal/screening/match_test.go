@@ -0,0 +1,61 @@
+package screening
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "MARTHA", b: "MARHTA", want: 0.961111},
+		{a: "DWAYNE", b: "DUANE", want: 0.840000},
+		{a: "DIXON", b: "DICKSONX", want: 0.813333},
+		{a: "CRATE", b: "TRACE", want: 0.733333},
+		{a: "IVAN PETROVSKY", b: "IVAN PETROVSKY", want: 1},
+		{a: "ABC", b: "XYZ", want: 0},
+		{a: "", b: "", want: 1},
+		{a: "ABC", b: "", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			got := jaroWinkler(tt.a, tt.b)
+			if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("jaroWinkler(%q, %q) = %.6f, want %.6f", tt.a, tt.b, got, tt.want)
+			}
+			if rev := jaroWinkler(tt.b, tt.a); math.Abs(rev-got) > 1e-9 {
+				t.Errorf("jaroWinkler(%q, %q) = %.6f, not symmetric with %.6f", tt.b, tt.a, rev, got)
+			}
+		})
+	}
+}
+
+func TestSimilarityIgnoresWordOrder(t *testing.T) {
+	if got := Similarity("PETROVSKY IVAN", "IVAN PETROVSKY"); got != 1 {
+		t.Errorf("Similarity() of reordered names = %.4f, want 1", got)
+	}
+	if got, plain := Similarity("IVAN PETROVSKI", "PETROVSKY IVAN"), jaroWinkler("IVAN PETROVSKI", "PETROVSKY IVAN"); got <= plain {
+		t.Errorf("Similarity() = %.4f, want above the unsorted score %.4f", got, plain)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{in: "Ivan Petrovsky", want: "IVAN PETROVSKY"},
+		{in: "PETROVSKY, Ivan", want: "IVAN PETROVSKY"},
+		{in: "MÜLLER, Jürgen", want: "JURGEN MULLER"},
+		{in: "O'Brien-Smith,  Seán", want: "SEAN O BRIEN SMITH"},
+		{in: "Oceanic Star Shipping Ltd.", want: "OCEANIC STAR SHIPPING LTD"},
+		{in: "  ", want: ""},
+		{in: "-.,", want: ""},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
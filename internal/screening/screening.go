@@ -0,0 +1,292 @@
+package screening
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultThreshold is the similarity at or above which a name is reported as a match.
+const DefaultThreshold = 0.92
+
+// ErrInvalidList is returned when a watchlist file cannot be parsed.
+var ErrInvalidList = errors.New("invalid sanctions list")
+
+// Entry is one sanctioned party of a watchlist.
+type Entry struct {
+	UID      string
+	Name     string
+	Aliases  []string
+	Type     string
+	Programs []string
+}
+
+// Match is a watchlist entry whose name or alias resembles a screened name.
+type Match struct {
+	Entry Entry
+	// MatchedName is the name or alias of the entry that matched.
+	MatchedName string
+	Score       float64
+}
+
+// name is a normalized name or alias of an entry.
+type name struct {
+	entry      int
+	original   string
+	normalized string
+}
+
+// List is a parsed watchlist ready for matching.
+type List struct {
+	entries []Entry
+	names   []name
+}
+
+// NewList indexes entries for matching.
+func NewList(entries []Entry) *List {
+	l := &List{entries: entries}
+	for i, e := range entries {
+		for _, n := range append([]string{e.Name}, e.Aliases...) {
+			if norm := Normalize(n); norm != "" {
+				l.names = append(l.names, name{entry: i, original: n, normalized: norm})
+			}
+		}
+	}
+	return l
+}
+
+// Len returns the number of entries in the list.
+func (l *List) Len() int {
+	return len(l.entries)
+}
+
+// Match returns the entries with a name or alias at least threshold similar to
+// holder, best match first and one match per entry.
+func (l *List) Match(holder string, threshold float64) []Match {
+	norm := Normalize(holder)
+	if norm == "" {
+		return nil
+	}
+	best := make(map[int]Match)
+	for _, n := range l.names {
+		score := Similarity(norm, n.normalized)
+		if score < threshold {
+			continue
+		}
+		if m, ok := best[n.entry]; !ok || score > m.Score {
+			best[n.entry] = Match{Entry: l.entries[n.entry], MatchedName: n.original, Score: score}
+		}
+	}
+	matches := make([]Match, 0, len(best))
+	for _, m := range best {
+		matches = append(matches, m)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Entry.UID < matches[j].Entry.UID
+	})
+	return matches
+}
+
+// LoadFile parses a watchlist. Files ending in .xml are read as an OFAC SDN XML export;
+// anything else as SDN-style CSV.
+func LoadFile(path string) (*List, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sanctions list: %w", err)
+	}
+	defer f.Close()
+
+	var entries []Entry
+	if strings.EqualFold(filepath.Ext(path), ".xml") {
+		entries, err = parseXML(f)
+	} else {
+		entries, err = parseCSV(f)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewList(entries), nil
+}
+
+// parseCSV reads the OFAC sdn.csv layout: ent_num, SDN_Name, SDN_Type, Program, then
+// columns that are ignored except Remarks, the twelfth, whose "a.k.a. 'NAME'" parts
+// become aliases. "-0-" marks an empty field.
+func parseCSV(r io.Reader) ([]Entry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	var entries []Entry
+	for line := 1; ; line++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidList, err)
+		}
+		if (len(rec) == 1 && strings.TrimSpace(rec[0]) == "") || strings.HasPrefix(rec[0], "\x1a") {
+			continue
+		}
+		if len(rec) < 2 {
+			return nil, fmt.Errorf("%w: line %d has %d columns", ErrInvalidList, line, len(rec))
+		}
+		field := func(i int) string {
+			if i >= len(rec) {
+				return ""
+			}
+			v := strings.TrimSpace(rec[i])
+			if v == "-0-" {
+				return ""
+			}
+			return v
+		}
+		e := Entry{UID: field(0), Name: field(1), Type: field(2)}
+		if p := field(3); p != "" {
+			e.Programs = strings.Split(strings.NewReplacer("[", "", "]", "").Replace(p), " ")
+		}
+		e.Aliases = akas(field(11))
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// akas extracts the names quoted after "a.k.a." in an SDN remarks field.
+func akas(remarks string) []string {
+	var out []string
+	for _, part := range strings.Split(remarks, ";") {
+		part = strings.TrimSpace(part)
+		i := strings.Index(strings.ToLower(part), "a.k.a.")
+		if i < 0 {
+			continue
+		}
+		alias := strings.Trim(strings.TrimSpace(part[i+len("a.k.a."):]), "'\".")
+		if alias != "" {
+			out = append(out, alias)
+		}
+	}
+	return out
+}
+
+// sdnList is the subset of the OFAC SDN XML schema used for screening.
+type sdnList struct {
+	Entries []struct {
+		UID       string   `xml:"uid"`
+		FirstName string   `xml:"firstName"`
+		LastName  string   `xml:"lastName"`
+		Type      string   `xml:"sdnType"`
+		Programs  []string `xml:"programList>program"`
+		Akas      []struct {
+			FirstName string `xml:"firstName"`
+			LastName  string `xml:"lastName"`
+		} `xml:"akaList>aka"`
+	} `xml:"sdnEntry"`
+}
+
+// parseXML reads an OFAC SDN XML export.
+func parseXML(r io.Reader) ([]Entry, error) {
+	var doc sdnList
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidList, err)
+	}
+	entries := make([]Entry, 0, len(doc.Entries))
+	for _, x := range doc.Entries {
+		e := Entry{
+			UID:      x.UID,
+			Name:     strings.TrimSpace(x.FirstName + " " + x.LastName),
+			Type:     x.Type,
+			Programs: x.Programs,
+		}
+		for _, aka := range x.Akas {
+			e.Aliases = append(e.Aliases, strings.TrimSpace(aka.FirstName+" "+aka.LastName))
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// Screener matches names against a watchlist file and picks up changes to the file
+// without a restart. It is safe for concurrent use.
+type Screener struct {
+	path      string
+	threshold float64
+
+	list atomic.Pointer[List]
+	mu   sync.Mutex
+	mod  time.Time
+}
+
+// NewScreener loads the watchlist at path. Names at least threshold similar to an entry
+// are reported; a threshold of zero uses DefaultThreshold.
+func NewScreener(path string, threshold float64) (*Screener, error) {
+	if threshold <= 0 {
+		threshold = DefaultThreshold
+	}
+	s := &Screener{path: path, threshold: threshold}
+	if _, err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Screen returns the watchlist matches for holder. A nil Screener matches nothing.
+func (s *Screener) Screen(holder string) []Match {
+	if s == nil {
+		return nil
+	}
+	return s.list.Load().Match(holder, s.threshold)
+}
+
+// Reload re-reads the watchlist if the file changed since it was last loaded and
+// reports whether it did. A file that fails to parse leaves the current list in place.
+func (s *Screener) Reload() (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat sanctions list: %w", err)
+	}
+	if s.list.Load() != nil && info.ModTime().Equal(s.mod) {
+		return false, nil
+	}
+	list, err := LoadFile(s.path)
+	if err != nil {
+		return false, err
+	}
+	s.list.Store(list)
+	s.mod = info.ModTime()
+	slog.Info("sanctions list loaded", "path", s.path, "entries", list.Len())
+	return true, nil
+}
+
+// Watch calls Reload every interval until ctx is done. Errors are logged and the
+// previous list stays in use.
+func (s *Screener) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Reload(); err != nil {
+				slog.Error("failed to reload sanctions list", "path", s.path, "error", err)
+			}
+		}
+	}
+}
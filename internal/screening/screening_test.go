@@ -0,0 +1,232 @@
+package screening
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fixtures are the same watchlist in both formats; the CSV has one more entry.
+var fixtures = []string{"testdata/sdn.csv", "testdata/sdn.xml"}
+
+func TestLoadFileCSV(t *testing.T) {
+	l, err := LoadFile("testdata/sdn.csv")
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	want := []Entry{
+		{UID: "1001", Name: "PETROVSKY, Ivan", Type: "individual", Programs: []string{"SDGT", "UKRAINE-EO13661"},
+			Aliases: []string{"PETROVSKI, Ivan", "Vanya PETROV"}},
+		{UID: "1002", Name: "OCEANIC STAR SHIPPING LTD.", Programs: []string{"IRAN"}},
+		{UID: "1003", Name: "MÜLLER, Jürgen", Type: "individual", Programs: []string{"SDGT"}},
+	}
+	assertEntries(t, l.entries, want)
+}
+
+func TestLoadFileXML(t *testing.T) {
+	l, err := LoadFile("testdata/sdn.xml")
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	want := []Entry{
+		{UID: "2001", Name: "Ivan PETROVSKY", Type: "Individual", Programs: []string{"SDGT", "UKRAINE-EO13661"},
+			Aliases: []string{"Ivan PETROVSKI", "Vanya PETROV"}},
+		{UID: "2002", Name: "OCEANIC STAR SHIPPING LTD.", Type: "Entity", Programs: []string{"IRAN"}},
+	}
+	assertEntries(t, l.entries, want)
+}
+
+func assertEntries(t *testing.T, got, want []Entry) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("loaded %d entries, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.UID != w.UID || g.Name != w.Name || g.Type != w.Type ||
+			!slices.Equal(g.Programs, w.Programs) || !slices.Equal(g.Aliases, w.Aliases) {
+			t.Errorf("entry %d = %+v, want %+v", i, g, w)
+		}
+	}
+}
+
+func TestLoadFileInvalid(t *testing.T) {
+	tests := []struct {
+		name, file, content string
+	}{
+		{name: "one column", file: "list.csv", content: "1001\n"},
+		{name: "broken xml", file: "list.xml", content: "<sdnList><sdnEntry>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadFile(path); !errors.Is(err, ErrInvalidList) {
+				t.Errorf("LoadFile() error = %v, want %v", err, ErrInvalidList)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		holder string
+		// want is the matched name, lower-cased so it is the same in both fixtures, or
+		// empty for a near miss.
+		want string
+	}{
+		{name: "exact", holder: "Ivan Petrovsky", want: "ivan petrovsky"},
+		{name: "last name first", holder: "PETROVSKY, Ivan", want: "ivan petrovsky"},
+		{name: "reordered without comma", holder: "Petrovsky Ivan", want: "ivan petrovsky"},
+		{name: "spelling variant", holder: "Ivan Petrovskiy", want: "ivan petrovsky"},
+		{name: "closest alias", holder: "Ivan Petrovski", want: "ivan petrovski"},
+		{name: "weak alias", holder: "Vanya Petrov", want: "vanya petrov"},
+		{name: "entity without suffix", holder: "Oceanic Star Shipping", want: "oceanic star shipping ltd."},
+		{name: "near miss entity", holder: "Ocean Star Logistics"},
+		{name: "near miss surname", holder: "Jordan Miller"},
+		{name: "unrelated", holder: "John Smith"},
+		{name: "nothing to screen", holder: " - "},
+	}
+	for _, path := range fixtures {
+		l, err := LoadFile(path)
+		if err != nil {
+			t.Fatalf("LoadFile(%s) error = %v", path, err)
+		}
+		for _, tt := range tests {
+			t.Run(filepath.Ext(path)+"/"+tt.name, func(t *testing.T) {
+				got := l.Match(tt.holder, DefaultThreshold)
+				if tt.want == "" {
+					if len(got) != 0 {
+						t.Errorf("Match(%q) = %+v, want no match", tt.holder, got)
+					}
+					return
+				}
+				if len(got) != 1 {
+					t.Fatalf("Match(%q) = %+v, want one match", tt.holder, got)
+				}
+				if m := got[0]; !strings.EqualFold(Normalize(m.MatchedName), Normalize(tt.want)) || m.Score < DefaultThreshold {
+					t.Errorf("Match(%q) = %q at %.4f, want %q", tt.holder, m.MatchedName, m.Score, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestMatchDiacritics(t *testing.T) {
+	l, err := LoadFile("testdata/sdn.csv")
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	if got := l.Match("Jurgen Muller", DefaultThreshold); len(got) != 1 || got[0].Entry.UID != "1003" {
+		t.Errorf("Match() of the name without diacritics = %+v, want entry 1003", got)
+	}
+	// transliterated umlauts are too far from the folded name
+	if got := l.Match("Juergen Mueller", DefaultThreshold); len(got) != 0 {
+		t.Errorf("Match() of the transliterated name = %+v, want no match", got)
+	}
+}
+
+func TestMatchThreshold(t *testing.T) {
+	l, err := LoadFile("testdata/sdn.csv")
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	// "Ivana Petrova" scores about 0.926 against the entry and 0.921 against its weak
+	// alias, just above DefaultThreshold.
+	const holder = "Ivana Petrova"
+	score := Similarity(Normalize(holder), Normalize("PETROVSKY, Ivan"))
+	tests := []struct {
+		threshold float64
+		want      int
+	}{
+		{threshold: 0.90, want: 1},
+		{threshold: DefaultThreshold, want: 1},
+		{threshold: score, want: 1},
+		{threshold: math.Nextafter(score, 1), want: 0},
+		{threshold: 1, want: 0},
+	}
+	for _, tt := range tests {
+		got := l.Match(holder, tt.threshold)
+		if len(got) != tt.want {
+			t.Errorf("Match(%q, %.4f) = %+v, want %d matches", holder, tt.threshold, got, tt.want)
+		}
+		if len(got) == 1 && got[0].MatchedName != "PETROVSKY, Ivan" {
+			t.Errorf("Match(%q, %.4f) matched %q, want the best-scoring name", holder, tt.threshold, got[0].MatchedName)
+		}
+	}
+	if got := l.Match("Ivan Petrovsky", 1); len(got) != 1 || got[0].Score != 1 {
+		t.Errorf("Match() of an exact name at threshold 1 = %+v, want one match", got)
+	}
+}
+
+func TestMatchOrder(t *testing.T) {
+	l := NewList([]Entry{
+		{UID: "b", Name: "Ivan Petrovski"},
+		{UID: "a", Name: "Ivan Petrovsky"},
+		{UID: "c", Name: "Ivan Petrovsky"},
+	})
+	got := l.Match("Ivan Petrovsky", DefaultThreshold)
+	var uids []string
+	for _, m := range got {
+		uids = append(uids, m.Entry.UID)
+	}
+	if want := []string{"a", "c", "b"}; !slices.Equal(uids, want) {
+		t.Errorf("Match() order = %v, want %v", uids, want)
+	}
+}
+
+func TestScreenerReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sdn.csv")
+	write := func(content string, mod time.Time) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, mod, mod); err != nil {
+			t.Fatal(err)
+		}
+	}
+	start := time.Now().Add(-time.Hour)
+	write(`1001,"PETROVSKY, Ivan"`+"\n", start)
+
+	s, err := NewScreener(path, 0)
+	if err != nil {
+		t.Fatalf("NewScreener() error = %v", err)
+	}
+	if got := s.Screen("Ivan Petrovsky"); len(got) != 1 {
+		t.Fatalf("Screen() = %+v, want one match", got)
+	}
+
+	if changed, err := s.Reload(); err != nil || changed {
+		t.Errorf("Reload() of an unchanged file = %v, %v, want false, nil", changed, err)
+	}
+
+	write("1001\n", start.Add(time.Minute))
+	if _, err := s.Reload(); !errors.Is(err, ErrInvalidList) {
+		t.Errorf("Reload() of a broken file error = %v, want %v", err, ErrInvalidList)
+	}
+	if got := s.Screen("Ivan Petrovsky"); len(got) != 1 {
+		t.Errorf("Screen() after a failed reload = %+v, want the previous list", got)
+	}
+
+	write(`1002,"OCEANIC STAR SHIPPING LTD."`+"\n", start.Add(2*time.Minute))
+	if changed, err := s.Reload(); err != nil || !changed {
+		t.Errorf("Reload() of a changed file = %v, %v, want true, nil", changed, err)
+	}
+	if got := s.Screen("Ivan Petrovsky"); len(got) != 0 {
+		t.Errorf("Screen() after reload = %+v, want no match", got)
+	}
+
+	var nilScreener *Screener
+	if got := nilScreener.Screen("Ivan Petrovsky"); got != nil {
+		t.Errorf("nil Screener Screen() = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,143 @@
+package tracecontext
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Header is the W3C Trace Context header, also used as the Kafka header and Temporal
+// header name.
+const Header = "traceparent"
+
+// contextKey is the key of the traceparent in a context.Context or workflow.Context.
+type contextKey struct{}
+
+// New starts a trace: it returns a sampled traceparent with random trace and span IDs.
+func New() string {
+	return "00-" + randomHex(16) + "-" + randomHex(8) + "-01"
+}
+
+// Child returns a traceparent for a new span in the trace of parent, or starts a new
+// trace if parent is not a valid traceparent.
+func Child(parent string) string {
+	if !Valid(parent) {
+		return New()
+	}
+	parts := strings.Split(parent, "-")
+	return parts[0] + "-" + parts[1] + "-" + randomHex(8) + "-" + parts[3]
+}
+
+// Valid reports whether tp is a version 00 traceparent with non-zero IDs.
+func Valid(tp string) bool {
+	parts := strings.Split(tp, "-")
+	if len(parts) != 4 || parts[0] != "00" {
+		return false
+	}
+	for i, n := range []int{2, 32, 16, 2} {
+		if len(parts[i]) != n || strings.ToLower(parts[i]) != parts[i] {
+			return false
+		}
+		if _, err := hex.DecodeString(parts[i]); err != nil {
+			return false
+		}
+	}
+	return strings.Trim(parts[1], "0") != "" && strings.Trim(parts[2], "0") != ""
+}
+
+// WithTraceParent returns a copy of ctx carrying tp.
+func WithTraceParent(ctx context.Context, tp string) context.Context {
+	return context.WithValue(ctx, contextKey{}, tp)
+}
+
+// FromContext returns the traceparent carried by ctx, or "" if there is none.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	tp, _ := ctx.Value(contextKey{}).(string)
+	return tp
+}
+
+// Middleware continues the trace of an incoming traceparent header in a new span, or
+// starts a trace when there is none, and carries it in the request context.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tp := Child(r.Header.Get(Header))
+		w.Header().Set(Header, tp)
+		next.ServeHTTP(w, r.WithContext(WithTraceParent(r.Context(), tp)))
+	})
+}
+
+// Propagator carries the traceparent from the context that starts a workflow into the
+// workflow and on to its activities and child workflows.
+type Propagator struct{}
+
+var _ workflow.ContextPropagator = Propagator{}
+
+// Inject writes the traceparent of ctx to the Temporal header.
+func (Propagator) Inject(ctx context.Context, w workflow.HeaderWriter) error {
+	return inject(FromContext(ctx), w)
+}
+
+// Extract reads the traceparent from the Temporal header into ctx.
+func (Propagator) Extract(ctx context.Context, r workflow.HeaderReader) (context.Context, error) {
+	tp, err := extract(r)
+	if err != nil || tp == "" {
+		return ctx, err
+	}
+	return WithTraceParent(ctx, tp), nil
+}
+
+// InjectFromWorkflow writes the traceparent of a workflow to the Temporal header.
+func (Propagator) InjectFromWorkflow(ctx workflow.Context, w workflow.HeaderWriter) error {
+	tp, _ := ctx.Value(contextKey{}).(string)
+	return inject(tp, w)
+}
+
+// ExtractToWorkflow reads the traceparent from the Temporal header into a workflow.
+func (Propagator) ExtractToWorkflow(ctx workflow.Context, r workflow.HeaderReader) (workflow.Context, error) {
+	tp, err := extract(r)
+	if err != nil || tp == "" {
+		return ctx, err
+	}
+	return workflow.WithValue(ctx, contextKey{}, tp), nil
+}
+
+// inject sets tp on w unless it is empty.
+func inject(tp string, w workflow.HeaderWriter) error {
+	if tp == "" {
+		return nil
+	}
+	p, err := converter.GetDefaultDataConverter().ToPayload(tp)
+	if err != nil {
+		return err
+	}
+	w.Set(Header, p)
+	return nil
+}
+
+// extract returns the traceparent of r, or "" if it carries none.
+func extract(r workflow.HeaderReader) (string, error) {
+	p, ok := r.Get(Header)
+	if !ok {
+		return "", nil
+	}
+	var tp string
+	if err := converter.GetDefaultDataConverter().FromPayload(p, &tp); err != nil {
+		return "", err
+	}
+	return tp, nil
+}
+
+// randomHex returns n random bytes as lower-case hex.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
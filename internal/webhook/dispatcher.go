@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	eventsv1 "FinTechPorto/gen/api/events/v1"
+	"FinTechPorto/internal/broker"
+	"FinTechPorto/internal/events"
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/tracecontext"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// deliveryWorkflow is the name the worker registers workflow.WebhookDeliveryWorkflow
+// under; it is referenced by name because the workflow package depends on this one.
+const deliveryWorkflow = "WebhookDeliveryWorkflow"
+
+// DeliveryWorkflowID returns the ID of the workflow that sends a delivery. replay is
+// zero for the first send and the replay count for manual replays.
+func DeliveryWorkflowID(deliveryID string, replay int) string {
+	if replay == 0 {
+		return "webhook-delivery-" + deliveryID
+	}
+	return fmt.Sprintf("webhook-delivery-%s-replay-%d", deliveryID, replay)
+}
+
+// Dispatcher turns events consumed from the broker into deliveries for the endpoints of
+// the merchants whose accounts they involve, and starts a delivery workflow for each.
+type Dispatcher struct {
+	DB       *gorm.DB
+	Temporal client.Client
+}
+
+// NewDispatcher creates a Dispatcher.
+func NewDispatcher(db *gorm.DB, tc client.Client) *Dispatcher {
+	return &Dispatcher{DB: db, Temporal: tc}
+}
+
+// Run consumes c until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context, c *broker.Consumer) error {
+	return c.Run(ctx, d.Handle)
+}
+
+// Handle records the deliveries of one broker message and starts their workflows. It is
+// idempotent: a delivery is keyed by endpoint and event ID, and its workflow is started
+// only once. The envelope is sent as received. Messages that are not events of a known
+// schema version are logged and skipped. Delivery workflows join the trace of the event.
+func (d *Dispatcher) Handle(ctx context.Context, msg broker.Message) error {
+	if tp := msg.Headers[tracecontext.Header]; tracecontext.Valid(tp) {
+		ctx = tracecontext.WithTraceParent(ctx, tracecontext.Child(tp))
+	}
+	env, err := events.Parse(msg.Value)
+	if err != nil {
+		slog.Warn("skipping message that is not an event", "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset, "error", err)
+		return nil
+	}
+
+	endpoints, err := d.endpoints(ctx, env.EventType, accounts(env)...)
+	if err != nil {
+		return err
+	}
+	for _, ep := range endpoints {
+		delivery := models.WebhookDelivery{
+			EndpointID: ep.ID,
+			EventID:    env.EventId,
+			EventType:  env.EventType,
+			Payload:    msg.Value,
+			Status:     "PENDING",
+		}
+		if err := d.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&delivery).Error; err != nil {
+			return fmt.Errorf("failed to record webhook delivery: %w", err)
+		}
+		if err := d.DB.WithContext(ctx).Where("endpoint_id = ? AND event_id = ?", ep.ID, env.EventId).First(&delivery).Error; err != nil {
+			return fmt.Errorf("failed to load webhook delivery: %w", err)
+		}
+		if delivery.Status != "PENDING" {
+			continue
+		}
+		if err := StartDelivery(ctx, d.Temporal, delivery.ID, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accounts returns the accounts an event involves.
+func accounts(env *eventsv1.Envelope) []string {
+	transfer := func(t *eventsv1.Transfer) []string {
+		return []string{t.GetSenderId(), t.GetRecipientId()}
+	}
+	switch p := env.Payload.(type) {
+	case *eventsv1.Envelope_TransferCompleted:
+		return transfer(p.TransferCompleted.GetTransfer())
+	case *eventsv1.Envelope_TransferFailed:
+		return transfer(p.TransferFailed.GetTransfer())
+	case *eventsv1.Envelope_TransferRejected:
+		return transfer(p.TransferRejected.GetTransfer())
+	case *eventsv1.Envelope_TransferReversed:
+		return transfer(p.TransferReversed.GetOriginal())
+	case *eventsv1.Envelope_BatchSettled:
+		return []string{p.BatchSettled.GetSenderId()}
+	case *eventsv1.Envelope_OverdraftThresholdCrossed:
+		return []string{p.OverdraftThresholdCrossed.GetAccountId()}
+	}
+	return []string{env.AggregateId}
+}
+
+// endpoints returns the ACTIVE endpoints subscribed to eventType of the merchants that
+// own any of accountIDs.
+func (d *Dispatcher) endpoints(ctx context.Context, eventType string, accountIDs ...string) ([]models.WebhookEndpoint, error) {
+	var all []models.WebhookEndpoint
+	err := d.DB.WithContext(ctx).
+		Where("status = ?", "ACTIVE").
+		Where("merchant_id IN (?)", d.DB.Model(&models.Account{}).Select("user_id").Where("id IN ?", accountIDs)).
+		Order("id").
+		Find(&all).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load webhook endpoints: %w", err)
+	}
+	endpoints := all[:0]
+	for _, ep := range all {
+		if len(ep.EventTypes) == 0 || slices.Contains(ep.EventTypes, eventType) {
+			endpoints = append(endpoints, ep)
+		}
+	}
+	return endpoints, nil
+}
+
+// StartDelivery starts the workflow that sends deliveryID. A workflow already started
+// under the same ID is not an error.
+func StartDelivery(ctx context.Context, tc client.Client, deliveryID string, replay int) error {
+	_, err := tc.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    DeliveryWorkflowID(deliveryID, replay),
+		TaskQueue:             "transaction-task-queue",
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}, deliveryWorkflow, deliveryID)
+	var started *serviceerror.WorkflowExecutionAlreadyStarted
+	if err != nil && !errors.As(err, &started) {
+		return fmt.Errorf("failed to start webhook delivery: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers set on every delivery.
+const (
+	// HeaderSignature carries "t=<unix seconds>,v1=<hex HMAC-SHA256>" where the HMAC
+	// is keyed with the endpoint secret over "<t>.<body>".
+	HeaderSignature = "Webhook-Signature"
+	// HeaderID is the delivery ID; it stays the same across retries and replays so
+	// receivers can drop duplicates.
+	HeaderID = "Webhook-Id"
+	// HeaderEvent is the event type of the payload.
+	HeaderEvent = "Webhook-Event"
+)
+
+// DefaultTolerance is how far a signature timestamp may be from now for Verify.
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	// ErrInvalidSignature is returned by Verify when a signature header does not match
+	// the body or is malformed.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrStaleSignature is returned by Verify when the signature timestamp is outside
+	// the tolerance.
+	ErrStaleSignature = errors.New("webhook signature timestamp out of tolerance")
+)
+
+// NewSecret returns a random endpoint secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Sign returns the HeaderSignature value for body sent at ts.
+func Sign(secret string, ts time.Time, body []byte) string {
+	t := strconv.FormatInt(ts.Unix(), 10)
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac(secret, t, body))
+}
+
+// Verify checks a HeaderSignature value against body, accepting timestamps within
+// tolerance of now.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var t, sig string
+	for _, part := range strings.Split(header, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch k {
+		case "t":
+			t = v
+		case "v1":
+			sig = v
+		}
+	}
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || sig == "" {
+		return ErrInvalidSignature
+	}
+	want, err := hex.DecodeString(sig)
+	if err != nil || !hmac.Equal(want, mac(secret, t, body)) {
+		return ErrInvalidSignature
+	}
+	if d := now.Sub(time.Unix(unix, 0)); d > tolerance || d < -tolerance {
+		return ErrStaleSignature
+	}
+	return nil
+}
+
+// mac is the HMAC-SHA256 of "<t>.<body>" keyed with secret.
+func mac(secret, t string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(t))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
+
+// Request is one signed delivery to an endpoint.
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID string
+	EventType  string
+	Payload    []byte
+}
+
+// Result is the outcome of Send. StatusCode is zero when no response was received.
+type Result struct {
+	StatusCode int
+	Duration   time.Duration
+}
+
+// Sender posts signed deliveries.
+type Sender struct {
+	Client *http.Client
+	// Now returns the signing time; nil uses time.Now.
+	Now func() time.Time
+}
+
+// NewSender creates a Sender whose requests time out after timeout.
+func NewSender(timeout time.Duration) *Sender {
+	return &Sender{Client: &http.Client{Timeout: timeout}}
+}
+
+// Send POSTs req.Payload as JSON to req.URL. Any response other than 2xx is an error.
+func (s *Sender) Send(ctx context.Context, req Request) (Result, error) {
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+	start := time.Now()
+
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	hreq.Header.Set(HeaderID, req.DeliveryID)
+	hreq.Header.Set(HeaderEvent, req.EventType)
+	hreq.Header.Set(HeaderSignature, Sign(req.Secret, now(), req.Payload))
+
+	resp, err := s.Client.Do(hreq)
+	if err != nil {
+		return Result{Duration: time.Since(start)}, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// drain a bounded amount so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	res := Result{StatusCode: resp.StatusCode, Duration: time.Since(start)}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return res, fmt.Errorf("webhook endpoint responded %s", resp.Status)
+	}
+	return res, nil
+}
@@ -0,0 +1,203 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testSecret = "whsec_test"
+
+// received is a request as seen by the test endpoint.
+type received struct {
+	header http.Header
+	body   []byte
+}
+
+// endpoint is an httptest server answering with the next status of statuses, then 200,
+// and recording every request it receives.
+type endpoint struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int
+	requests []received
+}
+
+func newEndpoint(t *testing.T, statuses ...int) *endpoint {
+	t.Helper()
+	e := &endpoint{statuses: statuses}
+	e.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request body: %v", err)
+		}
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.requests = append(e.requests, received{header: r.Header.Clone(), body: body})
+		status := http.StatusOK
+		if len(e.statuses) > 0 {
+			status, e.statuses = e.statuses[0], e.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(e.Close)
+	return e
+}
+
+func (e *endpoint) received() []received {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]received(nil), e.requests...)
+}
+
+func testRequest(url string) Request {
+	return Request{
+		URL:        url,
+		Secret:     testSecret,
+		DeliveryID: "delivery-1",
+		EventType:  "transfer.completed",
+		Payload:    []byte(`{"event_id":"evt-1","event_type":"transfer.completed"}`),
+	}
+}
+
+func TestSendSignsDelivery(t *testing.T) {
+	ep := newEndpoint(t)
+	req := testRequest(ep.URL)
+
+	res, err := NewSender(5*time.Second).Send(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("Send() StatusCode = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+
+	got := ep.received()
+	if len(got) != 1 {
+		t.Fatalf("endpoint received %d requests, want 1", len(got))
+	}
+	h := got[0].header
+	if id := h.Get(HeaderID); id != req.DeliveryID {
+		t.Errorf("%s = %q, want %q", HeaderID, id, req.DeliveryID)
+	}
+	if ev := h.Get(HeaderEvent); ev != req.EventType {
+		t.Errorf("%s = %q, want %q", HeaderEvent, ev, req.EventType)
+	}
+	if ct := h.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+	if string(got[0].body) != string(req.Payload) {
+		t.Errorf("body = %s, want %s", got[0].body, req.Payload)
+	}
+	if err := Verify(testSecret, h.Get(HeaderSignature), got[0].body, time.Now(), DefaultTolerance); err != nil {
+		t.Errorf("Verify() of the received signature error = %v", err)
+	}
+}
+
+func TestSendRetriesNon2xx(t *testing.T) {
+	ep := newEndpoint(t, http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	req := testRequest(ep.URL)
+	s := NewSender(5 * time.Second)
+
+	// Every error is retried by WebhookDeliveryWorkflow; this loop stands in for it.
+	var attempts int
+	for {
+		attempts++
+		res, err := s.Send(context.Background(), req)
+		if err == nil {
+			if res.StatusCode != http.StatusOK {
+				t.Errorf("Send() StatusCode = %d, want %d", res.StatusCode, http.StatusOK)
+			}
+			break
+		}
+		if res.StatusCode < 300 {
+			t.Fatalf("attempt %d: Send() error = %v with StatusCode %d", attempts, err, res.StatusCode)
+		}
+		if attempts == 10 {
+			t.Fatalf("Send() still failing after %d attempts: %v", attempts, err)
+		}
+	}
+	if attempts != 4 {
+		t.Errorf("Send() succeeded after %d attempts, want 4", attempts)
+	}
+
+	got := ep.received()
+	if len(got) != attempts {
+		t.Fatalf("endpoint received %d requests, want %d", len(got), attempts)
+	}
+	for i, r := range got {
+		if id := r.header.Get(HeaderID); id != req.DeliveryID {
+			t.Errorf("attempt %d: %s = %q, want %q", i+1, HeaderID, id, req.DeliveryID)
+		}
+		if err := Verify(testSecret, r.header.Get(HeaderSignature), r.body, time.Now(), DefaultTolerance); err != nil {
+			t.Errorf("attempt %d: Verify() error = %v", i+1, err)
+		}
+	}
+}
+
+func TestSendRejectsRedirectsAndClientErrors(t *testing.T) {
+	for _, status := range []int{http.StatusMultipleChoices, http.StatusBadRequest, http.StatusGone} {
+		ep := newEndpoint(t, status)
+		res, err := NewSender(5*time.Second).Send(context.Background(), testRequest(ep.URL))
+		if err == nil {
+			t.Errorf("status %d: Send() error = nil, want an error", status)
+		}
+		if res.StatusCode != status {
+			t.Errorf("status %d: Send() StatusCode = %d", status, res.StatusCode)
+		}
+	}
+}
+
+func TestVerifyRejectsTamperedBody(t *testing.T) {
+	ep := newEndpoint(t)
+	if _, err := NewSender(5*time.Second).Send(context.Background(), testRequest(ep.URL)); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	got := ep.received()[0]
+	header := got.header.Get(HeaderSignature)
+
+	tampered := []byte(`{"event_id":"evt-1","event_type":"transfer.failed"}`)
+	if err := Verify(testSecret, header, tampered, time.Now(), DefaultTolerance); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Verify() of a tampered body error = %v, want %v", err, ErrInvalidSignature)
+	}
+	if err := Verify("whsec_other", header, got.body, time.Now(), DefaultTolerance); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Verify() with another secret error = %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"ok":true}`)
+	now := time.Unix(1_700_000_000, 0)
+	valid := Sign(testSecret, now, body)
+
+	tests := []struct {
+		name   string
+		header string
+		now    time.Time
+		want   error
+	}{
+		{name: "valid", header: valid, now: now},
+		{name: "within tolerance", header: valid, now: now.Add(DefaultTolerance)},
+		{name: "stale", header: valid, now: now.Add(DefaultTolerance + time.Second), want: ErrStaleSignature},
+		{name: "from the future", header: valid, now: now.Add(-DefaultTolerance - time.Second), want: ErrStaleSignature},
+		{name: "tampered timestamp", header: strings.Replace(valid, "t=1700000000", "t=1700000001", 1), now: now, want: ErrInvalidSignature},
+		{name: "missing signature", header: "t=1700000000", now: now, want: ErrInvalidSignature},
+		{name: "malformed signature", header: "t=1700000000,v1=zz", now: now, want: ErrInvalidSignature},
+		{name: "empty", header: "", now: now, want: ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(testSecret, tt.header, body, tt.now, DefaultTolerance)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
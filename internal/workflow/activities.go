@@ -2,88 +2,539 @@ package workflow
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"log/slog"
+	"fmt"
+	"time"
 
-	"FinTechPorto/internal/broker"
+	"FinTechPorto/internal/approval"
+	"FinTechPorto/internal/events"
+	"FinTechPorto/internal/fee"
+	"FinTechPorto/internal/ledger"
+	"FinTechPorto/internal/limits"
 	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/risk"
+	"FinTechPorto/internal/screening"
+	"FinTechPorto/internal/webhook"
 
+	"go.temporal.io/sdk/temporal"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 // Activities holds dependencies for workflow activities.
 type Activities struct {
-	DB     *gorm.DB
-	Broker *broker.KafkaWriter
-	Topic  string
+	DB *gorm.DB
+	// Fees prices the transfers started by scheduled runs.
+	Fees *fee.Schedule
+	// Risk screens transfers before they are debited; nil allows everything.
+	Risk *risk.Engine
+	// Sanctions matches account holders against the watchlist; nil matches nobody.
+	Sanctions *screening.Screener
+	// Webhooks sends webhook deliveries to merchant endpoints.
+	Webhooks *webhook.Sender
+	// Approvals decides which scheduled runs wait for an approver.
+	Approvals approval.Policy
 }
 
 // TransferParams defines parameters for a transfer.
 type TransferParams struct {
-	SenderID    string
-	RecipientID string
-	Amount      int64
-	Currency    string
-	Memo        *string
+	// TransactionID is the PENDING transaction recorded when the transfer was accepted.
+	TransactionID string
+	// OriginalTransactionID is set for refunds and reversals.
+	OriginalTransactionID string
+	SenderID              string
+	RecipientID           string
+	Amount                int64
+	Currency              string
+	Memo                  *string
+	// CounterAmount and CounterCurrency are what the recipient receives in a
+	// cross-currency transfer. They are empty when both accounts share Currency.
+	CounterAmount   int64
+	CounterCurrency string
+	// FeeAmount is charged to the sender in Currency on top of Amount.
+	FeeAmount int64
+	// RequiresApproval makes TransferWorkflow wait for an approver before moving funds,
+	// for at most ApprovalTimeout. InitiatorID may not approve.
+	RequiresApproval bool
+	ApprovalTimeout  time.Duration
+	InitiatorID      string
 }
 
-// DebitAccountActivity subtracts amount from the sender's account.
+// creditLeg returns the amount and currency the recipient is credited with.
+func (p TransferParams) creditLeg() (int64, string) {
+	if p.CounterCurrency != "" && p.CounterCurrency != p.Currency {
+		return p.CounterAmount, p.CounterCurrency
+	}
+	return p.Amount, p.Currency
+}
+
+// ScreenTransferActivity screens a transfer against the risk rules using the sender's
+// recent transfers and records the decision. A REVIEW moves the transaction to
+// AWAITING_APPROVAL so an approver can release or reject it. Retries return the decision
+// already recorded.
+func (a *Activities) ScreenTransferActivity(ctx context.Context, p TransferParams) (*models.RiskDecision, error) {
+	var decision models.RiskDecision
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("transaction_id = ?", p.TransactionID).Limit(1).Find(&decision)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 1 {
+			return nil
+		}
+
+		in := risk.Input{
+			SenderID:    p.SenderID,
+			RecipientID: p.RecipientID,
+			Amount:      p.Amount,
+			Currency:    p.Currency,
+			Now:         time.Now(),
+		}
+		history, err := a.riskHistory(tx, p.SenderID, in.Now, []string{p.TransactionID})
+		if err != nil {
+			return err
+		}
+		in.History = history
+		var paid int64
+		if err := tx.Model(&models.Transaction{}).
+			Where("sender_id = ? AND recipient_id = ? AND id <> ? AND status = ?", p.SenderID, p.RecipientID, p.TransactionID, "COMPLETED").
+			Count(&paid).Error; err != nil {
+			return fmt.Errorf("failed to check recipient history: %w", err)
+		}
+		in.KnownRecipient = paid > 0
+
+		d := a.Risk.Screen(in)
+		decision = models.RiskDecision{
+			TransactionID: p.TransactionID,
+			Outcome:       d.Outcome,
+			Hits:          d.Hits,
+		}
+		if err := tx.Create(&decision).Error; err != nil {
+			return fmt.Errorf("failed to record risk decision: %w", err)
+		}
+		if d.Outcome == risk.Review {
+			return tx.Model(&models.Transaction{}).
+				Where("id = ? AND status = ?", p.TransactionID, "PENDING").
+				Update("status", "AWAITING_APPROVAL").Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &decision, nil
+}
+
+// riskHistory loads the transfers of senderID the risk rules look back on from now,
+// leaving out the transactions in excluded, which may be a list of IDs or a subquery.
+func (a *Activities) riskHistory(tx *gorm.DB, senderID string, now time.Time, excluded interface{}) ([]risk.Payment, error) {
+	lookback := a.Risk.Lookback()
+	if lookback <= 0 {
+		return nil, nil
+	}
+	// failed and rejected transfers never moved money, so they are not history
+	settled := []string{"FAILED", "REJECTED"}
+	var history []risk.Payment
+	if err := tx.Model(&models.Transaction{}).
+		Select("recipient_id, amount, currency, created_at").
+		Where("sender_id = ? AND id NOT IN (?) AND type = ? AND status NOT IN ? AND created_at >= ?",
+			senderID, excluded, "TRANSFER", settled, now.Add(-lookback)).
+		Scan(&history).Error; err != nil {
+		return nil, fmt.Errorf("failed to load sender history: %w", err)
+	}
+	return history, nil
+}
+
+// ScreenSanctionsActivity matches the holder names of the sender and recipient against
+// the sanctions list and records every hit. A transfer with hits moves to
+// AWAITING_APPROVAL so compliance can release or reject it. Retries return the hits
+// already recorded.
+func (a *Activities) ScreenSanctionsActivity(ctx context.Context, p TransferParams) ([]models.ScreeningHit, error) {
+	var hits []models.ScreeningHit
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("transaction_id = ?", p.TransactionID).Order("id").Find(&hits).Error; err != nil {
+			return err
+		}
+		if len(hits) > 0 {
+			return nil
+		}
+
+		parties := []struct{ party, accountID string }{
+			{"SENDER", p.SenderID},
+			{"RECIPIENT", p.RecipientID},
+		}
+		for _, pt := range parties {
+			var accounts []models.Account
+			if err := tx.Where("id = ?", pt.accountID).Limit(1).Find(&accounts).Error; err != nil {
+				return err
+			}
+			if len(accounts) == 0 {
+				// the debit or credit reports a missing account
+				continue
+			}
+			acc := accounts[0]
+			for _, m := range a.Sanctions.Screen(acc.HolderName) {
+				hits = append(hits, models.ScreeningHit{
+					TransactionID: p.TransactionID,
+					Party:         pt.party,
+					AccountID:     acc.ID,
+					HolderName:    acc.HolderName,
+					EntryUID:      m.Entry.UID,
+					EntryName:     m.Entry.Name,
+					MatchedName:   m.MatchedName,
+					Score:         m.Score,
+				})
+			}
+		}
+		if len(hits) == 0 {
+			return nil
+		}
+		if err := tx.Create(&hits).Error; err != nil {
+			return fmt.Errorf("failed to record screening hits: %w", err)
+		}
+		return tx.Model(&models.Transaction{}).
+			Where("id = ? AND status = ?", p.TransactionID, "PENDING").
+			Update("status", "AWAITING_APPROVAL").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// DebitAccountActivity subtracts amount and the fee from the sender's account. Transfers,
+// but not refunds or reversals, count against the sender's transfer limits. A retry after
+// the debit committed succeeds without debiting again.
 func (a *Activities) DebitAccountActivity(ctx context.Context, p TransferParams) error {
-	return a.DB.Transaction(func(tx *gorm.DB) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var sender models.Account
 		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND currency = ?", p.SenderID, p.Currency).First(&sender).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errors.New("sender not found")
+				return temporal.NewNonRetryableApplicationError("sender not found", "SenderNotFound", nil)
 			}
 			return err
 		}
+		if posted, err := ledger.Posted(tx, p.TransactionID, "transfer debit"); err != nil || posted {
+			return err
+		}
 
-		if sender.Balance < p.Amount {
-			return errors.New("insufficient funds")
+		if err := ledger.CheckDebit(&sender); err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "AccountBlocked", nil)
+		}
+		if err := ledger.CheckFunds(&sender, p.Amount+p.FeeAmount); err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "InsufficientFunds", nil)
+		}
+		if p.OriginalTransactionID == "" {
+			if err := limits.Consume(tx, &sender, p.Amount, p.TransactionID); err != nil {
+				return limitError(err)
+			}
 		}
 
-		sender.Balance -= p.Amount
-		if err := tx.Model(&models.Account{}).Where("id = ?", sender.ID).Update("balance", sender.Balance).Error; err != nil {
+		// move the funds into transit until the credit leg runs; the fee is revenue straight away
+		transit, err := ledger.SystemAccount(tx, ledger.PurposeTransit, p.Currency)
+		if err != nil {
 			return err
 		}
-		return nil
+		lines := ledger.Transfer(sender.ID, transit.ID, p.Currency, p.Amount)
+		if p.FeeAmount > 0 {
+			fees, err := ledger.SystemAccount(tx, ledger.PurposeFees, p.Currency)
+			if err != nil {
+				return err
+			}
+			lines = append(lines, ledger.Transfer(sender.ID, fees.ID, p.Currency, p.FeeAmount)...)
+		}
+		_, err = ledger.Post(tx, p.TransactionID, "transfer debit", lines...)
+		return err
 	})
 }
 
-// CreditAccountActivity adds amount to the recipient's account and creates a transaction record.
+// CreditAccountActivity adds amount to the recipient's account and marks the transaction
+// COMPLETED. A retry after the credit committed returns the transaction without
+// crediting again.
 func (a *Activities) CreditAccountActivity(ctx context.Context, p TransferParams) (*models.Transaction, error) {
 	var tr models.Transaction
-	err := a.DB.Transaction(func(tx *gorm.DB) error {
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var recipient models.Account
-		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND currency = ?", p.RecipientID, p.Currency).First(&recipient).Error; err != nil {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", p.RecipientID).First(&recipient).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errors.New("recipient not found")
+				return temporal.NewNonRetryableApplicationError("recipient not found", "RecipientNotFound", nil)
 			}
 			return err
 		}
+		posted, err := ledger.Posted(tx, p.TransactionID, "transfer credit")
+		if err != nil {
+			return err
+		}
+		if posted {
+			return tx.Where("id = ?", p.TransactionID).First(&tr).Error
+		}
+		if err := ledger.CheckCredit(&recipient); err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "AccountBlocked", nil)
+		}
+		creditAmount, creditCurrency := p.creditLeg()
+		if recipient.Currency != creditCurrency {
+			return temporal.NewNonRetryableApplicationError("recipient account is in "+recipient.Currency+", not "+creditCurrency, "CurrencyMismatch", nil)
+		}
 
-		recipient.Balance += p.Amount
-		if err := tx.Model(&models.Account{}).Where("id = ?", recipient.ID).Update("balance", recipient.Balance).Error; err != nil {
+		transit, err := ledger.SystemAccount(tx, ledger.PurposeTransit, p.Currency)
+		if err != nil {
+			return err
+		}
+		lines := ledger.Transfer(transit.ID, recipient.ID, p.Currency, p.Amount)
+		if creditCurrency != p.Currency {
+			// The house FX position buys the sender's currency and sells the recipient's,
+			// so the entry balances in each currency separately.
+			sellSide, err := ledger.SystemAccount(tx, ledger.PurposeFx, p.Currency)
+			if err != nil {
+				return err
+			}
+			buySide, err := ledger.SystemAccount(tx, ledger.PurposeFx, creditCurrency)
+			if err != nil {
+				return err
+			}
+			lines = append(ledger.Transfer(transit.ID, sellSide.ID, p.Currency, p.Amount),
+				ledger.Transfer(buySide.ID, recipient.ID, creditCurrency, creditAmount)...)
+		}
+		if _, err := ledger.Post(tx, p.TransactionID, "transfer credit", lines...); err != nil {
 			return err
 		}
 
-		tr = models.Transaction{
-			SenderID:    p.SenderID,
-			RecipientID: p.RecipientID,
-			Amount:      p.Amount,
-			Currency:    p.Currency,
-			Status:      "COMPLETED",
+		if err := tx.Model(&models.Transaction{}).Where("id = ?", p.TransactionID).Update("status", "COMPLETED").Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", p.TransactionID).First(&tr).Error; err != nil {
+			return err
+		}
+		// refunds are reported once settled against the original, by SettleRefundActivity
+		if tr.OriginalTransactionID != "" {
+			return nil
+		}
+		return events.TransferCompleted(tx, &tr, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tr, nil
+}
+
+// RefundDebitActivity compensates DebitAccountActivity by moving the funds held in
+// transit, and the fee, back to the sender. It runs when a later step of the transfer fails.
+// Like every compensation it ignores the account status so the funds always go back, and
+// it is retried until it succeeds, so a retry after the refund committed does nothing.
+func (a *Activities) RefundDebitActivity(ctx context.Context, p TransferParams) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if posted, err := ledger.Posted(tx, p.TransactionID, "transfer debit refund"); err != nil || posted {
+			return err
 		}
-		if p.Memo != nil {
-			tr.Memo = *p.Memo
+		transit, err := ledger.SystemAccount(tx, ledger.PurposeTransit, p.Currency)
+		if err != nil {
+			return err
+		}
+		lines := ledger.Transfer(transit.ID, p.SenderID, p.Currency, p.Amount)
+		if p.FeeAmount > 0 {
+			fees, err := ledger.SystemAccount(tx, ledger.PurposeFees, p.Currency)
+			if err != nil {
+				return err
+			}
+			lines = append(lines, ledger.Transfer(fees.ID, p.SenderID, p.Currency, p.FeeAmount)...)
 		}
+		if _, err := ledger.Post(tx, p.TransactionID, "transfer debit refund", lines...); err != nil {
+			return err
+		}
+		return limits.Release(tx, p.TransactionID)
+	})
+}
+
+// limitError makes a transfer limit rejection non-retryable; other errors are retried.
+func limitError(err error) error {
+	if errors.Is(err, limits.ErrLimitExceeded) {
+		return temporal.NewNonRetryableApplicationError(err.Error(), "LimitExceeded", nil)
+	}
+	return err
+}
 
-		if err := tx.Create(&tr).Error; err != nil {
+// RecordFailedTransferActivity marks the transaction FAILED with the reason the transfer
+// did not complete.
+func (a *Activities) RecordFailedTransferActivity(ctx context.Context, p TransferParams, reason string) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tr models.Transaction
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", p.TransactionID).First(&tr).Error; err != nil {
 			return err
 		}
-		return nil
+		if tr.Status == "FAILED" {
+			// already recorded by an earlier attempt of this activity
+			return nil
+		}
+		tr.Status, tr.FailureReason = "FAILED", reason
+		if err := tx.Model(&tr).Updates(map[string]interface{}{
+			"status":         tr.Status,
+			"failure_reason": tr.FailureReason,
+		}).Error; err != nil {
+			return err
+		}
+		return events.TransferFailed(tx, &tr, reason)
+	})
+}
+
+// RecordApprovalActivity moves an approved transfer from AWAITING_APPROVAL back to PENDING.
+func (a *Activities) RecordApprovalActivity(ctx context.Context, p TransferParams, approverID string) error {
+	return a.DB.WithContext(ctx).Model(&models.Transaction{}).
+		Where("id = ? AND status = ?", p.TransactionID, "AWAITING_APPROVAL").
+		Updates(map[string]interface{}{
+			"status":      "PENDING",
+			"approver_id": approverID,
+		}).Error
+}
+
+// RecordRejectionActivity marks a transfer that was rejected or timed out REJECTED.
+// approverID is empty when the approval timed out.
+func (a *Activities) RecordRejectionActivity(ctx context.Context, p TransferParams, approverID, reason string) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tr models.Transaction
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", p.TransactionID).First(&tr).Error; err != nil {
+			return err
+		}
+		if tr.Status != "AWAITING_APPROVAL" {
+			return nil
+		}
+		tr.Status, tr.ApproverID, tr.FailureReason = "REJECTED", approverID, reason
+		if err := tx.Model(&tr).Updates(map[string]interface{}{
+			"status":         tr.Status,
+			"approver_id":    tr.ApproverID,
+			"failure_reason": tr.FailureReason,
+		}).Error; err != nil {
+			return err
+		}
+		return events.TransferRejected(tx, &tr, approverID, reason)
+	})
+}
+
+// SettleRefundActivity updates the original transfer after its refund refundID completed.
+// RefundedAmount is recomputed from the completed refunds, and the transfer moves to
+// REVERSED once nothing remains. The refund is marked settled, so a retry does nothing
+// even when another refund of the same transfer completed in between.
+func (a *Activities) SettleRefundActivity(ctx context.Context, originalID, refundID string) (*models.Transaction, error) {
+	var orig models.Transaction
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", originalID).First(&orig).Error; err != nil {
+			return err
+		}
+		var refund models.Transaction
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", refundID).First(&refund).Error; err != nil {
+			return err
+		}
+		if refund.SettledAt != nil {
+			// already settled by an earlier attempt of this activity
+			return nil
+		}
+
+		var settled int64
+		if err := tx.Model(&models.Transaction{}).
+			Where("original_transaction_id = ? AND status = ?", originalID, "COMPLETED").
+			Select("COALESCE(SUM(amount), 0)").
+			Scan(&settled).Error; err != nil {
+			return err
+		}
+
+		orig.RefundedAmount = settled
+		if settled >= orig.Amount {
+			orig.Status = "REVERSED"
+		}
+		if err := tx.Model(&orig).Updates(map[string]interface{}{
+			"refunded_amount": orig.RefundedAmount,
+			"status":          orig.Status,
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&refund).Update("settled_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return events.TransferReversed(tx, &orig, &refund, refund.Amount, refund.Memo)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &orig, nil
+}
+
+// ExpireHoldActivity releases a hold that is still ACTIVE once it has expired.
+// Holds that were captured or voided in the meantime are left untouched, as are holds
+// whose capture is under review; ReleaseCaptureActivity expires those if the capture
+// is rejected.
+func (a *Activities) ExpireHoldActivity(ctx context.Context, holdID string) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var hold models.Hold
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", holdID).First(&hold).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return temporal.NewNonRetryableApplicationError("hold not found", "HoldNotFound", nil)
+			}
+			return err
+		}
+		if hold.Status != "ACTIVE" || hold.CaptureTransactionID != "" {
+			return nil
+		}
+		if time.Now().Before(hold.ExpiresAt) {
+			return temporal.NewApplicationError("hold has not expired yet", "HoldNotExpired")
+		}
+		return ledger.ReleaseHold(tx, &hold, "EXPIRED")
+	})
+}
+
+// CompleteCaptureActivity completes a hold capture released by an approver: it moves the
+// captured amount from the holder to the recipient, releases the rest of the hold and
+// marks the capture transaction COMPLETED. A retry after the capture committed returns
+// the completed transaction.
+func (a *Activities) CompleteCaptureActivity(ctx context.Context, holdID, transactionID string) (*models.Transaction, error) {
+	var tr models.Transaction
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", transactionID).First(&tr).Error; err != nil {
+			return err
+		}
+		if tr.Status == "COMPLETED" {
+			return nil
+		}
+		if tr.Status != "PENDING" {
+			return temporal.NewNonRetryableApplicationError("capture is "+tr.Status, "CaptureNotPending", nil)
+		}
+
+		var hold models.Hold
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", holdID).First(&hold).Error; err != nil {
+			return err
+		}
+		if hold.Status != "ACTIVE" || hold.CaptureTransactionID != tr.ID {
+			return temporal.NewNonRetryableApplicationError("hold is not reserved for this capture", "HoldNotActive", nil)
+		}
+
+		// Either account may have been frozen or closed while the capture was reviewed.
+		var holder, recipient models.Account
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", hold.AccountID).First(&holder).Error; err != nil {
+			return err
+		}
+		if err := ledger.CheckDebit(&holder); err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "AccountBlocked", nil)
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", tr.RecipientID).First(&recipient).Error; err != nil {
+			return err
+		}
+		if err := ledger.CheckCredit(&recipient); err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "AccountBlocked", nil)
+		}
+
+		if err := ledger.ReleaseHold(tx, &hold, "CAPTURED"); err != nil {
+			return err
+		}
+		if _, err := ledger.Post(tx, tr.ID, "hold capture", ledger.Transfer(holder.ID, recipient.ID, tr.Currency, tr.Amount)...); err != nil {
+			return err
+		}
+		// the limits were checked when the hold was authorized
+		if err := limits.Record(tx, &holder, tr.Amount, tr.ID); err != nil {
+			return err
+		}
+		tr.Status = "COMPLETED"
+		if err := tx.Model(&tr).Update("status", tr.Status).Error; err != nil {
+			return err
+		}
+		return events.TransferCompleted(tx, &tr, hold.ID)
 	})
 	if err != nil {
 		return nil, err
@@ -91,25 +542,778 @@ func (a *Activities) CreditAccountActivity(ctx context.Context, p TransferParams
 	return &tr, nil
 }
 
-// PublishKafkaEventActivity publishes a JSON event to the configured Kafka topic.
-func (a *Activities) PublishKafkaEventActivity(ctx context.Context, event map[string]interface{}) error {
-	if a.Broker == nil {
-		slog.Info("broker not configured; skipping publish")
+// ReleaseCaptureActivity gives a hold reserved for a rejected or failed capture back to
+// its holder. A hold that expired during the review is released as EXPIRED, since its
+// HoldExpiryWorkflow left it alone.
+func (a *Activities) ReleaseCaptureActivity(ctx context.Context, holdID, transactionID string) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var hold models.Hold
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", holdID).First(&hold).Error; err != nil {
+			return err
+		}
+		if hold.Status != "ACTIVE" || hold.CaptureTransactionID != transactionID {
+			return nil
+		}
+		hold.CapturedAmount, hold.CaptureTransactionID = 0, ""
+		if !time.Now().Before(hold.ExpiresAt) {
+			return ledger.ReleaseHold(tx, &hold, "EXPIRED")
+		}
+		return tx.Model(&hold).Updates(map[string]interface{}{
+			"captured_amount":        hold.CapturedAmount,
+			"capture_transaction_id": hold.CaptureTransactionID,
+		}).Error
+	})
+}
+
+// BeginScheduleRunActivity records a PENDING run of an ACTIVE schedule. It returns an
+// empty ScheduleRunStart when the schedule was cancelled or completed in the meantime.
+func (a *Activities) BeginScheduleRunActivity(ctx context.Context, scheduleID string) (*ScheduleRunStart, error) {
+	var start ScheduleRunStart
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var sched models.TransferSchedule
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", scheduleID).First(&sched).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return temporal.NewNonRetryableApplicationError("schedule not found", "ScheduleNotFound", nil)
+			}
+			return err
+		}
+		if sched.Status != "ACTIVE" {
+			return nil
+		}
+
+		run := models.ScheduleRun{ScheduleID: sched.ID, Status: "PENDING"}
+		if err := tx.Create(&run).Error; err != nil {
+			return err
+		}
+		start = ScheduleRunStart{RunID: run.ID, OnInsufficientFunds: sched.OnInsufficientFunds}
 		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return &start, nil
+}
+
+// StartScheduleAttemptActivity records the PENDING transaction for one attempt of a run
+// and links it to the run. An attempt above the approval threshold is recorded as
+// AWAITING_APPROVAL and waits for an approver like any other transfer. Retrying the
+// activity returns the transaction it already created.
+func (a *Activities) StartScheduleAttemptActivity(ctx context.Context, runID string, attempt int) (*ScheduleAttempt, error) {
+	var out ScheduleAttempt
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var run models.ScheduleRun
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", runID).First(&run).Error; err != nil {
+			return err
+		}
+		var sched models.TransferSchedule
+		if err := tx.Where("id = ?", run.ScheduleID).First(&sched).Error; err != nil {
+			return err
+		}
 
-	b := a.Broker
-	bts, err := json.Marshal(event)
+		var tr models.Transaction
+		if run.Attempts >= attempt && run.TransactionID != "" {
+			if err := tx.Where("id = ?", run.TransactionID).First(&tr).Error; err != nil {
+				return err
+			}
+		} else {
+			breakdown, err := a.Fees.Compute(fee.ProductTransfer, sched.Currency, sched.Currency, sched.Amount)
+			if err != nil {
+				return temporal.NewNonRetryableApplicationError(err.Error(), "InvalidSchedule", nil)
+			}
+			var sender models.Account
+			if err := tx.Where("id = ?", sched.SenderID).First(&sender).Error; err != nil {
+				return err
+			}
+			tr = models.Transaction{
+				SenderID:     sched.SenderID,
+				RecipientID:  sched.RecipientID,
+				Amount:       sched.Amount,
+				Currency:     sched.Currency,
+				Status:       "PENDING",
+				Type:         "TRANSFER",
+				Memo:         sched.Memo,
+				FeeAmount:    breakdown.Total,
+				FeeBreakdown: breakdown,
+				WorkflowID:   fmt.Sprintf("scheduled-transfer-%s-%d", run.ID, attempt),
+				InitiatorID:  sender.UserID,
+			}
+			// every run is held to the threshold in force when it fires
+			if a.Approvals.Requires(tr.Currency, tr.Amount) {
+				tr.Status = "AWAITING_APPROVAL"
+			}
+			if err := tx.Create(&tr).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&run).Updates(map[string]interface{}{
+				"transaction_id": tr.ID,
+				"attempts":       attempt,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		out = ScheduleAttempt{
+			WorkflowID: tr.WorkflowID,
+			Params: TransferParams{
+				TransactionID: tr.ID,
+				SenderID:      tr.SenderID,
+				RecipientID:   tr.RecipientID,
+				Amount:        tr.Amount,
+				Currency:      tr.Currency,
+				FeeAmount:     tr.FeeAmount,
+				// Decided when the attempt was recorded, so a retry keeps the decision.
+				RequiresApproval: tr.Status == "AWAITING_APPROVAL",
+				ApprovalTimeout:  a.Approvals.Timeout,
+				InitiatorID:      tr.InitiatorID,
+			},
+		}
+		if tr.Memo != "" {
+			memo := tr.Memo
+			out.Params.Memo = &memo
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FinishScheduleRunActivity records the outcome of a run and counts it against the
+// schedule, which completes once it reaches MaxRuns or its EndAt has passed. A run whose
+// transfer an approver rejected is recorded as FAILED.
+func (a *Activities) FinishScheduleRunActivity(ctx context.Context, runID, status, reason string) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var run models.ScheduleRun
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", runID).First(&run).Error; err != nil {
+			return err
+		}
+		if run.Status != "PENDING" {
+			// already finished by an earlier attempt of this activity
+			return nil
+		}
+		if status == "COMPLETED" && run.TransactionID != "" {
+			var tr models.Transaction
+			if err := tx.Where("id = ?", run.TransactionID).First(&tr).Error; err != nil {
+				return err
+			}
+			if tr.Status == "REJECTED" {
+				status, reason = "FAILED", tr.FailureReason
+			}
+		}
+		if err := tx.Model(&run).Updates(map[string]interface{}{
+			"status":         status,
+			"failure_reason": reason,
+		}).Error; err != nil {
+			return err
+		}
+
+		var sched models.TransferSchedule
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", run.ScheduleID).First(&sched).Error; err != nil {
+			return err
+		}
+		updates := map[string]interface{}{"run_count": sched.RunCount + 1}
+		if sched.Status == "ACTIVE" &&
+			((sched.MaxRuns > 0 && sched.RunCount+1 >= sched.MaxRuns) || (sched.EndAt != nil && time.Now().After(*sched.EndAt))) {
+			updates["status"] = "COMPLETED"
+		}
+		return tx.Model(&sched).Updates(updates).Error
+	})
+}
+
+// BatchScreening counts the items of a batch that risk screening held for review or
+// denied.
+type BatchScreening struct {
+	Review int
+	Denied int
+}
+
+// ScreenBatchRiskActivity screens every item of a batch against the risk rules before
+// anything is reserved and records a decision per item. The batch is one payment run, so
+// its own items are not part of the sender history each item is judged by. A REVIEW holds
+// the item in AWAITING_APPROVAL like a transfer and a DENY fails it. Retries return the
+// counts already recorded.
+func (a *Activities) ScreenBatchRiskActivity(ctx context.Context, batchID string) (*BatchScreening, error) {
+	var res BatchScreening
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var batch models.TransferBatch
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", batchID).First(&batch).Error; err != nil {
+			return err
+		}
+		itemIDs := tx.Model(&models.BatchItem{}).Select("transaction_id").Where("batch_id = ?", batch.ID)
+
+		var outcomes []struct {
+			Outcome string
+			N       int
+		}
+		if err := tx.Model(&models.RiskDecision{}).
+			Select("outcome, COUNT(*) AS n").
+			Where("transaction_id IN (?)", itemIDs).
+			Group("outcome").
+			Scan(&outcomes).Error; err != nil {
+			return err
+		}
+		if len(outcomes) > 0 {
+			for _, o := range outcomes {
+				switch o.Outcome {
+				case risk.Review:
+					res.Review = o.N
+				case risk.Deny:
+					res.Denied = o.N
+				}
+			}
+			return nil
+		}
+
+		var items []models.BatchItem
+		if err := tx.Where("batch_id = ?", batch.ID).Order("seq").Find(&items).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		history, err := a.riskHistory(tx, batch.SenderID, now, itemIDs)
+		if err != nil {
+			return err
+		}
+		recipientIDs := make([]string, 0, len(items))
+		for _, it := range items {
+			recipientIDs = append(recipientIDs, it.RecipientID)
+		}
+		var paid []string
+		if err := tx.Model(&models.Transaction{}).
+			Distinct("recipient_id").
+			Where("sender_id = ? AND recipient_id IN ? AND status = ?", batch.SenderID, recipientIDs, "COMPLETED").
+			Pluck("recipient_id", &paid).Error; err != nil {
+			return fmt.Errorf("failed to check recipient history: %w", err)
+		}
+		known := make(map[string]bool, len(paid))
+		for _, id := range paid {
+			known[id] = true
+		}
+
+		decisions := make([]models.RiskDecision, 0, len(items))
+		var reviewIDs []string
+		for _, it := range items {
+			d := a.Risk.Screen(risk.Input{
+				SenderID:       batch.SenderID,
+				RecipientID:    it.RecipientID,
+				Amount:         it.Amount,
+				Currency:       batch.Currency,
+				Now:            now,
+				KnownRecipient: known[it.RecipientID],
+				History:        history,
+			})
+			decisions = append(decisions, models.RiskDecision{
+				TransactionID: it.TransactionID,
+				Outcome:       d.Outcome,
+				Hits:          d.Hits,
+			})
+			switch d.Outcome {
+			case risk.Review:
+				reviewIDs = append(reviewIDs, it.TransactionID)
+			case risk.Deny:
+				res.Denied++
+				if err := tx.Model(&models.Transaction{}).
+					Where("id = ? AND status = ?", it.TransactionID, "PENDING").
+					Updates(map[string]interface{}{
+						"status":         "FAILED",
+						"failure_reason": "denied by risk screening: " + d.Hits[0].Reason,
+					}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		res.Review = len(reviewIDs)
+		if len(decisions) > 0 {
+			if err := tx.CreateInBatches(decisions, 500).Error; err != nil {
+				return fmt.Errorf("failed to record risk decisions: %w", err)
+			}
+		}
+		if len(reviewIDs) == 0 {
+			return nil
+		}
+		return tx.Model(&models.Transaction{}).
+			Where("id IN ? AND status = ?", reviewIDs, "PENDING").
+			Update("status", "AWAITING_APPROVAL").Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ScreenBatchActivity matches the holder names of a batch's sender and of every
+// recipient against the sanctions list before anything is reserved, and records each hit
+// against the item it concerns. Items with hits move to AWAITING_APPROVAL, so their
+// BatchItemWorkflow waits for compliance before paying. It returns how many items are
+// held. Retries return the count already recorded.
+func (a *Activities) ScreenBatchActivity(ctx context.Context, batchID string) (int, error) {
+	var held int
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var batch models.TransferBatch
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", batchID).First(&batch).Error; err != nil {
+			return err
+		}
+		var n int64
+		if err := tx.Model(&models.ScreeningHit{}).
+			Where("transaction_id IN (?)", tx.Model(&models.BatchItem{}).Select("transaction_id").Where("batch_id = ?", batch.ID)).
+			Distinct("transaction_id").Count(&n).Error; err != nil {
+			return err
+		}
+		if n > 0 {
+			held = int(n)
+			return nil
+		}
+
+		var items []models.BatchItem
+		if err := tx.Where("batch_id = ?", batch.ID).Order("seq").Find(&items).Error; err != nil {
+			return err
+		}
+
+		ids := []string{batch.SenderID}
+		for _, it := range items {
+			ids = append(ids, it.RecipientID)
+		}
+		var accounts []models.Account
+		if err := tx.Where("id IN ?", ids).Find(&accounts).Error; err != nil {
+			return err
+		}
+		// every holder is screened once however many items pay them
+		matches := make(map[string][]screening.Match, len(accounts))
+		holders := make(map[string]models.Account, len(accounts))
+		for _, acc := range accounts {
+			holders[acc.ID] = acc
+			matches[acc.ID] = a.Sanctions.Screen(acc.HolderName)
+		}
+
+		var hits []models.ScreeningHit
+		var heldIDs []string
+		for _, it := range items {
+			before := len(hits)
+			parties := []struct{ party, accountID string }{
+				{"SENDER", batch.SenderID},
+				{"RECIPIENT", it.RecipientID},
+			}
+			for _, pt := range parties {
+				acc := holders[pt.accountID]
+				for _, m := range matches[pt.accountID] {
+					hits = append(hits, models.ScreeningHit{
+						TransactionID: it.TransactionID,
+						Party:         pt.party,
+						AccountID:     acc.ID,
+						HolderName:    acc.HolderName,
+						EntryUID:      m.Entry.UID,
+						EntryName:     m.Entry.Name,
+						MatchedName:   m.MatchedName,
+						Score:         m.Score,
+					})
+				}
+			}
+			if len(hits) > before {
+				heldIDs = append(heldIDs, it.TransactionID)
+			}
+		}
+		held = len(heldIDs)
+		if held == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(hits, 500).Error; err != nil {
+			return fmt.Errorf("failed to record screening hits: %w", err)
+		}
+		return tx.Model(&models.Transaction{}).
+			Where("id IN ? AND status = ?", heldIDs, "PENDING").
+			Update("status", "AWAITING_APPROVAL").Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return held, nil
+}
+
+// ReserveBatchActivity moves the total of a PENDING batch from the sender into transit and
+// its fees to revenue, then marks the batch PROCESSING.
+func (a *Activities) ReserveBatchActivity(ctx context.Context, batchID string) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var batch models.TransferBatch
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", batchID).First(&batch).Error; err != nil {
+			return err
+		}
+		if batch.Status != "PENDING" {
+			// already reserved by an earlier attempt of this activity
+			return nil
+		}
+
+		var sender models.Account
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND currency = ?", batch.SenderID, batch.Currency).First(&sender).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return temporal.NewNonRetryableApplicationError("sender not found", "SenderNotFound", nil)
+			}
+			return err
+		}
+		if err := ledger.CheckDebit(&sender); err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "AccountBlocked", nil)
+		}
+		if err := ledger.CheckFunds(&sender, batch.TotalAmount+batch.TotalFee); err != nil {
+			return temporal.NewNonRetryableApplicationError(err.Error(), "InsufficientFunds", nil)
+		}
+		// the batch counts as one transfer of its total against the sender's limits
+		if err := limits.Consume(tx, &sender, batch.TotalAmount, batch.ID); err != nil {
+			return limitError(err)
+		}
+
+		transit, err := ledger.SystemAccount(tx, ledger.PurposeTransit, batch.Currency)
+		if err != nil {
+			return err
+		}
+		lines := ledger.Transfer(sender.ID, transit.ID, batch.Currency, batch.TotalAmount)
+		if batch.TotalFee > 0 {
+			fees, err := ledger.SystemAccount(tx, ledger.PurposeFees, batch.Currency)
+			if err != nil {
+				return err
+			}
+			lines = append(lines, ledger.Transfer(sender.ID, fees.ID, batch.Currency, batch.TotalFee)...)
+		}
+		if _, err := ledger.Post(tx, batch.ID, "batch reservation", lines...); err != nil {
+			return err
+		}
+		return tx.Model(&batch).Update("status", "PROCESSING").Error
+	})
+}
+
+// FailBatchActivity marks a batch that could not be reserved FAILED together with all
+// of its items. Nothing has moved, so there is nothing to return.
+func (a *Activities) FailBatchActivity(ctx context.Context, batchID, reason string) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var batch models.TransferBatch
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", batchID).First(&batch).Error; err != nil {
+			return err
+		}
+		if batch.Status != "PENDING" {
+			return nil
+		}
+		if err := failPendingBatchItems(tx, batch.ID, reason); err != nil {
+			return err
+		}
+		batch.Status, batch.FailureReason = "FAILED", reason
+		if err := tx.Model(&batch).Updates(map[string]interface{}{
+			"status":         batch.Status,
+			"failure_reason": batch.FailureReason,
+		}).Error; err != nil {
+			return err
+		}
+		return events.BatchSettled(tx, &batch)
+	})
+}
+
+// ListBatchItemsActivity returns up to limit items of a batch after seq afterSeq whose
+// transactions are still PENDING or held for review by ScreenBatchRiskActivity or
+// ScreenBatchActivity.
+func (a *Activities) ListBatchItemsActivity(ctx context.Context, batchID string, afterSeq, limit int) ([]BatchItemParams, error) {
+	return a.listBatchItems(ctx, batchID, afterSeq, limit, "PENDING", "AWAITING_APPROVAL")
+}
+
+// ListPaidBatchItemsActivity returns up to limit items of a batch after seq afterSeq whose
+// transactions COMPLETED, for an aborted batch to claw back.
+func (a *Activities) ListPaidBatchItemsActivity(ctx context.Context, batchID string, afterSeq, limit int) ([]BatchItemParams, error) {
+	return a.listBatchItems(ctx, batchID, afterSeq, limit, "COMPLETED")
+}
+
+// listBatchItems returns up to limit items of a batch after seq afterSeq whose
+// transactions are in one of statuses, in seq order.
+func (a *Activities) listBatchItems(ctx context.Context, batchID string, afterSeq, limit int, statuses ...string) ([]BatchItemParams, error) {
+	var batch models.TransferBatch
+	if err := a.DB.WithContext(ctx).Where("id = ?", batchID).First(&batch).Error; err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		models.BatchItem `gorm:"embedded"`
+		WorkflowID       string
+		Status           string
+		InitiatorID      string
+	}
+	err := a.DB.WithContext(ctx).
+		Table("batch_items AS i").
+		Select("i.*, t.workflow_id, t.status, t.initiator_id").
+		Joins("JOIN transactions t ON t.id = i.transaction_id").
+		Where("i.batch_id = ? AND i.seq > ? AND t.status IN ?", batchID, afterSeq, statuses).
+		Order("i.seq").
+		Limit(limit).
+		Scan(&rows).Error
 	if err != nil {
-		slog.Error("failed to marshal event", "error", err)
+		return nil, err
+	}
+
+	items := make([]BatchItemParams, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, BatchItemParams{
+			Seq:        r.Seq,
+			WorkflowID: r.WorkflowID,
+			Params: TransferParams{
+				TransactionID:    r.TransactionID,
+				SenderID:         batch.SenderID,
+				RecipientID:      r.RecipientID,
+				Amount:           r.Amount,
+				Currency:         batch.Currency,
+				FeeAmount:        r.FeeAmount,
+				RequiresApproval: r.Status == "AWAITING_APPROVAL",
+				InitiatorID:      r.InitiatorID,
+			},
+		})
+	}
+	return items, nil
+}
+
+// ReverseBatchItemActivity claws a paid batch item back from its recipient into transit
+// when an ALL_OR_NOTHING batch is aborted, and marks its transaction REVERSED. As a
+// compensation it ignores the recipient's account status.
+func (a *Activities) ReverseBatchItemActivity(ctx context.Context, p TransferParams) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tr models.Transaction
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", p.TransactionID).First(&tr).Error; err != nil {
+			return err
+		}
+		if tr.Status != "COMPLETED" {
+			return nil
+		}
+
+		transit, err := ledger.SystemAccount(tx, ledger.PurposeTransit, tr.Currency)
+		if err != nil {
+			return err
+		}
+		if _, err := ledger.Post(tx, tr.ID, "batch item reversal", ledger.Transfer(tr.RecipientID, transit.ID, tr.Currency, tr.Amount)...); err != nil {
+			return err
+		}
+		tr.Status, tr.RefundedAmount, tr.FailureReason = "REVERSED", tr.Amount, "batch aborted"
+		if err := tx.Model(&tr).Updates(map[string]interface{}{
+			"status":          tr.Status,
+			"refunded_amount": tr.RefundedAmount,
+			"failure_reason":  tr.FailureReason,
+		}).Error; err != nil {
+			return err
+		}
+		return events.TransferReversed(tx, &tr, nil, tr.Amount, tr.FailureReason)
+	})
+}
+
+// SettleBatchActivity finishes a PROCESSING batch: items that never ran are marked FAILED
+// with reason, everything reserved for items that did not complete, fees included, goes
+// back to the sender, and the batch status reflects how many items were paid.
+func (a *Activities) SettleBatchActivity(ctx context.Context, batchID, reason string) (*models.TransferBatch, error) {
+	var batch models.TransferBatch
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", batchID).First(&batch).Error; err != nil {
+			return err
+		}
+		if batch.Status != "PROCESSING" {
+			return nil
+		}
+		if err := failPendingBatchItems(tx, batch.ID, reason); err != nil {
+			return err
+		}
+
+		var unpaid struct {
+			Amount    int64
+			Fee       int64
+			Completed int
+		}
+		if err := tx.Table("batch_items AS i").
+			Select("COALESCE(SUM(CASE WHEN t.status <> ? THEN i.amount ELSE 0 END), 0) AS amount, "+
+				"COALESCE(SUM(CASE WHEN t.status <> ? THEN i.fee_amount ELSE 0 END), 0) AS fee, "+
+				"COUNT(*) FILTER (WHERE t.status = ?) AS completed", "COMPLETED", "COMPLETED", "COMPLETED").
+			Joins("JOIN transactions t ON t.id = i.transaction_id").
+			Where("i.batch_id = ?", batch.ID).
+			Scan(&unpaid).Error; err != nil {
+			return err
+		}
+
+		var lines []ledger.Line
+		if unpaid.Amount > 0 {
+			transit, err := ledger.SystemAccount(tx, ledger.PurposeTransit, batch.Currency)
+			if err != nil {
+				return err
+			}
+			lines = append(lines, ledger.Transfer(transit.ID, batch.SenderID, batch.Currency, unpaid.Amount)...)
+		}
+		if unpaid.Fee > 0 {
+			fees, err := ledger.SystemAccount(tx, ledger.PurposeFees, batch.Currency)
+			if err != nil {
+				return err
+			}
+			lines = append(lines, ledger.Transfer(fees.ID, batch.SenderID, batch.Currency, unpaid.Fee)...)
+		}
+		if len(lines) > 0 {
+			if _, err := ledger.Post(tx, batch.ID, "batch settlement refund", lines...); err != nil {
+				return err
+			}
+		}
+		if unpaid.Amount > 0 {
+			// only what was paid out stays counted against the sender's limits
+			if err := limits.Release(tx, batch.ID); err != nil {
+				return err
+			}
+			if paid := batch.TotalAmount - unpaid.Amount; paid > 0 {
+				var sender models.Account
+				if err := tx.Where("id = ?", batch.SenderID).First(&sender).Error; err != nil {
+					return err
+				}
+				if err := limits.Record(tx, &sender, paid, batch.ID); err != nil {
+					return err
+				}
+			}
+		}
+
+		status := "PARTIALLY_COMPLETED"
+		switch unpaid.Completed {
+		case batch.ItemCount:
+			status = "COMPLETED"
+		case 0:
+			status = "FAILED"
+		}
+		batch.Status = status
+		if status != "COMPLETED" {
+			batch.FailureReason = reason
+		}
+		if err := tx.Model(&batch).Updates(map[string]interface{}{
+			"status":         batch.Status,
+			"failure_reason": batch.FailureReason,
+		}).Error; err != nil {
+			return err
+		}
+		return events.BatchSettled(tx, &batch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
+
+// failPendingBatchItems marks the transactions of a batch's items that are still PENDING or
+// awaiting review FAILED.
+func failPendingBatchItems(tx *gorm.DB, batchID, reason string) error {
+	return tx.Model(&models.Transaction{}).
+		Where("id IN (?) AND status IN ?", tx.Model(&models.BatchItem{}).Select("transaction_id").Where("batch_id = ?", batchID), []string{"PENDING", "AWAITING_APPROVAL"}).
+		Updates(map[string]interface{}{
+			"status":         "FAILED",
+			"failure_reason": reason,
+		}).Error
+}
+
+// ListOverdrawnAccountsActivity returns the IDs, after afterID, of up to limit accounts
+// with a negative balance and an overdraft rate, in ID order.
+func (a *Activities) ListOverdrawnAccountsActivity(ctx context.Context, afterID string, limit int) ([]string, error) {
+	q := a.DB.WithContext(ctx).Model(&models.Account{}).
+		Where("balance < 0 AND overdraft_rate_bps > 0")
+	if afterID != "" {
+		q = q.Where("id > ?", afterID)
+	}
+	var ids []string
+	if err := q.Order("id").Limit(limit).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// AccrueOverdraftInterestActivity charges an overdrawn account one day of interest on its
+// negative balance and records it as the OverdraftAccrual of day. An account already
+// accrued for day, or no longer overdrawn, is left alone. Interest is owed under the
+// account agreement, so it is posted whatever the account's status and may take the
+// balance past the overdraft limit.
+func (a *Activities) AccrueOverdraftInterestActivity(ctx context.Context, accountID, day string) error {
+	return a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var acc models.Account
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", accountID).First(&acc).Error; err != nil {
+			return err
+		}
+		var accrued int64
+		if err := tx.Model(&models.OverdraftAccrual{}).Where("account_id = ? AND day = ?", acc.ID, day).Count(&accrued).Error; err != nil {
+			return err
+		}
+		if accrued > 0 {
+			return nil
+		}
+
+		interest := ledger.DailyOverdraftInterest(acc.Balance, acc.OverdraftRateBps)
+		if interest > 0 {
+			revenue, err := ledger.SystemAccount(tx, ledger.PurposeOverdraftInterest, acc.Currency)
+			if err != nil {
+				return err
+			}
+			ref := fmt.Sprintf("overdraft-interest:%s:%s", acc.ID, day)
+			if _, err := ledger.Post(tx, ref, "overdraft interest", ledger.Transfer(acc.ID, revenue.ID, acc.Currency, interest)...); err != nil {
+				return err
+			}
+		}
+		return tx.Create(&models.OverdraftAccrual{
+			AccountID: acc.ID,
+			Day:       day,
+			Balance:   acc.Balance,
+			RateBps:   acc.OverdraftRateBps,
+			Amount:    interest,
+		}).Error
+	})
+}
+
+// DeliverWebhookActivity sends a PENDING webhook delivery once and logs the attempt.
+// It fails, to be retried, unless the endpoint answered 2xx; a delivery whose endpoint
+// has been disabled fails without retry.
+func (a *Activities) DeliverWebhookActivity(ctx context.Context, deliveryID string) error {
+	var delivery models.WebhookDelivery
+	if err := a.DB.WithContext(ctx).Where("id = ?", deliveryID).First(&delivery).Error; err != nil {
+		return err
+	}
+	if delivery.Status != "PENDING" {
+		return nil
+	}
+	var endpoint models.WebhookEndpoint
+	if err := a.DB.WithContext(ctx).Where("id = ?", delivery.EndpointID).First(&endpoint).Error; err != nil {
 		return err
 	}
+	if endpoint.Status != "ACTIVE" {
+		return temporal.NewNonRetryableApplicationError("webhook endpoint is disabled", "EndpointDisabled", nil)
+	}
 
-	if err := b.PublishTransactionEvent(ctx, event); err != nil {
-		slog.Error("failed to publish event", "error", err)
+	res, sendErr := a.Webhooks.Send(ctx, webhook.Request{
+		URL:        endpoint.URL,
+		Secret:     endpoint.Secret,
+		DeliveryID: delivery.ID,
+		EventType:  delivery.EventType,
+		Payload:    delivery.Payload,
+	})
+	attempt := models.WebhookAttempt{
+		DeliveryID: delivery.ID,
+		StatusCode: res.StatusCode,
+		DurationMs: res.Duration.Milliseconds(),
+	}
+	updates := map[string]interface{}{
+		"attempts":         gorm.Expr("attempts + 1"),
+		"last_status_code": res.StatusCode,
+		"last_error":       "",
+	}
+	if sendErr != nil {
+		attempt.Error = sendErr.Error()
+		updates["last_error"] = attempt.Error
+	} else {
+		updates["status"] = "DELIVERED"
+		updates["delivered_at"] = time.Now()
+	}
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&attempt).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.WebhookDelivery{}).Where("id = ?", delivery.ID).Updates(updates).Error
+	})
+	if err != nil {
 		return err
 	}
+	return sendErr
+}
 
-	slog.Info("published event from activity", "topic", a.Topic, "payload", string(bts))
-	return nil
+// FailWebhookDeliveryActivity marks a delivery that ran out of attempts FAILED.
+func (a *Activities) FailWebhookDeliveryActivity(ctx context.Context, deliveryID, reason string) error {
+	return a.DB.WithContext(ctx).Model(&models.WebhookDelivery{}).
+		Where("id = ? AND status = ?", deliveryID, "PENDING").
+		Updates(map[string]interface{}{
+			"status":     "FAILED",
+			"last_error": reason,
+		}).Error
 }
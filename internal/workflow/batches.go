@@ -0,0 +1,218 @@
+package workflow
+
+import (
+	"time"
+
+	"FinTechPorto/internal/models"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// batchPageSize is how many items a run of BatchTransferWorkflow pays or claws back
+// before it continues as new.
+const batchPageSize = 500
+
+// BatchParams defines parameters for a batch transfer.
+type BatchParams struct {
+	BatchID string
+	// Mode is ALL_OR_NOTHING or BEST_EFFORT.
+	Mode string
+	// Concurrency bounds how many item workflows run at once.
+	Concurrency int
+	// AfterSeq is the seq of the last item an earlier run handled before it continued as
+	// new. The batch was screened and reserved by the first run.
+	AfterSeq int
+	// Aborted is set once an ALL_OR_NOTHING batch stopped after a failed item; AfterSeq
+	// then tracks how far the paid items have been clawed back.
+	Aborted bool
+}
+
+// BatchItemParams is one item of a batch as returned by ListBatchItemsActivity and
+// ListPaidBatchItemsActivity.
+type BatchItemParams struct {
+	Seq        int
+	WorkflowID string
+	Params     TransferParams
+}
+
+// BatchTransferWorkflow screens every item of the batch, reserves the batch total
+// from the sender, pays each item through a child BatchItemWorkflow with at most
+// params.Concurrency running at once, and settles the batch. In ALL_OR_NOTHING mode the
+// first failed or rejected item stops the batch and every item already paid is clawed
+// back before the reservation is returned. Each run pays or claws back one page of
+// items and continues as new, so the history stays bounded however large the batch.
+func BatchTransferWorkflow(ctx workflow.Context, params BatchParams) error {
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions())
+	if params.Concurrency <= 0 {
+		params.Concurrency = 1
+	}
+
+	if params.Aborted {
+		var page []BatchItemParams
+		if err := workflow.ExecuteActivity(ctx, "ListPaidBatchItemsActivity", params.BatchID, params.AfterSeq, batchPageSize).Get(ctx, &page); err != nil {
+			return err
+		}
+		if err := clawBackBatch(ctx, page); err != nil {
+			return err
+		}
+		if len(page) == batchPageSize {
+			params.AfterSeq = page[len(page)-1].Seq
+			return workflow.NewContinueAsNewError(ctx, BatchTransferWorkflow, params)
+		}
+		return workflow.ExecuteActivity(ctx, "SettleBatchActivity", params.BatchID, "batch aborted after a failed item").Get(ctx, nil)
+	}
+
+	if params.AfterSeq == 0 {
+		if err := reserveBatch(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	var page []BatchItemParams
+	if err := workflow.ExecuteActivity(ctx, "ListBatchItemsActivity", params.BatchID, params.AfterSeq, batchPageSize).Get(ctx, &page); err != nil {
+		return err
+	}
+	if payBatchPage(ctx, params, page) {
+		// claw back from the first item; the items left unpaid are failed on settlement
+		params.Aborted, params.AfterSeq = true, 0
+		return workflow.NewContinueAsNewError(ctx, BatchTransferWorkflow, params)
+	}
+	if len(page) == batchPageSize {
+		params.AfterSeq = page[len(page)-1].Seq
+		return workflow.NewContinueAsNewError(ctx, BatchTransferWorkflow, params)
+	}
+	return workflow.ExecuteActivity(ctx, "SettleBatchActivity", params.BatchID, "batch item was not processed").Get(ctx, nil)
+}
+
+// reserveBatch screens the items of a batch and reserves its total from the sender. A
+// batch that cannot be reserved is marked FAILED with all of its items.
+func reserveBatch(ctx workflow.Context, params BatchParams) error {
+	// Items the risk rules deny fail before anything is reserved; in ALL_OR_NOTHING mode
+	// they fail the batch. Items held by the risk rules or with sanctions hits are held for
+	// review by their own item workflow.
+	var screened BatchScreening
+	err := workflow.ExecuteActivity(ctx, "ScreenBatchRiskActivity", params.BatchID).Get(ctx, &screened)
+	if err == nil && screened.Denied > 0 && params.Mode == "ALL_OR_NOTHING" {
+		err = temporal.NewNonRetryableApplicationError("batch items denied by risk screening", "RiskDenied", nil)
+	}
+	if err == nil {
+		if screened.Review > 0 {
+			workflow.GetLogger(ctx).Warn("batch items held for risk review", "batch_id", params.BatchID, "items", screened.Review)
+		}
+		var held int
+		err = workflow.ExecuteActivity(ctx, "ScreenBatchActivity", params.BatchID).Get(ctx, &held)
+		if err == nil && held > 0 {
+			workflow.GetLogger(ctx).Warn("batch items held for sanctions review", "batch_id", params.BatchID, "items", held)
+		}
+	}
+	if err == nil {
+		err = workflow.ExecuteActivity(ctx, "ReserveBatchActivity", params.BatchID).Get(ctx, nil)
+	}
+	if err != nil {
+		cctx, _ := workflow.NewDisconnectedContext(ctx)
+		if ferr := workflow.ExecuteActivity(cctx, "FailBatchActivity", params.BatchID, failureReason(err)).Get(cctx, nil); ferr != nil {
+			workflow.GetLogger(ctx).Error("failed to record failed batch", "batch_id", params.BatchID, "error", ferr)
+		}
+		return err
+	}
+	return nil
+}
+
+// payBatchPage pays the items of page through child BatchItemWorkflows with at most
+// params.Concurrency running at once, and waits for all of them. It reports whether an
+// ALL_OR_NOTHING batch must be aborted, in which case no further item is started.
+func payBatchPage(ctx workflow.Context, params BatchParams, page []BatchItemParams) bool {
+	var (
+		aborted  bool
+		inflight int
+		selector = workflow.NewSelector(ctx)
+	)
+	for _, item := range page {
+		for inflight >= params.Concurrency {
+			selector.Select(ctx)
+		}
+		if aborted {
+			break
+		}
+
+		// Children outlive a terminated parent so an item is never cut off mid-way.
+		cctx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID:        item.WorkflowID,
+			ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_ABANDON,
+		})
+		inflight++
+		selector.AddFuture(workflow.ExecuteChildWorkflow(cctx, BatchItemWorkflow, item.Params), func(f workflow.Future) {
+			inflight--
+			if err := f.Get(ctx, nil); err != nil && params.Mode == "ALL_OR_NOTHING" {
+				aborted = true
+			}
+		})
+	}
+	for inflight > 0 {
+		selector.Select(ctx)
+	}
+	return aborted
+}
+
+// BatchItemWorkflow pays one batch item. The sender was debited when the batch was
+// reserved, so only the credit leg runs; a failed item stays in transit until the batch
+// settles. An item held by ScreenBatchRiskActivity or ScreenBatchActivity is paid only once
+// an approver releases it.
+func BatchItemWorkflow(ctx workflow.Context, params TransferParams) error {
+	state, err := trackTransferState(ctx, params)
+	if err != nil {
+		return err
+	}
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions())
+
+	if params.RequiresApproval {
+		if params.ApprovalTimeout <= 0 {
+			params.ApprovalTimeout = defaultReviewTimeout
+		}
+		approved, err := awaitApproval(ctx, params, state)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			// the batch refunds the item on settlement like any other unpaid item
+			return temporal.NewNonRetryableApplicationError("batch item rejected: "+state.FailureReason, "Rejected", nil)
+		}
+	}
+
+	state.Step = "credit"
+	var tr models.Transaction
+	if err := workflow.ExecuteActivity(ctx, "CreditAccountActivity", params).Get(ctx, &tr); err != nil {
+		recordFailure(ctx, params, state, err)
+		return err
+	}
+	state.Status = tr.Status
+	state.Step = "done"
+	return nil
+}
+
+// clawBackBatch reverses paid items of an aborted batch. Like compensateDebit it runs on a
+// disconnected context and retries until every reversal succeeds.
+func clawBackBatch(ctx workflow.Context, paid []BatchItemParams) error {
+	cctx, _ := workflow.NewDisconnectedContext(ctx)
+	cctx = workflow.WithActivityOptions(cctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+		},
+	})
+
+	futures := make([]workflow.Future, 0, len(paid))
+	for _, item := range paid {
+		futures = append(futures, workflow.ExecuteActivity(cctx, "ReverseBatchItemActivity", item.Params))
+	}
+	for _, f := range futures {
+		if err := f.Get(cctx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package workflow
+
+import (
+	"time"
+
+	"FinTechPorto/internal/models"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// HoldExpiryParams identifies a hold and when it expires.
+type HoldExpiryParams struct {
+	HoldID    string
+	ExpiresAt time.Time
+}
+
+// HoldExpiryWorkflow sleeps until a hold expires and then releases it if it is still active.
+func HoldExpiryWorkflow(ctx workflow.Context, params HoldExpiryParams) error {
+	if d := params.ExpiresAt.Sub(workflow.Now(ctx)); d > 0 {
+		if err := workflow.Sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+		},
+	})
+	return workflow.ExecuteActivity(ctx, "ExpireHoldActivity", params.HoldID).Get(ctx, nil)
+}
+
+// CaptureParams identifies a hold capture held for review and the capture transaction
+// awaiting approval.
+type CaptureParams struct {
+	HoldID   string
+	Transfer TransferParams
+}
+
+// CaptureReviewWorkflow waits for an approver to release or reject a hold capture held by
+// sanctions screening or the approval threshold. An approved capture is completed; a rejected or failed one gives
+// the hold back to its holder, who may capture it again, void it or let it expire.
+func CaptureReviewWorkflow(ctx workflow.Context, params CaptureParams) error {
+	p := params.Transfer
+	state, err := trackTransferState(ctx, p)
+	if err != nil {
+		return err
+	}
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions())
+	if p.ApprovalTimeout <= 0 {
+		p.ApprovalTimeout = defaultReviewTimeout
+	}
+
+	approved, err := awaitApproval(ctx, p, state)
+	if err != nil {
+		return err
+	}
+	if approved {
+		state.Step = "capture"
+		var tr models.Transaction
+		err = workflow.ExecuteActivity(ctx, "CompleteCaptureActivity", params.HoldID, p.TransactionID).Get(ctx, &tr)
+		if err == nil {
+			state.Status = tr.Status
+			state.Step = "done"
+			return nil
+		}
+		recordFailure(ctx, p, state, err)
+	}
+
+	cctx, _ := workflow.NewDisconnectedContext(ctx)
+	if rerr := workflow.ExecuteActivity(cctx, "ReleaseCaptureActivity", params.HoldID, p.TransactionID).Get(cctx, nil); rerr != nil {
+		return rerr
+	}
+	return err
+}
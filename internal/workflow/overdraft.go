@@ -0,0 +1,49 @@
+package workflow
+
+import (
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// OverdraftAccrualScheduleID is the Temporal schedule that starts OverdraftAccrualWorkflow daily.
+	OverdraftAccrualScheduleID = "overdraft-accrual"
+	// overdraftPageSize is how many accounts OverdraftAccrualWorkflow accrues per run
+	// before continuing as new.
+	overdraftPageSize = 500
+)
+
+// OverdraftAccrualParams defines parameters for an overdraft accrual run. Day is the UTC
+// date accrued as YYYY-MM-DD and defaults to the day the run starts; AfterID is the last
+// account accrued by the previous page.
+type OverdraftAccrualParams struct {
+	Day     string
+	AfterID string
+}
+
+// OverdraftAccrualWorkflow charges one day of interest to every overdrawn account with an
+// overdraft rate. Accounts are paged by ID and the workflow continues as new after each
+// page so its history stays small however many accounts there are. Accruals are keyed by
+// account and day, so re-running a day charges nothing twice.
+func OverdraftAccrualWorkflow(ctx workflow.Context, params OverdraftAccrualParams) error {
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions())
+	if params.Day == "" {
+		params.Day = workflow.Now(ctx).UTC().Format("2006-01-02")
+	}
+
+	var accountIDs []string
+	if err := workflow.ExecuteActivity(ctx, "ListOverdrawnAccountsActivity", params.AfterID, overdraftPageSize).Get(ctx, &accountIDs); err != nil {
+		return err
+	}
+	for _, id := range accountIDs {
+		if err := workflow.ExecuteActivity(ctx, "AccrueOverdraftInterestActivity", id, params.Day).Get(ctx, nil); err != nil {
+			// one failing account must not hold back interest on the others
+			workflow.GetLogger(ctx).Error("failed to accrue overdraft interest", "account_id", id, "day", params.Day, "error", err)
+		}
+	}
+	if len(accountIDs) < overdraftPageSize {
+		return nil
+	}
+
+	params.AfterID = accountIDs[len(accountIDs)-1]
+	return workflow.NewContinueAsNewError(ctx, OverdraftAccrualWorkflow, params)
+}
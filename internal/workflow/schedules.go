@@ -0,0 +1,97 @@
+package workflow
+
+import (
+	"errors"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// scheduleRetryInterval is how long a RETRY run waits after insufficient funds.
+	scheduleRetryInterval = time.Hour
+	// scheduleMaxAttempts bounds the attempts of a RETRY run.
+	scheduleMaxAttempts = 6
+)
+
+// ScheduleRunStart is the run recorded by BeginScheduleRunActivity. RunID is empty when
+// the schedule is no longer active.
+type ScheduleRunStart struct {
+	RunID               string
+	OnInsufficientFunds string
+}
+
+// ScheduleAttempt is one attempt of a run: the transfer to execute and its workflow ID.
+type ScheduleAttempt struct {
+	WorkflowID string
+	Params     TransferParams
+}
+
+// ScheduledTransferWorkflow is started by a Temporal schedule for every firing of a
+// TransferSchedule. It runs the transfer as a child TransferWorkflow and records the
+// outcome as a ScheduleRun. When the sender cannot cover the transfer the run is
+// skipped, or retried hourly when the schedule's policy is RETRY.
+func ScheduledTransferWorkflow(ctx workflow.Context, scheduleID string) error {
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions())
+
+	var start ScheduleRunStart
+	if err := workflow.ExecuteActivity(ctx, "BeginScheduleRunActivity", scheduleID).Get(ctx, &start); err != nil {
+		return err
+	}
+	if start.RunID == "" {
+		workflow.GetLogger(ctx).Info("schedule is no longer active; skipping run", "schedule_id", scheduleID)
+		return nil
+	}
+
+	for attempt := 1; ; attempt++ {
+		var next ScheduleAttempt
+		if err := workflow.ExecuteActivity(ctx, "StartScheduleAttemptActivity", start.RunID, attempt).Get(ctx, &next); err != nil {
+			finishScheduleRun(ctx, start.RunID, "FAILED", failureReason(err))
+			return err
+		}
+
+		// The child outlives a terminated parent so a transfer is never cut off mid-way.
+		cctx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID:        next.WorkflowID,
+			ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_ABANDON,
+		})
+		err := workflow.ExecuteChildWorkflow(cctx, TransferWorkflow, next.Params).Get(cctx, nil)
+		if err == nil {
+			finishScheduleRun(ctx, start.RunID, "COMPLETED", "")
+			return nil
+		}
+		if !isInsufficientFunds(err) {
+			finishScheduleRun(ctx, start.RunID, "FAILED", failureReason(err))
+			return err
+		}
+		if start.OnInsufficientFunds != "RETRY" {
+			finishScheduleRun(ctx, start.RunID, "SKIPPED", failureReason(err))
+			return nil
+		}
+		if attempt >= scheduleMaxAttempts {
+			finishScheduleRun(ctx, start.RunID, "FAILED", failureReason(err))
+			return err
+		}
+		if err := workflow.Sleep(ctx, scheduleRetryInterval); err != nil {
+			finishScheduleRun(ctx, start.RunID, "FAILED", err.Error())
+			return err
+		}
+	}
+}
+
+// finishScheduleRun records the outcome of a run. Errors are logged rather than returned
+// so the transfer's own outcome is what the workflow reports.
+func finishScheduleRun(ctx workflow.Context, runID, status, reason string) {
+	cctx, _ := workflow.NewDisconnectedContext(ctx)
+	if err := workflow.ExecuteActivity(cctx, "FinishScheduleRunActivity", runID, status, reason).Get(cctx, nil); err != nil {
+		workflow.GetLogger(ctx).Error("failed to record schedule run", "run_id", runID, "error", err)
+	}
+}
+
+// isInsufficientFunds reports whether a transfer failed because the sender could not cover it.
+func isInsufficientFunds(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == "InsufficientFunds"
+}
@@ -0,0 +1,37 @@
+package workflow
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// webhookMaxAttempts bounds the sends of one delivery; with the backoff below the last
+// attempt is made about five hours after the first.
+const webhookMaxAttempts = 12
+
+// WebhookDeliveryWorkflow sends a webhook delivery, retrying failed sends with
+// exponential backoff from 30s up to an hour apart. A delivery still failing after
+// webhookMaxAttempts is marked FAILED and can be replayed by hand.
+func WebhookDeliveryWorkflow(ctx workflow.Context, deliveryID string) error {
+	sctx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    30 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Hour,
+			MaximumAttempts:    webhookMaxAttempts,
+		},
+	})
+	err := workflow.ExecuteActivity(sctx, "DeliverWebhookActivity", deliveryID).Get(sctx, nil)
+	if err == nil {
+		return nil
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions())
+	if ferr := workflow.ExecuteActivity(ctx, "FailWebhookDeliveryActivity", deliveryID, failureReason(err)).Get(ctx, nil); ferr != nil {
+		return ferr
+	}
+	return err
+}
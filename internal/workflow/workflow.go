@@ -1,49 +1,260 @@
 package workflow
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/risk"
 
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
 
-// TransferWorkflow orchestrates debit, credit, and publish activities.
+// QueryTransferState is the query type that returns a TransferWorkflow's TransferState.
+const QueryTransferState = "transfer-state"
+
+// SignalApprovalDecision is the signal that delivers an ApprovalDecision to a
+// TransferWorkflow waiting for approval.
+const SignalApprovalDecision = "approval-decision"
+
+// ApprovalDecision is an approver's verdict on a transfer awaiting approval.
+type ApprovalDecision struct {
+	Approved   bool
+	ApproverID string
+	Reason     string
+}
+
+// defaultReviewTimeout bounds the wait for a reviewer when the transfer itself carries
+// no ApprovalTimeout.
+const defaultReviewTimeout = 24 * time.Hour
+
+// TransferState is the progress of a TransferWorkflow as exposed by QueryTransferState.
+type TransferState struct {
+	TransactionID string
+	Status        string
+	Step          string
+	FailureReason string
+}
+
+// TransferWorkflow orchestrates screening, debit and credit activities; each activity
+// that settles the transfer records its event. A transfer denied by risk screening fails
+// before any money moves. Transfers that require approval, that risk screening sent to
+// review or that matched the sanctions list first wait for an ApprovalDecision and end
+// REJECTED without moving funds if it is negative or does not arrive in time.
 func TransferWorkflow(ctx workflow.Context, params TransferParams) error {
-	// Configure activity options
-	ao := workflow.ActivityOptions{
+	state, err := trackTransferState(ctx, params)
+	if err != nil {
+		return err
+	}
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions())
+
+	state.Step = "screen"
+	var decision models.RiskDecision
+	if err := workflow.ExecuteActivity(ctx, "ScreenTransferActivity", params).Get(ctx, &decision); err != nil {
+		recordFailure(ctx, params, state, err)
+		return err
+	}
+	switch decision.Outcome {
+	case risk.Deny:
+		err := temporal.NewNonRetryableApplicationError("denied by risk screening: "+decision.Hits[0].Reason, "RiskDenied", nil)
+		recordFailure(ctx, params, state, err)
+		return err
+	case risk.Review:
+		params.RequiresApproval = true
+	}
+
+	// A sanctions hit never fails the transfer on its own; compliance decides.
+	state.Step = "sanctions"
+	var hits []models.ScreeningHit
+	if err := workflow.ExecuteActivity(ctx, "ScreenSanctionsActivity", params).Get(ctx, &hits); err != nil {
+		recordFailure(ctx, params, state, err)
+		return err
+	}
+	if len(hits) > 0 {
+		workflow.GetLogger(ctx).Warn("transfer held for sanctions review", "transaction_id", params.TransactionID, "hits", len(hits))
+		params.RequiresApproval = true
+	}
+	if params.RequiresApproval && params.ApprovalTimeout <= 0 {
+		params.ApprovalTimeout = defaultReviewTimeout
+	}
+
+	if params.RequiresApproval {
+		approved, err := awaitApproval(ctx, params, state)
+		if err != nil || !approved {
+			return err
+		}
+	}
+
+	if _, err := moveFunds(ctx, params, state); err != nil {
+		return err
+	}
+
+	state.Step = "done"
+	return nil
+}
+
+// RefundWorkflow moves a refund or reversal back to the original sender with the same
+// debit and credit activities as a transfer, then settles it against the original.
+func RefundWorkflow(ctx workflow.Context, params TransferParams) error {
+	state, err := trackTransferState(ctx, params)
+	if err != nil {
+		return err
+	}
+	ctx = workflow.WithActivityOptions(ctx, defaultActivityOptions())
+
+	if _, err := moveFunds(ctx, params, state); err != nil {
+		return err
+	}
+
+	// Settle the refund on the original; it becomes REVERSED once fully refunded.
+	state.Step = "settle"
+	if err := workflow.ExecuteActivity(ctx, "SettleRefundActivity", params.OriginalTransactionID, params.TransactionID).Get(ctx, nil); err != nil {
+		return err
+	}
+
+	state.Step = "done"
+	return nil
+}
+
+// trackTransferState registers the QueryTransferState handler and returns the state it reports.
+func trackTransferState(ctx workflow.Context, params TransferParams) (*TransferState, error) {
+	state := &TransferState{TransactionID: params.TransactionID, Status: "PENDING", Step: "debit"}
+	if err := workflow.SetQueryHandler(ctx, QueryTransferState, func() (TransferState, error) {
+		return *state, nil
+	}); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// awaitApproval blocks until an approver other than the initiator decides on the
+// transfer or ApprovalTimeout passes, and records the outcome.
+func awaitApproval(ctx workflow.Context, params TransferParams, state *TransferState) (bool, error) {
+	state.Status = "AWAITING_APPROVAL"
+	state.Step = "approval"
+
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+	timer := workflow.NewTimer(timerCtx, params.ApprovalTimeout)
+	signals := workflow.GetSignalChannel(ctx, SignalApprovalDecision)
+
+	var decision ApprovalDecision
+	for {
+		timedOut := false
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(signals, func(c workflow.ReceiveChannel, more bool) {
+			c.Receive(ctx, &decision)
+		})
+		selector.AddFuture(timer, func(f workflow.Future) {
+			timedOut = true
+		})
+		selector.Select(ctx)
+
+		if timedOut {
+			decision = ApprovalDecision{Reason: "approval timed out"}
+			break
+		}
+		// The handler already refuses self-approval; this guards direct signals.
+		if decision.ApproverID == "" || decision.ApproverID == params.InitiatorID {
+			workflow.GetLogger(ctx).Warn("ignoring approval decision from initiator", "approver_id", decision.ApproverID)
+			continue
+		}
+		break
+	}
+
+	if decision.Approved {
+		if err := workflow.ExecuteActivity(ctx, "RecordApprovalActivity", params, decision.ApproverID).Get(ctx, nil); err != nil {
+			return false, err
+		}
+		state.Status = "PENDING"
+		return true, nil
+	}
+
+	reason := decision.Reason
+	if reason == "" {
+		reason = "rejected by approver"
+	}
+	if err := workflow.ExecuteActivity(ctx, "RecordRejectionActivity", params, decision.ApproverID, reason).Get(ctx, nil); err != nil {
+		return false, err
+	}
+	state.Status = "REJECTED"
+	state.FailureReason = reason
+	state.Step = "done"
+	return false, nil
+}
+
+// defaultActivityOptions are the options used for the transfer activities.
+func defaultActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
 		StartToCloseTimeout: time.Minute,
 		RetryPolicy: &temporal.RetryPolicy{
 			MaximumAttempts: 3,
 		},
 	}
-	ctx = workflow.WithActivityOptions(ctx, ao)
+}
 
+// moveFunds runs the debit and credit legs of params. If the debit fails nothing has
+// moved, so only the failure is recorded; if the credit fails the debit is refunded first.
+func moveFunds(ctx workflow.Context, params TransferParams, state *TransferState) (*models.Transaction, error) {
 	// Execute Debit
+	state.Step = "debit"
 	if err := workflow.ExecuteActivity(ctx, "DebitAccountActivity", params).Get(ctx, nil); err != nil {
-		return err
+		recordFailure(ctx, params, state, err)
+		return nil, err
 	}
 
 	// Execute Credit and retrieve transaction
+	state.Step = "credit"
 	var tr models.Transaction
 	if err := workflow.ExecuteActivity(ctx, "CreditAccountActivity", params).Get(ctx, &tr); err != nil {
-		return err
+		// The sender has already been debited; return the funds before failing.
+		state.Step = "refund"
+		if cerr := compensateDebit(ctx, params); cerr != nil {
+			return nil, fmt.Errorf("credit failed: %w; refund of debit failed: %v", err, cerr)
+		}
+		recordFailure(ctx, params, state, err)
+		return nil, err
 	}
+	state.Status = tr.Status
+	return &tr, nil
+}
 
-	// Prepare event
-	event := map[string]interface{}{
-		"transaction_id": tr.ID,
-		"sender_id":      tr.SenderID,
-		"recipient_id":   tr.RecipientID,
-		"amount":         tr.Amount,
-		"status":         tr.Status,
-	}
+// compensateDebit refunds the sender after a failed credit. It runs on a disconnected
+// context so cancelling the workflow cannot strand funds in transit, and retries
+// until the refund succeeds.
+func compensateDebit(ctx workflow.Context, params TransferParams) error {
+	cctx, _ := workflow.NewDisconnectedContext(ctx)
+	cctx = workflow.WithActivityOptions(cctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+		},
+	})
+	return workflow.ExecuteActivity(cctx, "RefundDebitActivity", params).Get(cctx, nil)
+}
 
-	// Publish
-	if err := workflow.ExecuteActivity(ctx, "PublishKafkaEventActivity", event).Get(ctx, nil); err != nil {
-		return err
+// recordFailure marks the transaction FAILED. Errors are logged rather than returned
+// so the original failure is what the workflow reports.
+func recordFailure(ctx workflow.Context, params TransferParams, state *TransferState, cause error) {
+	state.Status = "FAILED"
+	state.FailureReason = failureReason(cause)
+	state.Step = "done"
+
+	cctx, _ := workflow.NewDisconnectedContext(ctx)
+	if err := workflow.ExecuteActivity(cctx, "RecordFailedTransferActivity", params, state.FailureReason).Get(cctx, nil); err != nil {
+		workflow.GetLogger(ctx).Error("failed to record failed transfer", "error", err)
 	}
+}
 
-	return nil
+// failureReason extracts the application error message from an activity failure.
+func failureReason(err error) string {
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) {
+		return appErr.Error()
+	}
+	return err.Error()
 }
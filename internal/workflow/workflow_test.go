@@ -0,0 +1,207 @@
+package workflow
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/risk"
+
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+// newTestEnv returns a test environment with the activities registered under their
+// names. Every activity a test expects to run must be mocked.
+func newTestEnv(t *testing.T) *testsuite.TestWorkflowEnvironment {
+	t.Helper()
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterActivity(&Activities{})
+	return env
+}
+
+// onScreening mocks both screenings to let the transfer through.
+func onScreening(env *testsuite.TestWorkflowEnvironment) {
+	env.OnActivity("ScreenTransferActivity", mock.Anything, mock.Anything).
+		Return(&models.RiskDecision{Outcome: risk.Allow}, nil)
+	env.OnActivity("ScreenSanctionsActivity", mock.Anything, mock.Anything).
+		Return([]models.ScreeningHit(nil), nil)
+}
+
+func testTransfer() TransferParams {
+	return TransferParams{
+		TransactionID: "tx-1",
+		SenderID:      "sender",
+		RecipientID:   "recipient",
+		Amount:        10_000,
+		Currency:      "USD",
+		FeeAmount:     50,
+		InitiatorID:   "initiator",
+	}
+}
+
+func queryState(t *testing.T, env *testsuite.TestWorkflowEnvironment) TransferState {
+	t.Helper()
+	v, err := env.QueryWorkflow(QueryTransferState)
+	if err != nil {
+		t.Fatalf("QueryWorkflow() error = %v", err)
+	}
+	var state TransferState
+	if err := v.Get(&state); err != nil {
+		t.Fatalf("failed to decode transfer state: %v", err)
+	}
+	return state
+}
+
+func TestTransferWorkflowRefundsDebitWhenCreditFails(t *testing.T) {
+	env := newTestEnv(t)
+	p := testTransfer()
+	onScreening(env)
+	env.OnActivity("DebitAccountActivity", mock.Anything, p).Return(nil).Once()
+	env.OnActivity("CreditAccountActivity", mock.Anything, p).
+		Return(nil, temporal.NewNonRetryableApplicationError("recipient account is frozen", "AccountBlocked", nil)).Once()
+	env.OnActivity("RefundDebitActivity", mock.Anything, p).Return(nil).Once()
+	env.OnActivity("RecordFailedTransferActivity", mock.Anything, p, mock.MatchedBy(func(reason string) bool {
+		return reason != ""
+	})).Return(nil).Once()
+
+	env.ExecuteWorkflow(TransferWorkflow, p)
+
+	if !env.IsWorkflowCompleted() {
+		t.Fatal("TransferWorkflow did not complete")
+	}
+	var appErr *temporal.ApplicationError
+	if err := env.GetWorkflowError(); !errors.As(err, &appErr) || appErr.Type() != "AccountBlocked" {
+		t.Errorf("TransferWorkflow() error = %v, want the AccountBlocked credit failure", err)
+	}
+	env.AssertExpectations(t)
+	if state := queryState(t, env); state.Status != "FAILED" || state.Step != "done" {
+		t.Errorf("state = %+v, want FAILED at step done", state)
+	}
+}
+
+func TestTransferWorkflowRetriesRefundUntilItSucceeds(t *testing.T) {
+	env := newTestEnv(t)
+	p := testTransfer()
+	onScreening(env)
+	env.OnActivity("DebitAccountActivity", mock.Anything, p).Return(nil).Once()
+	env.OnActivity("CreditAccountActivity", mock.Anything, p).
+		Return(nil, temporal.NewNonRetryableApplicationError("recipient not found", "RecipientNotFound", nil)).Once()
+	// the refund has no attempt limit, unlike the transfer activities
+	env.OnActivity("RefundDebitActivity", mock.Anything, p).Return(errors.New("database unavailable")).Times(5)
+	env.OnActivity("RefundDebitActivity", mock.Anything, p).Return(nil).Once()
+	env.OnActivity("RecordFailedTransferActivity", mock.Anything, p, mock.Anything).Return(nil).Once()
+
+	env.ExecuteWorkflow(TransferWorkflow, p)
+
+	if err := env.GetWorkflowError(); err == nil {
+		t.Error("TransferWorkflow() error = nil, want the credit failure")
+	}
+	env.AssertExpectations(t)
+}
+
+func TestTransferWorkflowDebitFailureMovesNothing(t *testing.T) {
+	env := newTestEnv(t)
+	p := testTransfer()
+	onScreening(env)
+	env.OnActivity("DebitAccountActivity", mock.Anything, p).
+		Return(temporal.NewNonRetryableApplicationError("insufficient funds", "InsufficientFunds", nil)).Once()
+	env.OnActivity("RecordFailedTransferActivity", mock.Anything, p, mock.Anything).Return(nil).Once()
+
+	env.ExecuteWorkflow(TransferWorkflow, p)
+
+	if err := env.GetWorkflowError(); err == nil {
+		t.Error("TransferWorkflow() error = nil, want the debit failure")
+	}
+	env.AssertExpectations(t)
+	env.AssertNotCalled(t, "CreditAccountActivity", mock.Anything, mock.Anything)
+	env.AssertNotCalled(t, "RefundDebitActivity", mock.Anything, mock.Anything)
+}
+
+func TestAwaitApproval(t *testing.T) {
+	const timeout = 2 * time.Hour
+	tests := []struct {
+		name      string
+		decisions []ApprovalDecision
+		// wantApprover is who the approval is recorded for; empty means it is rejected
+		// with wantReason.
+		wantApprover string
+		wantReason   string
+	}{
+		{
+			name:         "approved by another user",
+			decisions:    []ApprovalDecision{{Approved: true, ApproverID: "approver"}},
+			wantApprover: "approver",
+		},
+		{
+			name:       "rejected by another user",
+			decisions:  []ApprovalDecision{{ApproverID: "approver", Reason: "unknown payee"}},
+			wantReason: "unknown payee",
+		},
+		{
+			name:       "initiator approval is ignored until the timeout",
+			decisions:  []ApprovalDecision{{Approved: true, ApproverID: "initiator"}},
+			wantReason: "approval timed out",
+		},
+		{
+			name:       "anonymous approval is ignored until the timeout",
+			decisions:  []ApprovalDecision{{Approved: true}},
+			wantReason: "approval timed out",
+		},
+		{
+			name: "initiator approval does not hide a later decision",
+			decisions: []ApprovalDecision{
+				{Approved: true, ApproverID: "initiator"},
+				{Approved: true, ApproverID: "approver"},
+			},
+			wantApprover: "approver",
+		},
+		{
+			name:       "no decision times out",
+			wantReason: "approval timed out",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newTestEnv(t)
+			p := testTransfer()
+			p.RequiresApproval = true
+			p.ApprovalTimeout = timeout
+			onScreening(env)
+			for i, d := range tt.decisions {
+				env.RegisterDelayedCallback(func() {
+					env.SignalWorkflow(SignalApprovalDecision, d)
+				}, time.Duration(i+1)*time.Minute)
+			}
+			if tt.wantApprover != "" {
+				env.OnActivity("RecordApprovalActivity", mock.Anything, p, tt.wantApprover).Return(nil).Once()
+				env.OnActivity("DebitAccountActivity", mock.Anything, p).Return(nil).Once()
+				env.OnActivity("CreditAccountActivity", mock.Anything, p).Return(&models.Transaction{Status: "COMPLETED"}, nil).Once()
+			} else {
+				env.OnActivity("RecordRejectionActivity", mock.Anything, p, mock.Anything, tt.wantReason).Return(nil).Once()
+			}
+
+			env.ExecuteWorkflow(TransferWorkflow, p)
+
+			if err := env.GetWorkflowError(); err != nil {
+				t.Fatalf("TransferWorkflow() error = %v", err)
+			}
+			env.AssertExpectations(t)
+			state := queryState(t, env)
+			if tt.wantApprover != "" {
+				if state.Status != "COMPLETED" {
+					t.Errorf("state = %+v, want COMPLETED", state)
+				}
+				return
+			}
+			env.AssertNotCalled(t, "RecordApprovalActivity", mock.Anything, mock.Anything, mock.Anything)
+			env.AssertNotCalled(t, "DebitAccountActivity", mock.Anything, mock.Anything)
+			if state.Status != "REJECTED" || state.FailureReason != tt.wantReason {
+				t.Errorf("state = %+v, want REJECTED with reason %q", state, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/account/v1"
+	accountv1connect "FinTechPorto/gen/api/account/v1/accountv1connect"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"log/slog"
+
+	connectgo "github.com/bufbuild/connect-go"
+	"github.com/go-chi/chi/v5"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"FinTechPorto/internal/currency"
+	"FinTechPorto/internal/ledger"
+	"FinTechPorto/internal/limits"
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/tracecontext"
+	"FinTechPorto/services/account/repository"
+)
+
+// accountHandler implements accountv1connect.AccountServiceHandler
+type accountHandler struct {
+	repo *repository.Repository
+}
+
+// NewHandler creates a new accountHandler.
+func NewHandler(repo *repository.Repository) *accountHandler {
+	return &accountHandler{repo: repo}
+}
+
+func (s *accountHandler) CreateAccount(ctx context.Context, req *connectgo.Request[v1.CreateAccountRequest]) (*connectgo.Response[v1.CreateAccountResponse], error) {
+	slog.Info("CreateAccount called", "user_id", req.Msg.UserId, "currency", req.Msg.Currency, "holder_name", req.Msg.HolderName)
+
+	if req.Msg.UserId == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("user_id is required"))
+	}
+	if err := currency.Validate(req.Msg.Currency); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+
+	acc, err := s.repo.CreateAccount(ctx, req.Msg.UserId, req.Msg.Currency, strings.TrimSpace(req.Msg.HolderName))
+	if err != nil {
+		slog.Error("failed to create account", "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	return connectgo.NewResponse(&v1.CreateAccountResponse{Account: toProtoAccount(acc)}), nil
+}
+
+func (s *accountHandler) GetAccount(ctx context.Context, req *connectgo.Request[v1.GetAccountRequest]) (*connectgo.Response[v1.GetAccountResponse], error) {
+	acc, err := s.repo.GetAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connectgo.NewResponse(&v1.GetAccountResponse{Account: toProtoAccount(acc)}), nil
+}
+
+func (s *accountHandler) ListAccountsByUser(ctx context.Context, req *connectgo.Request[v1.ListAccountsByUserRequest]) (*connectgo.Response[v1.ListAccountsByUserResponse], error) {
+	if req.Msg.UserId == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("user_id is required"))
+	}
+
+	accounts, err := s.repo.ListAccountsByUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.ListAccountsByUserResponse{}
+	for i := range accounts {
+		resp.Accounts = append(resp.Accounts, toProtoAccount(&accounts[i]))
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *accountHandler) GetBalance(ctx context.Context, req *connectgo.Request[v1.GetBalanceRequest]) (*connectgo.Response[v1.GetBalanceResponse], error) {
+	acc, err := s.repo.GetAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.GetBalanceResponse{
+		AccountId:        acc.ID,
+		Balance:          acc.Balance,
+		Currency:         acc.Currency,
+		HeldAmount:       acc.HeldAmount,
+		AvailableBalance: ledger.AvailableBalance(acc),
+		OverdraftLimit:   acc.OverdraftLimit,
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *accountHandler) CloseAccount(ctx context.Context, req *connectgo.Request[v1.CloseAccountRequest]) (*connectgo.Response[v1.CloseAccountResponse], error) {
+	slog.Info("CloseAccount called", "account_id", req.Msg.AccountId)
+
+	acc, err := s.repo.CloseAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connectgo.NewResponse(&v1.CloseAccountResponse{Account: toProtoAccount(acc)}), nil
+}
+
+func (s *accountHandler) SetAccountStatus(ctx context.Context, req *connectgo.Request[v1.SetAccountStatusRequest]) (*connectgo.Response[v1.SetAccountStatusResponse], error) {
+	slog.Info("SetAccountStatus called",
+		"account_id", req.Msg.AccountId,
+		"status", req.Msg.Status,
+		"reason", req.Msg.Reason,
+		"changed_by", req.Msg.ChangedBy,
+	)
+
+	status := fromProtoStatus(req.Msg.Status)
+	if status == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("status is required"))
+	}
+	reason := strings.TrimSpace(req.Msg.Reason)
+	if reason == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("reason is required"))
+	}
+
+	acc, err := s.repo.SetAccountStatus(ctx, req.Msg.AccountId, status, reason, req.Msg.ChangedBy)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connectgo.NewResponse(&v1.SetAccountStatusResponse{Account: toProtoAccount(acc)}), nil
+}
+
+func (s *accountHandler) ListAccountStatusChanges(ctx context.Context, req *connectgo.Request[v1.ListAccountStatusChangesRequest]) (*connectgo.Response[v1.ListAccountStatusChangesResponse], error) {
+	changes, err := s.repo.ListStatusChanges(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.ListAccountStatusChangesResponse{}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, &v1.AccountStatusChange{
+			FromStatus: toProtoStatus(c.FromStatus),
+			ToStatus:   toProtoStatus(c.ToStatus),
+			Reason:     c.Reason,
+			ChangedBy:  c.ChangedBy,
+			ChangedAt:  timestamppb.New(c.CreatedAt),
+		})
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *accountHandler) SetTransferLimit(ctx context.Context, req *connectgo.Request[v1.SetTransferLimitRequest]) (*connectgo.Response[v1.SetTransferLimitResponse], error) {
+	slog.Info("SetTransferLimit called", "limit", req.Msg.Limit)
+
+	if req.Msg.Limit == nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("limit is required"))
+	}
+	if err := currency.Validate(req.Msg.Limit.Currency); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	l := fromProtoTransferLimit(req.Msg.Limit)
+	if err := s.repo.SetTransferLimit(ctx, l); err != nil {
+		return nil, toConnectError(err)
+	}
+	return connectgo.NewResponse(&v1.SetTransferLimitResponse{Limit: toProtoTransferLimit(l)}), nil
+}
+
+func (s *accountHandler) GetTransferLimits(ctx context.Context, req *connectgo.Request[v1.GetTransferLimitsRequest]) (*connectgo.Response[v1.GetTransferLimitsResponse], error) {
+	statuses, err := s.repo.GetTransferLimits(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.GetTransferLimitsResponse{}
+	for i := range statuses {
+		resp.Limits = append(resp.Limits, toProtoTransferLimitStatus(&statuses[i]))
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *accountHandler) SetAccountTier(ctx context.Context, req *connectgo.Request[v1.SetAccountTierRequest]) (*connectgo.Response[v1.SetAccountTierResponse], error) {
+	slog.Info("SetAccountTier called", "account_id", req.Msg.AccountId, "tier", req.Msg.Tier)
+
+	if req.Msg.Tier == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("tier is required"))
+	}
+	acc, err := s.repo.SetAccountTier(ctx, req.Msg.AccountId, req.Msg.Tier)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connectgo.NewResponse(&v1.SetAccountTierResponse{Account: toProtoAccount(acc)}), nil
+}
+
+func (s *accountHandler) SetOverdraft(ctx context.Context, req *connectgo.Request[v1.SetOverdraftRequest]) (*connectgo.Response[v1.SetOverdraftResponse], error) {
+	slog.Info("SetOverdraft called", "account_id", req.Msg.AccountId, "limit", req.Msg.Limit, "rate_bps", req.Msg.RateBps)
+
+	acc, err := s.repo.SetOverdraft(ctx, req.Msg.AccountId, req.Msg.Limit, req.Msg.RateBps)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connectgo.NewResponse(&v1.SetOverdraftResponse{Account: toProtoAccount(acc)}), nil
+}
+
+// toConnectError maps repository errors to connect error codes.
+func toConnectError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrAccountNotFound):
+		return connectgo.NewError(connectgo.CodeNotFound, err)
+	case errors.Is(err, repository.ErrAccountClosed), errors.Is(err, repository.ErrNonZeroBalance), errors.Is(err, repository.ErrActiveHolds):
+		return connectgo.NewError(connectgo.CodeFailedPrecondition, err)
+	case errors.Is(err, limits.ErrInvalidLimit), errors.Is(err, repository.ErrInvalidStatus),
+		errors.Is(err, repository.ErrInvalidOverdraft):
+		return connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	slog.Error("account request failed", "error", err)
+	return connectgo.NewError(connectgo.CodeInternal, err)
+}
+
+// toProtoStatus maps a stored account status to the proto enum.
+func toProtoStatus(status string) v1.AccountStatus {
+	switch status {
+	case ledger.StatusActive:
+		return v1.AccountStatus_ACCOUNT_STATUS_ACTIVE
+	case ledger.StatusClosed:
+		return v1.AccountStatus_ACCOUNT_STATUS_CLOSED
+	case ledger.StatusFrozen:
+		return v1.AccountStatus_ACCOUNT_STATUS_FROZEN
+	case ledger.StatusDebitBlocked:
+		return v1.AccountStatus_ACCOUNT_STATUS_DEBIT_BLOCKED
+	case ledger.StatusCreditBlocked:
+		return v1.AccountStatus_ACCOUNT_STATUS_CREDIT_BLOCKED
+	}
+	return v1.AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+// fromProtoStatus maps the proto account status to its stored form; unspecified maps to "".
+func fromProtoStatus(status v1.AccountStatus) string {
+	switch status {
+	case v1.AccountStatus_ACCOUNT_STATUS_ACTIVE:
+		return ledger.StatusActive
+	case v1.AccountStatus_ACCOUNT_STATUS_CLOSED:
+		return ledger.StatusClosed
+	case v1.AccountStatus_ACCOUNT_STATUS_FROZEN:
+		return ledger.StatusFrozen
+	case v1.AccountStatus_ACCOUNT_STATUS_DEBIT_BLOCKED:
+		return ledger.StatusDebitBlocked
+	case v1.AccountStatus_ACCOUNT_STATUS_CREDIT_BLOCKED:
+		return ledger.StatusCreditBlocked
+	}
+	return ""
+}
+
+// toProtoAccount converts a stored account to its proto representation.
+func toProtoAccount(acc *models.Account) *v1.Account {
+	return &v1.Account{
+		AccountId:        acc.ID,
+		UserId:           acc.UserID,
+		Balance:          acc.Balance,
+		Currency:         acc.Currency,
+		Status:           toProtoStatus(acc.Status),
+		CreatedAt:        timestamppb.New(acc.CreatedAt),
+		UpdatedAt:        timestamppb.New(acc.UpdatedAt),
+		Tier:             acc.Tier,
+		HolderName:       acc.HolderName,
+		OverdraftLimit:   acc.OverdraftLimit,
+		OverdraftRateBps: acc.OverdraftRateBps,
+	}
+}
+
+// fromProtoTransferLimit converts a proto transfer limit to its stored form. An
+// unspecified scope maps to "", which validation rejects.
+func fromProtoTransferLimit(l *v1.TransferLimit) *models.TransferLimit {
+	tl := &models.TransferLimit{
+		ScopeID:        l.ScopeId,
+		Currency:       l.Currency,
+		PerTransaction: l.PerTransaction,
+		DailyAmount:    l.DailyAmount,
+		MonthlyAmount:  l.MonthlyAmount,
+		DailyCount:     int(l.DailyCount),
+	}
+	switch l.Scope {
+	case v1.LimitScope_LIMIT_SCOPE_ACCOUNT:
+		tl.Scope = limits.ScopeAccount
+	case v1.LimitScope_LIMIT_SCOPE_USER:
+		tl.Scope = limits.ScopeUser
+	case v1.LimitScope_LIMIT_SCOPE_TIER:
+		tl.Scope = limits.ScopeTier
+	}
+	return tl
+}
+
+// toProtoTransferLimit converts a stored transfer limit to its proto representation.
+func toProtoTransferLimit(l *models.TransferLimit) *v1.TransferLimit {
+	pl := &v1.TransferLimit{
+		ScopeId:        l.ScopeID,
+		Currency:       l.Currency,
+		PerTransaction: l.PerTransaction,
+		DailyAmount:    l.DailyAmount,
+		MonthlyAmount:  l.MonthlyAmount,
+		DailyCount:     int32(l.DailyCount),
+	}
+	switch l.Scope {
+	case limits.ScopeAccount:
+		pl.Scope = v1.LimitScope_LIMIT_SCOPE_ACCOUNT
+	case limits.ScopeUser:
+		pl.Scope = v1.LimitScope_LIMIT_SCOPE_USER
+	case limits.ScopeTier:
+		pl.Scope = v1.LimitScope_LIMIT_SCOPE_TIER
+	}
+	return pl
+}
+
+// toProtoTransferLimitStatus converts a limit and its usage to the proto representation.
+func toProtoTransferLimitStatus(s *limits.Status) *v1.TransferLimitStatus {
+	ps := &v1.TransferLimitStatus{
+		Limit:             toProtoTransferLimit(&s.Limit),
+		DailyAmountUsed:   s.Usage.DailyAmount,
+		MonthlyAmountUsed: s.Usage.MonthlyAmount,
+		DailyCountUsed:    int32(s.Usage.DailyCount),
+	}
+	if s.Limit.DailyAmount > 0 {
+		ps.DailyAmountRemaining = max(s.Limit.DailyAmount-s.Usage.DailyAmount, 0)
+	}
+	if s.Limit.MonthlyAmount > 0 {
+		ps.MonthlyAmountRemaining = max(s.Limit.MonthlyAmount-s.Usage.MonthlyAmount, 0)
+	}
+	if s.Limit.DailyCount > 0 {
+		ps.DailyCountRemaining = int32(max(int64(s.Limit.DailyCount)-s.Usage.DailyCount, 0))
+	}
+	return ps
+}
+
+// SetupRouter mounts the handler on a new chi Router and returns the router ready to be used.
+func (s *accountHandler) SetupRouter() http.Handler {
+	r := chi.NewRouter()
+	r.Use(tracecontext.Middleware)
+
+	// Health check
+	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("OK"))
+	})
+
+	path, handler := accountv1connect.NewAccountServiceHandler(s)
+	// register multiple path variants to ensure correct routing
+	r.Handle(path, handler)
+	trimmed := strings.TrimRight(path, "/")
+	r.Handle(trimmed, handler)
+	r.Handle(path+"*", handler)
+	r.Handle(trimmed+"/*", handler)
+
+	// Wrap with H2C
+	return h2c.NewHandler(r, &http2.Server{})
+}
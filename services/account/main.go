@@ -0,0 +1,47 @@
+package main
+
+import (
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"FinTechPorto/services/account/handler"
+	"FinTechPorto/services/account/repository"
+
+	"FinTechPorto/internal/database"
+)
+
+func main() {
+	// Configure slog default logger
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stdout, nil)))
+
+	// Initialize database (auto-migrate models)
+	if err := database.Connect(); err != nil {
+		slog.Error("database initialization failed", "error", err)
+		os.Exit(1)
+	}
+
+	// Initialize repository and handler
+	repo := repository.New(database.DB)
+	h := handler.NewHandler(repo)
+
+	// Read port from env, fallback to 8082 so it can run next to the transaction service
+	appPort := os.Getenv("ACCOUNT_APP_PORT")
+	if appPort == "" {
+		appPort = "8082"
+	}
+
+	srv := &http.Server{
+		Addr:         ":" + appPort,
+		Handler:      h.SetupRouter(),
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	slog.Info("starting account service", "addr", srv.Addr)
+	if err := srv.ListenAndServe(); err != nil {
+		slog.Error("server failed", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"FinTechPorto/internal/ledger"
+	"FinTechPorto/internal/limits"
+	"FinTechPorto/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrAccountNotFound is returned when an account cannot be found.
+	ErrAccountNotFound = errors.New("account not found")
+	// ErrAccountClosed is returned when an operation targets a closed account.
+	ErrAccountClosed = ledger.ErrAccountClosed
+	// ErrNonZeroBalance is returned when closing an account that still holds funds.
+	ErrNonZeroBalance = errors.New("account balance is not zero")
+	// ErrActiveHolds is returned when closing an account with active holds.
+	ErrActiveHolds = errors.New("account has active holds")
+	// ErrInvalidStatus is returned for an unknown status or a change to the current one.
+	ErrInvalidStatus = errors.New("invalid account status")
+	// ErrInvalidOverdraft is returned for a negative overdraft limit or rate.
+	ErrInvalidOverdraft = errors.New("invalid overdraft")
+)
+
+// Repository wraps DB operations for accounts.
+type Repository struct {
+	db *gorm.DB
+}
+
+// New creates a new Repository.
+func New(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// CreateAccount opens an empty ACTIVE account for userID in currency held by holderName.
+func (r *Repository) CreateAccount(ctx context.Context, userID, currency, holderName string) (*models.Account, error) {
+	acc := models.Account{
+		UserID:     userID,
+		Currency:   currency,
+		Status:     ledger.StatusActive,
+		HolderName: holderName,
+	}
+	if err := r.db.WithContext(ctx).Create(&acc).Error; err != nil {
+		return nil, fmt.Errorf("failed to create account: %w", err)
+	}
+	return &acc, nil
+}
+
+// GetAccount retrieves an account by its ID.
+func (r *Repository) GetAccount(ctx context.Context, id string) (*models.Account, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrAccountNotFound
+	}
+
+	var acc models.Account
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&acc).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, err
+	}
+	return &acc, nil
+}
+
+// ListAccountsByUser returns every account owned by userID, oldest first.
+func (r *Repository) ListAccountsByUser(ctx context.Context, userID string) ([]models.Account, error) {
+	var accounts []models.Account
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at, id").Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// CloseAccount marks an account CLOSED at the holder's request. The balance must be zero
+// and no holds may be active.
+func (r *Repository) CloseAccount(ctx context.Context, id string) (*models.Account, error) {
+	return r.SetAccountStatus(ctx, id, ledger.StatusClosed, "closed at the holder's request", "")
+}
+
+// SetAccountStatus moves account id to status and records the change with reason and
+// changedBy. CLOSED is final and needs a zero balance and no active holds.
+func (r *Repository) SetAccountStatus(ctx context.Context, id, status, reason, changedBy string) (*models.Account, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrAccountNotFound
+	}
+	if !ledger.ValidStatus(status) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidStatus, status)
+	}
+
+	var acc models.Account
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// lock the row so no transfer can change the balance while we check it
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&acc).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to query account: %w", err)
+		}
+
+		if acc.Status == ledger.StatusClosed {
+			return ErrAccountClosed
+		}
+		if acc.Status == status {
+			return fmt.Errorf("%w: account is already %s", ErrInvalidStatus, status)
+		}
+		if status == ledger.StatusClosed {
+			if acc.Balance != 0 {
+				return ErrNonZeroBalance
+			}
+			if acc.HeldAmount != 0 {
+				return ErrActiveHolds
+			}
+		}
+
+		change := models.AccountStatusChange{
+			AccountID:  acc.ID,
+			FromStatus: acc.Status,
+			ToStatus:   status,
+			Reason:     reason,
+			ChangedBy:  changedBy,
+		}
+		if err := tx.Create(&change).Error; err != nil {
+			return fmt.Errorf("failed to record status change: %w", err)
+		}
+		acc.Status = status
+		if err := tx.Model(&acc).Update("status", acc.Status).Error; err != nil {
+			return fmt.Errorf("failed to update account status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+// ListStatusChanges returns the status history of account id, oldest first.
+func (r *Repository) ListStatusChanges(ctx context.Context, id string) ([]models.AccountStatusChange, error) {
+	if _, err := r.GetAccount(ctx, id); err != nil {
+		return nil, err
+	}
+	var changes []models.AccountStatusChange
+	if err := r.db.WithContext(ctx).Where("account_id = ?", id).Order("id").Find(&changes).Error; err != nil {
+		return nil, fmt.Errorf("failed to list status changes: %w", err)
+	}
+	return changes, nil
+}
+
+// SetTransferLimit creates or replaces the limit for l's scope, scope ID and currency.
+func (r *Repository) SetTransferLimit(ctx context.Context, l *models.TransferLimit) error {
+	if err := limits.Validate(l); err != nil {
+		return err
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "scope"}, {Name: "scope_id"}, {Name: "currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"per_transaction", "daily_amount", "monthly_amount", "daily_count", "updated_at"}),
+	}).Create(l).Error
+	if err != nil {
+		return fmt.Errorf("failed to set transfer limit: %w", err)
+	}
+	return nil
+}
+
+// GetTransferLimits returns the limits that bind account id with their current usage.
+func (r *Repository) GetTransferLimits(ctx context.Context, id string) ([]limits.Status, error) {
+	acc, err := r.GetAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return limits.Statuses(r.db.WithContext(ctx), acc)
+}
+
+// SetAccountTier moves account id to tier.
+func (r *Repository) SetAccountTier(ctx context.Context, id, tier string) (*models.Account, error) {
+	acc, err := r.GetAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	acc.Tier = tier
+	if err := r.db.WithContext(ctx).Model(acc).Update("tier", tier).Error; err != nil {
+		return nil, fmt.Errorf("failed to set account tier: %w", err)
+	}
+	return acc, nil
+}
+
+// SetOverdraft lets account id go limit below zero and charges it rateBps a year while
+// it does. Lowering the limit below what is already drawn only stops further debits.
+func (r *Repository) SetOverdraft(ctx context.Context, id string, limit, rateBps int64) (*models.Account, error) {
+	if limit < 0 || rateBps < 0 {
+		return nil, ErrInvalidOverdraft
+	}
+	acc, err := r.GetAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if acc.Status == ledger.StatusClosed {
+		return nil, ErrAccountClosed
+	}
+	acc.OverdraftLimit, acc.OverdraftRateBps = limit, rateBps
+	if err := r.db.WithContext(ctx).Model(acc).Updates(map[string]interface{}{
+		"overdraft_limit":    limit,
+		"overdraft_rate_bps": rateBps,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to set overdraft: %w", err)
+	}
+	return acc, nil
+}
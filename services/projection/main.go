@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"FinTechPorto/internal/broker"
+	"FinTechPorto/internal/database"
+	"FinTechPorto/internal/projection"
+)
+
+func main() {
+	// Configure slog default logger
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stdout, nil)))
+
+	// Initialize database (auto-migrate models)
+	if err := database.Connect(); err != nil {
+		slog.Error("database initialization failed", "error", err)
+		os.Exit(1)
+	}
+
+	brokersEnv := os.Getenv("KAFKA_BROKERS")
+	topic := os.Getenv("KAFKA_TOPIC")
+	if brokersEnv == "" || topic == "" {
+		slog.Error("KAFKA_BROKERS and KAFKA_TOPIC are required")
+		os.Exit(1)
+	}
+	// The group name is also the projector name under which processed events are recorded
+	group := os.Getenv("PROJECTION_CONSUMER_GROUP")
+	if group == "" {
+		group = "account-activity-projection"
+	}
+	cfg, err := broker.LoadConsumerConfig(strings.Split(brokersEnv, ","), topic, group)
+	if err != nil {
+		slog.Error("failed to configure consumer", "error", err)
+		os.Exit(1)
+	}
+	consumer := broker.NewConsumer(cfg)
+	defer consumer.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	slog.Info("starting projection service", "topic", topic, "group", group)
+	if err := projection.NewProjector(database.DB, group).Run(ctx, consumer); err != nil {
+		slog.Error("projection stopped", "error", err)
+		os.Exit(1)
+	}
+}
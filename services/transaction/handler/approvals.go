@@ -0,0 +1,74 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"context"
+	"errors"
+	"log/slog"
+
+	connectgo "github.com/bufbuild/connect-go"
+	"go.temporal.io/api/serviceerror"
+
+	"FinTechPorto/internal/workflow"
+	"FinTechPorto/services/transaction/repository"
+)
+
+func (s *transactionHandler) ApproveTransfer(ctx context.Context, req *connectgo.Request[v1.ApproveTransferRequest]) (*connectgo.Response[v1.ApproveTransferResponse], error) {
+	slog.Info("ApproveTransfer called", "transaction_id", req.Msg.TransactionId, "approver_id", req.Msg.ApproverId)
+
+	tr, err := s.decideApproval(ctx, req.Msg.TransactionId, workflow.ApprovalDecision{
+		Approved:   true,
+		ApproverID: req.Msg.ApproverId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connectgo.NewResponse(&v1.ApproveTransferResponse{Transaction: tr}), nil
+}
+
+func (s *transactionHandler) RejectTransfer(ctx context.Context, req *connectgo.Request[v1.RejectTransferRequest]) (*connectgo.Response[v1.RejectTransferResponse], error) {
+	slog.Info("RejectTransfer called", "transaction_id", req.Msg.TransactionId, "approver_id", req.Msg.ApproverId, "reason", req.Msg.Reason)
+
+	tr, err := s.decideApproval(ctx, req.Msg.TransactionId, workflow.ApprovalDecision{
+		ApproverID: req.Msg.ApproverId,
+		Reason:     req.Msg.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connectgo.NewResponse(&v1.RejectTransferResponse{Transaction: tr}), nil
+}
+
+// decideApproval checks that the transfer is awaiting approval and that the approver is
+// not its initiator, then signals the decision to the transfer's workflow.
+func (s *transactionHandler) decideApproval(ctx context.Context, transactionID string, decision workflow.ApprovalDecision) (*v1.Transaction, error) {
+	if decision.ApproverID == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("approver_id is required"))
+	}
+
+	tr, err := s.repo.GetTransactionByID(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTransactionNotFound) {
+			return nil, connectgo.NewError(connectgo.CodeNotFound, err)
+		}
+		slog.Error("failed to get transaction", "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	if tr.Status != "AWAITING_APPROVAL" {
+		return nil, connectgo.NewError(connectgo.CodeFailedPrecondition, errors.New("transfer is not awaiting approval"))
+	}
+	if decision.ApproverID == tr.InitiatorID {
+		return nil, connectgo.NewError(connectgo.CodePermissionDenied, errors.New("the initiator cannot decide on their own transfer"))
+	}
+
+	if err := s.tclient.SignalWorkflow(ctx, tr.WorkflowID, "", workflow.SignalApprovalDecision, decision); err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			// The workflow already finished, most likely because the approval timed out.
+			return nil, connectgo.NewError(connectgo.CodeFailedPrecondition, errors.New("transfer is no longer awaiting approval"))
+		}
+		slog.Error("failed to signal approval decision", "transaction_id", tr.ID, "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	return toProtoTransaction(tr), nil
+}
@@ -0,0 +1,279 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	connectgo "github.com/bufbuild/connect-go"
+	"github.com/google/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"FinTechPorto/internal/currency"
+	"FinTechPorto/internal/fee"
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/workflow"
+	"FinTechPorto/services/transaction/repository"
+)
+
+// Batch limits for CreateBatchTransfer.
+const (
+	maxBatchItems    = 5000
+	batchConcurrency = 20
+)
+
+func (s *transactionHandler) CreateBatchTransfer(ctx context.Context, req *connectgo.Request[v1.CreateBatchTransferRequest]) (*connectgo.Response[v1.CreateBatchTransferResponse], error) {
+	slog.Info("CreateBatchTransfer called",
+		"sender_id", req.Msg.SenderId,
+		"currency", req.Msg.Currency,
+		"mode", req.Msg.Mode,
+		"items", len(req.Msg.Items),
+		"idempotency_key", req.Msg.IdempotencyKey,
+	)
+
+	if err := currency.Validate(req.Msg.Currency); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	mode := fromProtoBatchMode(req.Msg.Mode)
+	if mode == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("mode is required"))
+	}
+	if len(req.Msg.Items) == 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("items must not be empty"))
+	}
+	if len(req.Msg.Items) > maxBatchItems {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, fmt.Errorf("a batch holds at most %d items", maxBatchItems))
+	}
+
+	items := make([]models.BatchItem, 0, len(req.Msg.Items))
+	for _, it := range req.Msg.Items {
+		item := models.BatchItem{
+			RecipientID: it.RecipientId,
+			Amount:      it.Amount,
+			Reference:   it.Reference,
+		}
+		if it.Amount > 0 {
+			breakdown, err := s.fees.Compute(fee.ProductBatch, req.Msg.Currency, req.Msg.Currency, it.Amount)
+			if err != nil {
+				return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+			}
+			item.FeeAmount = breakdown.Total
+		}
+		items = append(items, item)
+	}
+
+	// Batches have no approval step, so payments that would need one, alone or together,
+	// must not be split into a batch to avoid it.
+	var total int64
+	for _, item := range items {
+		if s.approvals.Requires(req.Msg.Currency, item.Amount) {
+			return nil, connectgo.NewError(connectgo.CodeFailedPrecondition, errors.New("a batch item exceeds the approval threshold; send it as a transfer"))
+		}
+		total += item.Amount
+	}
+	if s.approvals.Requires(req.Msg.Currency, total) {
+		return nil, connectgo.NewError(connectgo.CodeFailedPrecondition, errors.New("the batch total exceeds the approval threshold; send the payments as transfers"))
+	}
+
+	key := req.Msg.IdempotencyKey
+	if key == "" {
+		key = uuid.New().String()
+	}
+
+	batch := &models.TransferBatch{
+		SenderID: req.Msg.SenderId,
+		Currency: req.Msg.Currency,
+		Mode:     mode,
+	}
+	replayed, err := s.repo.CreateBatch(ctx, batch, items, key, batchFingerprint(req.Msg))
+	if err != nil {
+		return nil, batchError(err)
+	}
+	if replayed {
+		slog.Info("idempotent replay of CreateBatchTransfer", "batch_id", batch.ID)
+	}
+
+	// A replay still attempts the start in case the original request recorded the
+	// batch but failed before the workflow was started.
+	_, err = s.tclient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    batch.WorkflowID,
+		TaskQueue:             "transaction-task-queue",
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}, workflow.BatchTransferWorkflow, workflow.BatchParams{
+		BatchID:     batch.ID,
+		Mode:        batch.Mode,
+		Concurrency: batchConcurrency,
+	})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if err != nil && !errors.As(err, &alreadyStarted) {
+		slog.Error("failed to start batch workflow", "batch_id", batch.ID, "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+
+	_, counts, err := s.repo.GetBatch(ctx, batch.ID)
+	if err != nil {
+		return nil, batchError(err)
+	}
+	return connectgo.NewResponse(&v1.CreateBatchTransferResponse{Batch: toProtoBatch(batch, counts)}), nil
+}
+
+func (s *transactionHandler) GetBatchTransfer(ctx context.Context, req *connectgo.Request[v1.GetBatchTransferRequest]) (*connectgo.Response[v1.GetBatchTransferResponse], error) {
+	batch, counts, err := s.repo.GetBatch(ctx, req.Msg.BatchId)
+	if err != nil {
+		return nil, batchError(err)
+	}
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	afterSeq := 0
+	if req.Msg.PageToken != "" {
+		afterSeq, err = decodeBatchCursor(req.Msg.PageToken)
+		if err != nil {
+			return nil, connectgo.NewError(connectgo.CodeInvalidArgument, repository.ErrInvalidCursor)
+		}
+	}
+
+	// Fetch one extra row to know whether another page follows.
+	items, err := s.repo.ListBatchItems(ctx, batch.ID, afterSeq, pageSize+1)
+	if err != nil {
+		return nil, batchError(err)
+	}
+
+	resp := &v1.GetBatchTransferResponse{Batch: toProtoBatch(batch, counts)}
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		resp.NextPageToken = encodeBatchCursor(items[len(items)-1].Seq)
+	}
+	for i := range items {
+		resp.Items = append(resp.Items, toProtoBatchItem(&items[i]))
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+// batchFingerprint hashes the fields that define a batch so replays of an idempotency
+// key can be compared with the request that first claimed it.
+func batchFingerprint(m *v1.CreateBatchTransferRequest) string {
+	fields := []string{m.SenderId, m.Currency, m.Mode.String()}
+	for _, it := range m.Items {
+		fields = append(fields, it.RecipientId, strconv.FormatInt(it.Amount, 10), it.Reference)
+	}
+	return fingerprint(fields...)
+}
+
+// encodeBatchCursor renders the seq of the last item returned as an opaque page token.
+func encodeBatchCursor(seq int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(seq)))
+}
+
+// decodeBatchCursor parses a page token produced by encodeBatchCursor.
+func decodeBatchCursor(token string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(raw))
+}
+
+// batchError maps repository errors from batch operations to connect error codes.
+func batchError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrAccountNotFound), errors.Is(err, repository.ErrBatchNotFound):
+		return connectgo.NewError(connectgo.CodeNotFound, err)
+	case errors.Is(err, repository.ErrInvalidBatch):
+		return connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	case errors.Is(err, repository.ErrIdempotencyKeyConflict):
+		return connectgo.NewError(connectgo.CodeAlreadyExists, err)
+	case errors.Is(err, repository.ErrInsufficientFunds), errors.Is(err, repository.ErrAccountFrozen),
+		errors.Is(err, repository.ErrDebitBlocked), errors.Is(err, repository.ErrAccountClosed):
+		return connectgo.NewError(connectgo.CodeFailedPrecondition, err)
+	case errors.Is(err, repository.ErrLimitExceeded):
+		return limitError(err)
+	}
+	slog.Error("batch request failed", "error", err)
+	return connectgo.NewError(connectgo.CodeInternal, err)
+}
+
+// fromProtoBatchMode maps the proto batch mode to its stored form; unspecified maps to "".
+func fromProtoBatchMode(m v1.BatchMode) string {
+	switch m {
+	case v1.BatchMode_BATCH_MODE_ALL_OR_NOTHING:
+		return "ALL_OR_NOTHING"
+	case v1.BatchMode_BATCH_MODE_BEST_EFFORT:
+		return "BEST_EFFORT"
+	}
+	return ""
+}
+
+// toProtoBatchStatus maps a stored batch status to the proto enum.
+func toProtoBatchStatus(status string) v1.BatchStatus {
+	switch status {
+	case "PENDING":
+		return v1.BatchStatus_BATCH_STATUS_PENDING
+	case "PROCESSING":
+		return v1.BatchStatus_BATCH_STATUS_PROCESSING
+	case "COMPLETED":
+		return v1.BatchStatus_BATCH_STATUS_COMPLETED
+	case "PARTIALLY_COMPLETED":
+		return v1.BatchStatus_BATCH_STATUS_PARTIALLY_COMPLETED
+	case "FAILED":
+		return v1.BatchStatus_BATCH_STATUS_FAILED
+	}
+	return v1.BatchStatus_BATCH_STATUS_UNSPECIFIED
+}
+
+// toProtoBatch converts a stored batch and its item counts to the proto representation.
+func toProtoBatch(b *models.TransferBatch, counts *repository.BatchCounts) *v1.Batch {
+	pb := &v1.Batch{
+		BatchId:       b.ID,
+		SenderId:      b.SenderID,
+		Currency:      b.Currency,
+		Status:        toProtoBatchStatus(b.Status),
+		ItemCount:     int32(b.ItemCount),
+		TotalAmount:   b.TotalAmount,
+		TotalFee:      b.TotalFee,
+		FailureReason: b.FailureReason,
+		CreatedAt:     timestamppb.New(b.CreatedAt),
+		UpdatedAt:     timestamppb.New(b.UpdatedAt),
+	}
+	switch b.Mode {
+	case "ALL_OR_NOTHING":
+		pb.Mode = v1.BatchMode_BATCH_MODE_ALL_OR_NOTHING
+	case "BEST_EFFORT":
+		pb.Mode = v1.BatchMode_BATCH_MODE_BEST_EFFORT
+	}
+	if counts != nil {
+		pb.PendingCount = int32(counts.Pending)
+		pb.CompletedCount = int32(counts.Completed)
+		pb.FailedCount = int32(counts.Failed)
+		pb.ReversedCount = int32(counts.Reversed)
+	}
+	return pb
+}
+
+// toProtoBatchItem converts a batch item and its transaction status to the proto representation.
+func toProtoBatchItem(it *repository.BatchItemStatus) *v1.BatchItem {
+	return &v1.BatchItem{
+		ItemId:        it.ID,
+		Seq:           int32(it.Seq),
+		RecipientId:   it.RecipientID,
+		Amount:        it.Amount,
+		FeeAmount:     it.FeeAmount,
+		Reference:     it.Reference,
+		TransactionId: it.TransactionID,
+		Status:        toProtoStatus(it.Status),
+		FailureReason: it.FailureReason,
+	}
+}
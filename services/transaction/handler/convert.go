@@ -0,0 +1,101 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"FinTechPorto/internal/models"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProtoStatus maps a stored status string to the proto enum.
+func toProtoStatus(status string) v1.TransactionStatus {
+	switch status {
+	case "PENDING":
+		return v1.TransactionStatus_PENDING
+	case "COMPLETED":
+		return v1.TransactionStatus_COMPLETED
+	case "FAILED":
+		return v1.TransactionStatus_FAILED
+	case "REVERSED":
+		return v1.TransactionStatus_REVERSED
+	case "AWAITING_APPROVAL":
+		return v1.TransactionStatus_AWAITING_APPROVAL
+	case "REJECTED":
+		return v1.TransactionStatus_REJECTED
+	}
+	return v1.TransactionStatus_UNSPECIFIED
+}
+
+// toProtoType maps a stored transaction type to the proto enum.
+func toProtoType(kind string) v1.TransactionType {
+	switch kind {
+	case "TRANSFER":
+		return v1.TransactionType_TRANSACTION_TYPE_TRANSFER
+	case "REFUND":
+		return v1.TransactionType_TRANSACTION_TYPE_REFUND
+	case "REVERSAL":
+		return v1.TransactionType_TRANSACTION_TYPE_REVERSAL
+	case "CAPTURE":
+		return v1.TransactionType_TRANSACTION_TYPE_CAPTURE
+	}
+	return v1.TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+// toProtoTransaction converts a stored transaction to its proto representation.
+func toProtoTransaction(tr *models.Transaction) *v1.Transaction {
+	pt := &v1.Transaction{
+		TransactionId:         tr.ID,
+		SenderId:              tr.SenderID,
+		RecipientId:           tr.RecipientID,
+		Amount:                tr.Amount,
+		Currency:              tr.Currency,
+		Status:                toProtoStatus(tr.Status),
+		CreatedAt:             timestamppb.New(tr.CreatedAt),
+		UpdatedAt:             timestamppb.New(tr.UpdatedAt),
+		Type:                  toProtoType(tr.Type),
+		OriginalTransactionId: tr.OriginalTransactionID,
+		RefundedAmount:        tr.RefundedAmount,
+		CounterAmount:         tr.CounterAmount,
+		CounterCurrency:       tr.CounterCurrency,
+		FxRate:                tr.FxRate,
+		FeeAmount:             tr.FeeAmount,
+		InitiatorId:           tr.InitiatorID,
+		ApproverId:            tr.ApproverID,
+	}
+	if tr.Memo != "" {
+		memo := tr.Memo
+		pt.Memo = &memo
+	}
+	return pt
+}
+
+// toProtoHoldStatus maps a stored hold status to the proto enum.
+func toProtoHoldStatus(status string) v1.HoldStatus {
+	switch status {
+	case "ACTIVE":
+		return v1.HoldStatus_HOLD_STATUS_ACTIVE
+	case "CAPTURED":
+		return v1.HoldStatus_HOLD_STATUS_CAPTURED
+	case "VOIDED":
+		return v1.HoldStatus_HOLD_STATUS_VOIDED
+	case "EXPIRED":
+		return v1.HoldStatus_HOLD_STATUS_EXPIRED
+	}
+	return v1.HoldStatus_HOLD_STATUS_UNSPECIFIED
+}
+
+// toProtoHold converts a stored hold to its proto representation.
+func toProtoHold(h *models.Hold) *v1.Hold {
+	return &v1.Hold{
+		HoldId:               h.ID,
+		AccountId:            h.AccountID,
+		Amount:               h.Amount,
+		CapturedAmount:       h.CapturedAmount,
+		Currency:             h.Currency,
+		Status:               toProtoHoldStatus(h.Status),
+		Reference:            h.Reference,
+		CaptureTransactionId: h.CaptureTransactionID,
+		ExpiresAt:            timestamppb.New(h.ExpiresAt),
+		CreatedAt:            timestamppb.New(h.CreatedAt),
+	}
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"context"
+	"errors"
+	"log/slog"
+
+	connectgo "github.com/bufbuild/connect-go"
+
+	"FinTechPorto/internal/currency"
+	"FinTechPorto/internal/fee"
+	"FinTechPorto/services/transaction/repository"
+)
+
+func (s *transactionHandler) QuoteTransfer(ctx context.Context, req *connectgo.Request[v1.QuoteTransferRequest]) (*connectgo.Response[v1.QuoteTransferResponse], error) {
+	if err := currency.Validate(req.Msg.Currency); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	if req.Msg.Amount <= 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("amount must be positive"))
+	}
+	buy := req.Msg.RecipientCurrency
+	if buy == "" {
+		buy = req.Msg.Currency
+	}
+
+	breakdown, err := s.fees.Compute(fee.ProductTransfer, req.Msg.Currency, buy, req.Msg.Amount)
+	if err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+
+	return connectgo.NewResponse(&v1.QuoteTransferResponse{
+		Fee:        toProtoFeeBreakdown(breakdown, req.Msg.Currency),
+		TotalDebit: req.Msg.Amount + breakdown.Total,
+	}), nil
+}
+
+// transferFee computes the fee for a CreateTransfer request. The corridor's buy side is
+// the quote's currency for cross-currency transfers and the transfer currency otherwise.
+func (s *transactionHandler) transferFee(ctx context.Context, m *v1.CreateTransferRequest) (*fee.Breakdown, error) {
+	buy := m.Currency
+	if m.FxQuoteId != "" {
+		q, err := s.repo.GetFxQuote(ctx, m.FxQuoteId)
+		if err != nil {
+			if errors.Is(err, repository.ErrQuoteNotFound) {
+				return nil, connectgo.NewError(connectgo.CodeNotFound, err)
+			}
+			slog.Error("failed to load fx quote", "error", err)
+			return nil, connectgo.NewError(connectgo.CodeInternal, err)
+		}
+		buy = q.BuyCurrency
+	}
+
+	breakdown, err := s.fees.Compute(fee.ProductTransfer, m.Currency, buy, m.Amount)
+	if err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	return breakdown, nil
+}
+
+// toProtoFeeBreakdown converts a fee breakdown to its proto representation. Transfers
+// recorded before fees were introduced have no breakdown and report a zero fee.
+func toProtoFeeBreakdown(b *fee.Breakdown, currency string) *v1.FeeBreakdown {
+	if b == nil {
+		return &v1.FeeBreakdown{Currency: currency}
+	}
+	return &v1.FeeBreakdown{
+		Currency:   b.Currency,
+		Flat:       b.Flat,
+		Percentage: b.Percentage,
+		Adjustment: b.Adjustment,
+		Total:      b.Total,
+		Rule:       b.Rule,
+	}
+}
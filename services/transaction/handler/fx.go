@@ -0,0 +1,117 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	connectgo "github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"FinTechPorto/internal/currency"
+	"FinTechPorto/internal/fx"
+	"FinTechPorto/internal/models"
+)
+
+func (s *transactionHandler) QuoteFx(ctx context.Context, req *connectgo.Request[v1.QuoteFxRequest]) (*connectgo.Response[v1.QuoteFxResponse], error) {
+	slog.Info("QuoteFx called",
+		"sell_currency", req.Msg.SellCurrency,
+		"buy_currency", req.Msg.BuyCurrency,
+		"sell_amount", req.Msg.SellAmount,
+	)
+
+	if err := currency.Validate(req.Msg.SellCurrency); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	if err := currency.Validate(req.Msg.BuyCurrency); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	if req.Msg.SellCurrency == req.Msg.BuyCurrency {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("sell and buy currency must differ"))
+	}
+	if req.Msg.SellAmount <= 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("sell_amount must be positive"))
+	}
+
+	conv, err := s.fx.Convert(req.Msg.SellCurrency, req.Msg.BuyCurrency, req.Msg.SellAmount)
+	if err != nil {
+		if errors.Is(err, fx.ErrRateNotFound) {
+			return nil, connectgo.NewError(connectgo.CodeNotFound, err)
+		}
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	if conv.BuyAmount <= 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("sell_amount is too small to convert"))
+	}
+
+	q := &models.FxQuote{
+		SellCurrency: req.Msg.SellCurrency,
+		BuyCurrency:  req.Msg.BuyCurrency,
+		SellAmount:   req.Msg.SellAmount,
+		BuyAmount:    conv.BuyAmount,
+		MidRate:      fx.FormatRate(conv.MidRate),
+		Rate:         fx.FormatRate(conv.Rate),
+		SpreadBps:    conv.SpreadBps,
+		ExpiresAt:    time.Now().Add(s.fx.QuoteTTL()),
+	}
+	if err := s.repo.CreateFxQuote(ctx, q); err != nil {
+		slog.Error("failed to store fx quote", "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+
+	return connectgo.NewResponse(&v1.QuoteFxResponse{Quote: toProtoFxQuote(q)}), nil
+}
+
+func (s *transactionHandler) SetFxRate(ctx context.Context, req *connectgo.Request[v1.SetFxRateRequest]) (*connectgo.Response[v1.SetFxRateResponse], error) {
+	if req.Msg.Rate == nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("rate is required"))
+	}
+	slog.Info("SetFxRate called", "base", req.Msg.Rate.Base, "quote", req.Msg.Rate.Quote, "rate", req.Msg.Rate.Rate)
+
+	rate := fx.Rate{Base: req.Msg.Rate.Base, Quote: req.Msg.Rate.Quote, Rate: req.Msg.Rate.Rate}
+	parsed, err := fx.ParseRate(rate.Rate)
+	if err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	rate.Rate = fx.FormatRate(parsed)
+	if err := fx.ValidateRate(rate); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+
+	// validated before it is stored, as stored rates are loaded into the book on startup;
+	// persisted before the book is updated so it never holds a rate lost on restart
+	if err := s.repo.SaveFxRate(ctx, rate); err != nil {
+		slog.Error("failed to save fx rate", "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	if err := s.fx.Set(rate); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+
+	return connectgo.NewResponse(&v1.SetFxRateResponse{Rate: &v1.FxRate{Base: rate.Base, Quote: rate.Quote, Rate: rate.Rate}}), nil
+}
+
+func (s *transactionHandler) ListFxRates(ctx context.Context, req *connectgo.Request[v1.ListFxRatesRequest]) (*connectgo.Response[v1.ListFxRatesResponse], error) {
+	resp := &v1.ListFxRatesResponse{SpreadBps: s.fx.SpreadBps()}
+	for _, r := range s.fx.Rates() {
+		resp.Rates = append(resp.Rates, &v1.FxRate{Base: r.Base, Quote: r.Quote, Rate: r.Rate})
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+// toProtoFxQuote converts a stored FX quote to its proto representation.
+func toProtoFxQuote(q *models.FxQuote) *v1.FxQuote {
+	return &v1.FxQuote{
+		QuoteId:      q.ID,
+		SellCurrency: q.SellCurrency,
+		BuyCurrency:  q.BuyCurrency,
+		SellAmount:   q.SellAmount,
+		BuyAmount:    q.BuyAmount,
+		Rate:         q.Rate,
+		MidRate:      q.MidRate,
+		SpreadBps:    q.SpreadBps,
+		ExpiresAt:    timestamppb.New(q.ExpiresAt),
+	}
+}
@@ -4,35 +4,53 @@ import (
 	v1 "FinTechPorto/gen/api/transaction/v1"
 	transactionv1connect "FinTechPorto/gen/api/transaction/v1/transactionv1connect"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
-	"time"
 
 	"log/slog"
 
 	connectgo "github.com/bufbuild/connect-go"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
 
+	"FinTechPorto/internal/approval"
+	"FinTechPorto/internal/currency"
+	"FinTechPorto/internal/fee"
+	"FinTechPorto/internal/fx"
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/risk"
+	"FinTechPorto/internal/screening"
+	"FinTechPorto/internal/tracecontext"
 	"FinTechPorto/services/transaction/repository"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 
 	"FinTechPorto/internal/workflow"
 )
 
-// transactionHandler implements transactionv1connect.TransactionServiceHandler
+// transactionHandler implements transactionv1connect.TransactionServiceHandler and
+// transactionv1connect.FxServiceHandler
 type transactionHandler struct {
-	repo    *repository.Repository
-	tclient client.Client
+	repo      *repository.Repository
+	tclient   client.Client
+	fx        *fx.Book
+	fees      *fee.Schedule
+	approvals approval.Policy
+	sanctions *screening.Screener
+	risk      *risk.Engine
 }
 
 // NewHandler creates a new transactionHandler.
-func NewHandler(repo *repository.Repository, tc client.Client) *transactionHandler {
-	return &transactionHandler{repo: repo, tclient: tc}
+func NewHandler(repo *repository.Repository, tc client.Client, book *fx.Book, fees *fee.Schedule, approvals approval.Policy, sanctions *screening.Screener, engine *risk.Engine) *transactionHandler {
+	return &transactionHandler{repo: repo, tclient: tc, fx: book, fees: fees, approvals: approvals, sanctions: sanctions, risk: engine}
 }
 
 func (s *transactionHandler) CreateTransfer(ctx context.Context, req *connectgo.Request[v1.CreateTransferRequest]) (*connectgo.Response[v1.CreateTransferResponse], error) {
@@ -43,69 +61,320 @@ func (s *transactionHandler) CreateTransfer(ctx context.Context, req *connectgo.
 		"amount", req.Msg.Amount,
 		"currency", req.Msg.Currency,
 		"memo", req.Msg.Memo,
+		"idempotency_key", req.Msg.IdempotencyKey,
 	)
 
+	if err := currency.Validate(req.Msg.Currency); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	if req.Msg.Amount <= 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("amount must be positive"))
+	}
+
+	// Without a client key every call is treated as a new transfer.
+	key := req.Msg.IdempotencyKey
+	if key == "" {
+		key = uuid.New().String()
+	}
+
 	memo := (*string)(nil)
 	if req.Msg.Memo != nil {
 		m := *req.Msg.Memo
 		memo = &m
 	}
 
-	// Build workflow params
-	params := workflow.TransferParams{
+	breakdown, err := s.transferFee(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the transfer as PENDING before starting the workflow so the returned ID
+	// always resolves. The key doubles as the workflow ID so Temporal also rejects
+	// duplicate starts.
+	tr, replayed, err := s.repo.CreatePendingTransfer(ctx, repository.PendingTransfer{
 		SenderID:    req.Msg.SenderId,
 		RecipientID: req.Msg.RecipientId,
 		Amount:      req.Msg.Amount,
 		Currency:    req.Msg.Currency,
 		Memo:        memo,
+		WorkflowID:  "transfer-" + req.Msg.SenderId + "-" + key,
+		FxQuoteID:   req.Msg.FxQuoteId,
+		Fee:         breakdown,
+		// Large transfers wait for a second person before any money moves.
+		RequiresApproval: s.approvals.Requires(req.Msg.Currency, req.Msg.Amount),
+	}, key, transferFingerprint(req.Msg))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrIdempotencyKeyConflict):
+			return nil, connectgo.NewError(connectgo.CodeAlreadyExists, err)
+		case errors.Is(err, repository.ErrQuoteNotFound):
+			return nil, connectgo.NewError(connectgo.CodeNotFound, err)
+		case errors.Is(err, repository.ErrQuoteMismatch):
+			return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+		case errors.Is(err, repository.ErrQuoteExpired), errors.Is(err, repository.ErrQuoteUsed):
+			return nil, connectgo.NewError(connectgo.CodeFailedPrecondition, err)
+		case errors.Is(err, repository.ErrLimitExceeded):
+			return nil, limitError(err)
+		}
+		slog.Error("failed to record pending transfer", "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	if replayed {
+		slog.Info("idempotent replay of CreateTransfer", "transaction_id", tr.ID)
+	}
+
+	// Build workflow params
+	params := workflow.TransferParams{
+		TransactionID:   tr.ID,
+		SenderID:        req.Msg.SenderId,
+		RecipientID:     req.Msg.RecipientId,
+		Amount:          req.Msg.Amount,
+		Currency:        req.Msg.Currency,
+		Memo:            memo,
+		CounterAmount:   tr.CounterAmount,
+		CounterCurrency: tr.CounterCurrency,
+		FeeAmount:       tr.FeeAmount,
+		// Decided when the transfer was recorded, so a replay keeps the original decision.
+		RequiresApproval: tr.Status == "AWAITING_APPROVAL",
+		ApprovalTimeout:  s.approvals.Timeout,
+		InitiatorID:      tr.InitiatorID,
 	}
 
-	// Start workflow asynchronously
-	workflowID := "transfer-" + time.Now().Format("20060102-150405-000000")
-	run, err := s.tclient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
-		ID:        workflowID,
-		TaskQueue: "transaction-task-queue",
+	// Start workflow asynchronously. A replay still attempts the start in case the
+	// original request recorded the transfer but failed before the workflow was started.
+	_, err = s.tclient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    tr.WorkflowID,
+		TaskQueue:             "transaction-task-queue",
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
 	}, workflow.TransferWorkflow, params)
-	if err != nil {
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if err != nil && !errors.As(err, &alreadyStarted) {
 		slog.Error("failed to start workflow", "error", err)
 		return nil, connectgo.NewError(connectgo.CodeInternal, err)
 	}
 
-	// Return immediate response with workflow/run id and PENDING status
+	// Return immediate response with the transaction id and its current status
 	resp := &v1.CreateTransferResponse{
-		TransactionId: workflowID,
-		Status:        v1.TransactionStatus_PENDING,
+		TransactionId: tr.ID,
+		Status:        toProtoStatus(tr.Status),
+		Transaction:   toProtoTransaction(tr),
+		Fee:           toProtoFeeBreakdown(tr.FeeBreakdown, tr.Currency),
+		TotalDebit:    tr.Amount + tr.FeeAmount,
 	}
-	_ = run
 	return connectgo.NewResponse(resp), nil
 }
 
+// transferFingerprint hashes the fields that define a transfer so replays of an
+// idempotency key can be compared with the request that first claimed it.
+func transferFingerprint(m *v1.CreateTransferRequest) string {
+	return fingerprint(m.SenderId, m.RecipientId, strconv.FormatInt(m.Amount, 10), m.Currency, m.GetMemo(), m.FxQuoteId)
+}
+
+// fingerprint hashes request fields into a stable, separator-safe digest.
+func fingerprint(fields ...string) string {
+	h := sha256.New()
+	for _, f := range fields {
+		h.Write([]byte(f))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func (s *transactionHandler) GetTransactionStatus(ctx context.Context, req *connectgo.Request[v1.GetTransactionStatusRequest]) (*connectgo.Response[v1.GetTransactionStatusResponse], error) {
 	// Fetch transaction
 	tr, err := s.repo.GetTransactionByID(ctx, req.Msg.TransactionId)
+	if errors.Is(err, repository.ErrTransactionNotFound) && strings.HasPrefix(req.Msg.TransactionId, "transfer-") {
+		// Workflows started before transfers were recorded at acceptance have no row;
+		// ask the workflow itself.
+		return s.workflowTransferStatus(ctx, req.Msg.TransactionId)
+	}
 	if err != nil {
-		if errors.Is(err, repository.ErrAccountNotFound) {
+		if errors.Is(err, repository.ErrTransactionNotFound) {
 			return nil, connectgo.NewError(connectgo.CodeNotFound, err)
 		}
 		return nil, connectgo.NewError(connectgo.CodeInternal, err)
 	}
 
-	// Map status string to proto enum
-	status := v1.TransactionStatus_UNSPECIFIED
-	switch tr.Status {
-	case "PENDING":
-		status = v1.TransactionStatus_PENDING
-	case "COMPLETED":
-		status = v1.TransactionStatus_COMPLETED
-	case "FAILED":
-		status = v1.TransactionStatus_FAILED
-	case "REVERSED":
-		status = v1.TransactionStatus_REVERSED
+	resp := &v1.GetTransactionStatusResponse{
+		TransactionId: tr.ID,
+		Status:        toProtoStatus(tr.Status),
+		FailureReason: tr.FailureReason,
+		Transaction:   toProtoTransaction(tr),
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *transactionHandler) ReverseTransfer(ctx context.Context, req *connectgo.Request[v1.ReverseTransferRequest]) (*connectgo.Response[v1.ReverseTransferResponse], error) {
+	slog.Info("ReverseTransfer called", "transaction_id", req.Msg.TransactionId, "reason", req.Msg.Reason)
+
+	refund, _, err := s.startRefund(ctx, "REVERSAL", req.Msg.TransactionId, 0, req.Msg.Reason, req.Msg.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	return connectgo.NewResponse(&v1.ReverseTransferResponse{Reversal: toProtoTransaction(refund)}), nil
+}
+
+func (s *transactionHandler) RefundTransfer(ctx context.Context, req *connectgo.Request[v1.RefundTransferRequest]) (*connectgo.Response[v1.RefundTransferResponse], error) {
+	slog.Info("RefundTransfer called", "transaction_id", req.Msg.TransactionId, "amount", req.Msg.Amount, "reason", req.Msg.Reason)
+
+	if req.Msg.Amount <= 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("amount must be positive"))
+	}
+
+	refund, remaining, err := s.startRefund(ctx, "REFUND", req.Msg.TransactionId, req.Msg.Amount, req.Msg.Reason, req.Msg.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.RefundTransferResponse{
+		Refund:              toProtoTransaction(refund),
+		RemainingRefundable: remaining,
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+// startRefund records a refund or reversal of originalID and starts its RefundWorkflow.
+// An amount of zero refunds everything still refundable.
+func (s *transactionHandler) startRefund(ctx context.Context, kind, originalID string, amount int64, reason, key string) (*models.Transaction, int64, error) {
+	if _, err := uuid.Parse(originalID); err != nil {
+		return nil, 0, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("transaction_id must be a UUID"))
+	}
+	if key == "" {
+		key = uuid.New().String()
+	}
+
+	refund, remaining, replayed, err := s.repo.CreateRefund(ctx, repository.RefundRequest{
+		OriginalID:  originalID,
+		Amount:      amount,
+		Type:        kind,
+		Reason:      reason,
+		Key:         key,
+		Fingerprint: fingerprint(kind, originalID, strconv.FormatInt(amount, 10), reason),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrTransactionNotFound):
+			return nil, 0, connectgo.NewError(connectgo.CodeNotFound, err)
+		case errors.Is(err, repository.ErrIdempotencyKeyConflict):
+			return nil, 0, connectgo.NewError(connectgo.CodeAlreadyExists, err)
+		case errors.Is(err, repository.ErrNotRefundable), errors.Is(err, repository.ErrAlreadyReversed), errors.Is(err, repository.ErrRefundExceedsOriginal):
+			return nil, 0, connectgo.NewError(connectgo.CodeFailedPrecondition, err)
+		}
+		slog.Error("failed to record refund", "error", err)
+		return nil, 0, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	if replayed {
+		slog.Info("idempotent replay of refund", "transaction_id", refund.ID)
+	}
+
+	params := workflow.TransferParams{
+		TransactionID:         refund.ID,
+		OriginalTransactionID: refund.OriginalTransactionID,
+		SenderID:              refund.SenderID,
+		RecipientID:           refund.RecipientID,
+		Amount:                refund.Amount,
+		Currency:              refund.Currency,
+	}
+	if refund.Memo != "" {
+		memo := refund.Memo
+		params.Memo = &memo
+	}
+
+	_, err = s.tclient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    refund.WorkflowID,
+		TaskQueue:             "transaction-task-queue",
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}, workflow.RefundWorkflow, params)
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if err != nil && !errors.As(err, &alreadyStarted) {
+		slog.Error("failed to start refund workflow", "error", err)
+		return nil, 0, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	return refund, remaining, nil
+}
+
+// Page size bounds for ListTransactions.
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+func (s *transactionHandler) ListTransactions(ctx context.Context, req *connectgo.Request[v1.ListTransactionsRequest]) (*connectgo.Response[v1.ListTransactionsResponse], error) {
+	if req.Msg.AccountId == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("account_id is required"))
+	}
+	if _, err := uuid.Parse(req.Msg.AccountId); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("account_id must be a UUID"))
+	}
+
+	filter := repository.TransactionFilter{
+		AccountID: req.Msg.AccountId,
+		Currency:  req.Msg.Currency,
+	}
+	if req.Msg.Status != v1.TransactionStatus_UNSPECIFIED {
+		filter.Status = req.Msg.Status.String()
+	}
+	if req.Msg.CreatedAfter != nil {
+		filter.CreatedAfter = req.Msg.CreatedAfter.AsTime()
+	}
+	if req.Msg.CreatedBefore != nil {
+		filter.CreatedBefore = req.Msg.CreatedBefore.AsTime()
+	}
+
+	var cursor *repository.Cursor
+	if req.Msg.PageToken != "" {
+		c, err := repository.DecodeCursor(req.Msg.PageToken)
+		if err != nil {
+			return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+		}
+		cursor = c
+	}
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// fetch one extra row to learn whether another page exists
+	txs, err := s.repo.ListTransactions(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		slog.Error("failed to list transactions", "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+
+	resp := &v1.ListTransactionsResponse{}
+	if len(txs) > pageSize {
+		txs = txs[:pageSize]
+		last := txs[len(txs)-1]
+		resp.NextPageToken = repository.EncodeCursor(repository.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	for i := range txs {
+		resp.Transactions = append(resp.Transactions, toProtoTransaction(&txs[i]))
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+// workflowTransferStatus answers GetTransactionStatus from the TransferWorkflow's query handler.
+func (s *transactionHandler) workflowTransferStatus(ctx context.Context, workflowID string) (*connectgo.Response[v1.GetTransactionStatusResponse], error) {
+	val, err := s.tclient.QueryWorkflow(ctx, workflowID, "", workflow.QueryTransferState)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil, connectgo.NewError(connectgo.CodeNotFound, repository.ErrTransactionNotFound)
+		}
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	var state workflow.TransferState
+	if err := val.Get(&state); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
 	}
 
 	resp := &v1.GetTransactionStatusResponse{
-		TransactionId: tr.ID,
-		Status:        status,
+		TransactionId: workflowID,
+		Status:        toProtoStatus(state.Status),
+		FailureReason: state.FailureReason,
 	}
 	return connectgo.NewResponse(resp), nil
 }
@@ -113,6 +382,7 @@ func (s *transactionHandler) GetTransactionStatus(ctx context.Context, req *conn
 // SetupRouter mounts the handler on a new chi Router and returns the router ready to be used.
 func (s *transactionHandler) SetupRouter() http.Handler {
 	r := chi.NewRouter()
+	r.Use(tracecontext.Middleware)
 
 	// Health check
 	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -130,6 +400,13 @@ func (s *transactionHandler) SetupRouter() http.Handler {
 	r.Handle(path+"*", handler)
 	r.Handle(trimmed+"/*", handler)
 
+	fxPath, fxHandler := transactionv1connect.NewFxServiceHandler(s)
+	fxTrimmed := strings.TrimRight(fxPath, "/")
+	r.Handle(fxPath, fxHandler)
+	r.Handle(fxTrimmed, fxHandler)
+	r.Handle(fxPath+"*", fxHandler)
+	r.Handle(fxTrimmed+"/*", fxHandler)
+
 	// Wrap with H2C
 	return h2c.NewHandler(r, &http2.Server{})
 }
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	connectgo "github.com/bufbuild/connect-go"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+
+	"FinTechPorto/internal/currency"
+	"FinTechPorto/internal/workflow"
+	"FinTechPorto/services/transaction/repository"
+)
+
+// Hold lifetime bounds for AuthorizeHold.
+const (
+	defaultHoldTTL = 7 * 24 * time.Hour
+	maxHoldTTL     = 30 * 24 * time.Hour
+)
+
+func (s *transactionHandler) AuthorizeHold(ctx context.Context, req *connectgo.Request[v1.AuthorizeHoldRequest]) (*connectgo.Response[v1.AuthorizeHoldResponse], error) {
+	slog.Info("AuthorizeHold called",
+		"account_id", req.Msg.AccountId,
+		"amount", req.Msg.Amount,
+		"currency", req.Msg.Currency,
+		"reference", req.Msg.Reference,
+	)
+
+	if err := currency.Validate(req.Msg.Currency); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	if req.Msg.Amount <= 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("amount must be positive"))
+	}
+	ttl := time.Duration(req.Msg.TtlSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = defaultHoldTTL
+	}
+	if ttl > maxHoldTTL {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("ttl_seconds exceeds 30 days"))
+	}
+
+	hold, available, err := s.repo.AuthorizeHold(ctx, req.Msg.AccountId, req.Msg.Amount, req.Msg.Currency, req.Msg.Reference, time.Now().Add(ttl))
+	if err != nil {
+		return nil, holdError(err)
+	}
+
+	// Release the hold automatically once it expires.
+	_, err = s.tclient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:        "hold-expiry-" + hold.ID,
+		TaskQueue: "transaction-task-queue",
+	}, workflow.HoldExpiryWorkflow, workflow.HoldExpiryParams{HoldID: hold.ID, ExpiresAt: hold.ExpiresAt})
+	if err != nil {
+		// A hold without an expiry timer would reserve funds forever; undo it.
+		slog.Error("failed to start hold expiry workflow", "hold_id", hold.ID, "error", err)
+		if _, verr := s.repo.VoidHold(ctx, hold.ID); verr != nil {
+			slog.Error("failed to void hold after expiry workflow error", "hold_id", hold.ID, "error", verr)
+		}
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+
+	resp := &v1.AuthorizeHoldResponse{
+		Hold:             toProtoHold(hold),
+		AvailableBalance: available,
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *transactionHandler) CaptureHold(ctx context.Context, req *connectgo.Request[v1.CaptureHoldRequest]) (*connectgo.Response[v1.CaptureHoldResponse], error) {
+	slog.Info("CaptureHold called", "hold_id", req.Msg.HoldId, "recipient_id", req.Msg.RecipientId, "amount", req.Msg.Amount)
+
+	if req.Msg.Amount < 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("amount must not be negative"))
+	}
+
+	hold, tr, err := s.repo.CaptureHold(ctx, req.Msg.HoldId, req.Msg.RecipientId, req.Msg.Amount, req.Msg.Memo, repository.CaptureChecks{
+		Sanctions: s.sanctions,
+		Approvals: s.approvals,
+		Risk:      s.risk,
+	})
+	if err != nil {
+		return nil, holdError(err)
+	}
+
+	// A capture held by screening or above the approval threshold waits for an approver
+	// like a transfer.
+	if tr.Status == "AWAITING_APPROVAL" {
+		slog.Warn("capture held for review", "hold_id", hold.ID, "transaction_id", tr.ID)
+		_, err := s.tclient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+			ID:                    tr.WorkflowID,
+			TaskQueue:             "transaction-task-queue",
+			WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		}, workflow.CaptureReviewWorkflow, workflow.CaptureParams{
+			HoldID: hold.ID,
+			Transfer: workflow.TransferParams{
+				TransactionID:   tr.ID,
+				SenderID:        tr.SenderID,
+				RecipientID:     tr.RecipientID,
+				Amount:          tr.Amount,
+				Currency:        tr.Currency,
+				InitiatorID:     tr.InitiatorID,
+				ApprovalTimeout: s.approvals.Timeout,
+			},
+		})
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if err != nil && !errors.As(err, &alreadyStarted) {
+			// Without its review workflow the capture would reserve the hold forever; undo it.
+			slog.Error("failed to start capture review workflow", "hold_id", hold.ID, "error", err)
+			if cerr := s.repo.CancelCapture(ctx, hold.ID, tr.ID, "capture review could not be started"); cerr != nil {
+				slog.Error("failed to cancel capture after review workflow error", "hold_id", hold.ID, "error", cerr)
+			}
+			return nil, connectgo.NewError(connectgo.CodeInternal, err)
+		}
+	}
+
+	resp := &v1.CaptureHoldResponse{
+		Hold:        toProtoHold(hold),
+		Transaction: toProtoTransaction(tr),
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *transactionHandler) VoidHold(ctx context.Context, req *connectgo.Request[v1.VoidHoldRequest]) (*connectgo.Response[v1.VoidHoldResponse], error) {
+	slog.Info("VoidHold called", "hold_id", req.Msg.HoldId)
+
+	hold, err := s.repo.VoidHold(ctx, req.Msg.HoldId)
+	if err != nil {
+		return nil, holdError(err)
+	}
+	return connectgo.NewResponse(&v1.VoidHoldResponse{Hold: toProtoHold(hold)}), nil
+}
+
+// holdError maps repository errors from hold operations to connect error codes.
+func holdError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrAccountNotFound), errors.Is(err, repository.ErrHoldNotFound):
+		return connectgo.NewError(connectgo.CodeNotFound, err)
+	case errors.Is(err, repository.ErrCurrencyMismatch), errors.Is(err, repository.ErrCaptureExceedsHold):
+		return connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	case errors.Is(err, repository.ErrInsufficientFunds), errors.Is(err, repository.ErrHoldNotActive), errors.Is(err, repository.ErrHoldExpired),
+		errors.Is(err, repository.ErrCaptureUnderReview):
+		return connectgo.NewError(connectgo.CodeFailedPrecondition, err)
+	case errors.Is(err, repository.ErrAccountFrozen), errors.Is(err, repository.ErrDebitBlocked),
+		errors.Is(err, repository.ErrCreditBlocked), errors.Is(err, repository.ErrAccountClosed):
+		return connectgo.NewError(connectgo.CodeFailedPrecondition, err)
+	case errors.Is(err, repository.ErrLimitExceeded):
+		return limitError(err)
+	}
+	slog.Error("hold request failed", "error", err)
+	return connectgo.NewError(connectgo.CodeInternal, err)
+}
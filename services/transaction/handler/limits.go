@@ -0,0 +1,36 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"errors"
+	"log/slog"
+
+	connectgo "github.com/bufbuild/connect-go"
+
+	"FinTechPorto/internal/limits"
+)
+
+// limitError maps a transfer limit rejection to RESOURCE_EXHAUSTED with a LimitViolation
+// detail carrying the remaining allowance.
+func limitError(err error) error {
+	cerr := connectgo.NewError(connectgo.CodeResourceExhausted, err)
+	var lerr *limits.Error
+	if !errors.As(err, &lerr) {
+		return cerr
+	}
+	detail, derr := connectgo.NewErrorDetail(&v1.LimitViolation{
+		Scope:     lerr.Scope,
+		ScopeId:   lerr.ScopeID,
+		LimitType: lerr.Kind,
+		Currency:  lerr.Currency,
+		Limit:     lerr.Limit,
+		Used:      lerr.Used,
+		Remaining: lerr.Remaining,
+	})
+	if derr != nil {
+		slog.Error("failed to attach limit violation", "error", derr)
+		return cerr
+	}
+	cerr.AddDetail(detail)
+	return cerr
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"context"
+	"errors"
+	"log/slog"
+
+	connectgo "github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"FinTechPorto/internal/risk"
+	"FinTechPorto/services/transaction/repository"
+)
+
+func (s *transactionHandler) GetRiskDecision(ctx context.Context, req *connectgo.Request[v1.GetRiskDecisionRequest]) (*connectgo.Response[v1.GetRiskDecisionResponse], error) {
+	d, err := s.repo.GetRiskDecision(ctx, req.Msg.TransactionId)
+	if err != nil {
+		if errors.Is(err, repository.ErrRiskDecisionNotFound) {
+			return nil, connectgo.NewError(connectgo.CodeNotFound, err)
+		}
+		slog.Error("failed to get risk decision", "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+
+	resp := &v1.GetRiskDecisionResponse{
+		TransactionId: d.TransactionID,
+		Outcome:       toProtoRiskOutcome(d.Outcome),
+		ScreenedAt:    timestamppb.New(d.CreatedAt),
+	}
+	for _, h := range d.Hits {
+		resp.Hits = append(resp.Hits, &v1.RiskRuleHit{Rule: h.Rule, Action: h.Action, Reason: h.Reason})
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+// toProtoRiskOutcome maps a stored risk outcome to the proto enum.
+func toProtoRiskOutcome(outcome string) v1.RiskOutcome {
+	switch outcome {
+	case risk.Allow:
+		return v1.RiskOutcome_RISK_OUTCOME_ALLOW
+	case risk.Review:
+		return v1.RiskOutcome_RISK_OUTCOME_REVIEW
+	case risk.Deny:
+		return v1.RiskOutcome_RISK_OUTCOME_DENY
+	}
+	return v1.RiskOutcome_RISK_OUTCOME_UNSPECIFIED
+}
+
+func (s *transactionHandler) ListScreeningHits(ctx context.Context, req *connectgo.Request[v1.ListScreeningHitsRequest]) (*connectgo.Response[v1.ListScreeningHitsResponse], error) {
+	tr, err := s.repo.GetTransactionByID(ctx, req.Msg.TransactionId)
+	if err != nil {
+		if errors.Is(err, repository.ErrTransactionNotFound) {
+			return nil, connectgo.NewError(connectgo.CodeNotFound, err)
+		}
+		slog.Error("failed to get transaction", "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	hits, err := s.repo.ListScreeningHits(ctx, tr.ID)
+	if err != nil {
+		slog.Error("failed to list screening hits", "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+
+	resp := &v1.ListScreeningHitsResponse{}
+	for _, h := range hits {
+		resp.Hits = append(resp.Hits, &v1.ScreeningHit{
+			Party:       h.Party,
+			AccountId:   h.AccountID,
+			HolderName:  h.HolderName,
+			EntryUid:    h.EntryUID,
+			EntryName:   h.EntryName,
+			MatchedName: h.MatchedName,
+			Score:       h.Score,
+			ScreenedAt:  timestamppb.New(h.CreatedAt),
+		})
+	}
+	return connectgo.NewResponse(resp), nil
+}
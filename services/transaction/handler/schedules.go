@@ -0,0 +1,317 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	connectgo "github.com/bufbuild/connect-go"
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"FinTechPorto/internal/currency"
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/workflow"
+	"FinTechPorto/services/transaction/repository"
+)
+
+func (s *transactionHandler) CreateScheduledTransfer(ctx context.Context, req *connectgo.Request[v1.CreateScheduledTransferRequest]) (*connectgo.Response[v1.CreateScheduledTransferResponse], error) {
+	slog.Info("CreateScheduledTransfer called",
+		"sender_id", req.Msg.SenderId,
+		"recipient_id", req.Msg.RecipientId,
+		"amount", req.Msg.Amount,
+		"currency", req.Msg.Currency,
+		"cron_expression", req.Msg.CronExpression,
+		"idempotency_key", req.Msg.IdempotencyKey,
+	)
+
+	if err := currency.Validate(req.Msg.Currency); err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	}
+	if req.Msg.Amount <= 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("amount must be positive"))
+	}
+	if req.Msg.SenderId == req.Msg.RecipientId {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("sender and recipient must differ"))
+	}
+	if req.Msg.CronExpression == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("cron_expression is required"))
+	}
+	if req.Msg.TimeZone != "" {
+		if _, err := time.LoadLocation(req.Msg.TimeZone); err != nil {
+			return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+		}
+	}
+	if req.Msg.MaxRuns < 0 {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("max_runs must not be negative"))
+	}
+	if req.Msg.EndAt != nil && !req.Msg.EndAt.AsTime().After(time.Now()) {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("end_at must be in the future"))
+	}
+	if req.Msg.StartAt != nil && req.Msg.EndAt != nil && !req.Msg.EndAt.AsTime().After(req.Msg.StartAt.AsTime()) {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("end_at must be after start_at"))
+	}
+
+	key := req.Msg.IdempotencyKey
+	if key == "" {
+		key = uuid.New().String()
+	}
+
+	sched := &models.TransferSchedule{
+		SenderID:            req.Msg.SenderId,
+		RecipientID:         req.Msg.RecipientId,
+		Amount:              req.Msg.Amount,
+		Currency:            req.Msg.Currency,
+		Memo:                req.Msg.GetMemo(),
+		CronExpression:      req.Msg.CronExpression,
+		TimeZone:            req.Msg.TimeZone,
+		MaxRuns:             int(req.Msg.MaxRuns),
+		OnInsufficientFunds: fromProtoInsufficientFundsPolicy(req.Msg.OnInsufficientFunds),
+	}
+	if req.Msg.StartAt != nil {
+		t := req.Msg.StartAt.AsTime()
+		sched.StartAt = &t
+	}
+	if req.Msg.EndAt != nil {
+		t := req.Msg.EndAt.AsTime()
+		sched.EndAt = &t
+	}
+
+	replayed, err := s.repo.CreateSchedule(ctx, sched, key, scheduleFingerprint(req.Msg))
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+	if replayed {
+		slog.Info("idempotent replay of CreateScheduledTransfer", "schedule_id", sched.ID)
+		if sched.Status != "ACTIVE" {
+			return connectgo.NewResponse(&v1.CreateScheduledTransferResponse{Schedule: toProtoSchedule(sched)}), nil
+		}
+	}
+
+	// A replay still attempts the create in case the original request stored the
+	// schedule but failed before registering it with Temporal.
+	if err := s.startSchedule(ctx, sched); err != nil && !errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+		slog.Error("failed to create temporal schedule", "schedule_id", sched.ID, "error", err)
+		if !replayed {
+			// The schedule would never fire; cancel it so it does not look active.
+			if _, cerr := s.repo.CancelSchedule(ctx, sched.ID); cerr != nil {
+				slog.Error("failed to cancel schedule after temporal error", "schedule_id", sched.ID, "error", cerr)
+			}
+		}
+		var invalid *serviceerror.InvalidArgument
+		if errors.As(err, &invalid) {
+			return nil, connectgo.NewError(connectgo.CodeInvalidArgument, err)
+		}
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+
+	return connectgo.NewResponse(&v1.CreateScheduledTransferResponse{Schedule: toProtoSchedule(sched)}), nil
+}
+
+// startSchedule registers sched with Temporal. Each firing starts a ScheduledTransferWorkflow.
+func (s *transactionHandler) startSchedule(ctx context.Context, sched *models.TransferSchedule) error {
+	spec := client.ScheduleSpec{
+		CronExpressions: []string{sched.CronExpression},
+		TimeZoneName:    sched.TimeZone,
+	}
+	if sched.StartAt != nil {
+		spec.StartAt = *sched.StartAt
+	}
+	if sched.EndAt != nil {
+		spec.EndAt = *sched.EndAt
+	}
+
+	_, err := s.tclient.ScheduleClient().Create(ctx, client.ScheduleOptions{
+		ID:   sched.TemporalScheduleID,
+		Spec: spec,
+		Action: &client.ScheduleWorkflowAction{
+			ID:        "scheduled-transfer-" + sched.ID,
+			Workflow:  workflow.ScheduledTransferWorkflow,
+			Args:      []interface{}{sched.ID},
+			TaskQueue: "transaction-task-queue",
+		},
+		RemainingActions: sched.MaxRuns,
+	})
+	return err
+}
+
+func (s *transactionHandler) ListSchedules(ctx context.Context, req *connectgo.Request[v1.ListSchedulesRequest]) (*connectgo.Response[v1.ListSchedulesResponse], error) {
+	if req.Msg.SenderId == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("sender_id is required"))
+	}
+
+	schedules, err := s.repo.ListSchedules(ctx, req.Msg.SenderId)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	resp := &v1.ListSchedulesResponse{}
+	for i := range schedules {
+		resp.Schedules = append(resp.Schedules, toProtoSchedule(&schedules[i]))
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *transactionHandler) CancelSchedule(ctx context.Context, req *connectgo.Request[v1.CancelScheduleRequest]) (*connectgo.Response[v1.CancelScheduleResponse], error) {
+	slog.Info("CancelSchedule called", "schedule_id", req.Msg.ScheduleId)
+
+	sched, err := s.repo.CancelSchedule(ctx, req.Msg.ScheduleId)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	// Runs that still fire see the CANCELLED status and skip, so a failed delete is only logged.
+	if err := s.tclient.ScheduleClient().GetHandle(ctx, sched.TemporalScheduleID).Delete(ctx); err != nil {
+		var notFound *serviceerror.NotFound
+		if !errors.As(err, &notFound) {
+			slog.Error("failed to delete temporal schedule", "schedule_id", sched.ID, "error", err)
+		}
+	}
+
+	return connectgo.NewResponse(&v1.CancelScheduleResponse{Schedule: toProtoSchedule(sched)}), nil
+}
+
+func (s *transactionHandler) ListScheduleRuns(ctx context.Context, req *connectgo.Request[v1.ListScheduleRunsRequest]) (*connectgo.Response[v1.ListScheduleRunsResponse], error) {
+	if _, err := s.repo.GetSchedule(ctx, req.Msg.ScheduleId); err != nil {
+		return nil, scheduleError(err)
+	}
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	runs, err := s.repo.ListScheduleRuns(ctx, req.Msg.ScheduleId, pageSize)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	resp := &v1.ListScheduleRunsResponse{}
+	for i := range runs {
+		resp.Runs = append(resp.Runs, toProtoScheduleRun(&runs[i]))
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+// scheduleFingerprint hashes the fields that define a schedule so replays of an
+// idempotency key can be compared with the request that first claimed it.
+func scheduleFingerprint(m *v1.CreateScheduledTransferRequest) string {
+	var startAt, endAt string
+	if m.StartAt != nil {
+		startAt = m.StartAt.AsTime().UTC().Format(time.RFC3339Nano)
+	}
+	if m.EndAt != nil {
+		endAt = m.EndAt.AsTime().UTC().Format(time.RFC3339Nano)
+	}
+	return fingerprint(m.SenderId, m.RecipientId, strconv.FormatInt(m.Amount, 10), m.Currency, m.GetMemo(),
+		m.CronExpression, m.TimeZone, startAt, endAt, strconv.Itoa(int(m.MaxRuns)), m.OnInsufficientFunds.String())
+}
+
+// scheduleError maps repository errors from schedule operations to connect error codes.
+func scheduleError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrAccountNotFound), errors.Is(err, repository.ErrScheduleNotFound):
+		return connectgo.NewError(connectgo.CodeNotFound, err)
+	case errors.Is(err, repository.ErrCurrencyMismatch):
+		return connectgo.NewError(connectgo.CodeInvalidArgument, err)
+	case errors.Is(err, repository.ErrIdempotencyKeyConflict):
+		return connectgo.NewError(connectgo.CodeAlreadyExists, err)
+	case errors.Is(err, repository.ErrScheduleNotActive):
+		return connectgo.NewError(connectgo.CodeFailedPrecondition, err)
+	}
+	slog.Error("schedule request failed", "error", err)
+	return connectgo.NewError(connectgo.CodeInternal, err)
+}
+
+// fromProtoInsufficientFundsPolicy maps the proto policy to its stored form; unspecified means SKIP.
+func fromProtoInsufficientFundsPolicy(p v1.InsufficientFundsPolicy) string {
+	if p == v1.InsufficientFundsPolicy_INSUFFICIENT_FUNDS_POLICY_RETRY {
+		return "RETRY"
+	}
+	return "SKIP"
+}
+
+// toProtoScheduleStatus maps a stored schedule status to the proto enum.
+func toProtoScheduleStatus(status string) v1.ScheduleStatus {
+	switch status {
+	case "ACTIVE":
+		return v1.ScheduleStatus_SCHEDULE_STATUS_ACTIVE
+	case "COMPLETED":
+		return v1.ScheduleStatus_SCHEDULE_STATUS_COMPLETED
+	case "CANCELLED":
+		return v1.ScheduleStatus_SCHEDULE_STATUS_CANCELLED
+	}
+	return v1.ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED
+}
+
+// toProtoScheduleRunStatus maps a stored run status to the proto enum.
+func toProtoScheduleRunStatus(status string) v1.ScheduleRunStatus {
+	switch status {
+	case "PENDING":
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_PENDING
+	case "COMPLETED":
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_COMPLETED
+	case "FAILED":
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_FAILED
+	case "SKIPPED":
+		return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_SKIPPED
+	}
+	return v1.ScheduleRunStatus_SCHEDULE_RUN_STATUS_UNSPECIFIED
+}
+
+// toProtoSchedule converts a stored schedule to its proto representation.
+func toProtoSchedule(sched *models.TransferSchedule) *v1.TransferSchedule {
+	ps := &v1.TransferSchedule{
+		ScheduleId:     sched.ID,
+		SenderId:       sched.SenderID,
+		RecipientId:    sched.RecipientID,
+		Amount:         sched.Amount,
+		Currency:       sched.Currency,
+		CronExpression: sched.CronExpression,
+		TimeZone:       sched.TimeZone,
+		MaxRuns:        int32(sched.MaxRuns),
+		RunCount:       int32(sched.RunCount),
+		Status:         toProtoScheduleStatus(sched.Status),
+		CreatedAt:      timestamppb.New(sched.CreatedAt),
+	}
+	if sched.Memo != "" {
+		memo := sched.Memo
+		ps.Memo = &memo
+	}
+	if sched.StartAt != nil {
+		ps.StartAt = timestamppb.New(*sched.StartAt)
+	}
+	if sched.EndAt != nil {
+		ps.EndAt = timestamppb.New(*sched.EndAt)
+	}
+	switch sched.OnInsufficientFunds {
+	case "SKIP":
+		ps.OnInsufficientFunds = v1.InsufficientFundsPolicy_INSUFFICIENT_FUNDS_POLICY_SKIP
+	case "RETRY":
+		ps.OnInsufficientFunds = v1.InsufficientFundsPolicy_INSUFFICIENT_FUNDS_POLICY_RETRY
+	}
+	return ps
+}
+
+// toProtoScheduleRun converts a stored schedule run to its proto representation.
+func toProtoScheduleRun(run *models.ScheduleRun) *v1.ScheduleRun {
+	return &v1.ScheduleRun{
+		RunId:         run.ID,
+		ScheduleId:    run.ScheduleID,
+		TransactionId: run.TransactionID,
+		Status:        toProtoScheduleRunStatus(run.Status),
+		Attempts:      int32(run.Attempts),
+		FailureReason: run.FailureReason,
+		CreatedAt:     timestamppb.New(run.CreatedAt),
+		UpdatedAt:     timestamppb.New(run.UpdatedAt),
+	}
+}
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	v1 "FinTechPorto/gen/api/transaction/v1"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	connectgo "github.com/bufbuild/connect-go"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/webhook"
+	"FinTechPorto/services/transaction/repository"
+)
+
+func (s *transactionHandler) CreateWebhookEndpoint(ctx context.Context, req *connectgo.Request[v1.CreateWebhookEndpointRequest]) (*connectgo.Response[v1.CreateWebhookEndpointResponse], error) {
+	slog.Info("CreateWebhookEndpoint called",
+		"merchant_id", req.Msg.MerchantId,
+		"url", req.Msg.Url,
+		"event_types", req.Msg.EventTypes,
+	)
+
+	if req.Msg.MerchantId == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("merchant_id is required"))
+	}
+	u, err := url.Parse(req.Msg.Url)
+	// Payloads carry account activity, so they are only sent encrypted.
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("url must be an absolute https URL"))
+	}
+	for _, t := range req.Msg.EventTypes {
+		if strings.TrimSpace(t) == "" {
+			return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("event_types must not contain empty entries"))
+		}
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	ep := &models.WebhookEndpoint{
+		MerchantID: req.Msg.MerchantId,
+		URL:        u.String(),
+		Secret:     secret,
+		EventTypes: req.Msg.EventTypes,
+	}
+	if err := s.repo.CreateWebhookEndpoint(ctx, ep); err != nil {
+		return nil, webhookError(err)
+	}
+	return connectgo.NewResponse(&v1.CreateWebhookEndpointResponse{
+		Endpoint: toProtoWebhookEndpoint(ep),
+		Secret:   secret,
+	}), nil
+}
+
+func (s *transactionHandler) ListWebhookEndpoints(ctx context.Context, req *connectgo.Request[v1.ListWebhookEndpointsRequest]) (*connectgo.Response[v1.ListWebhookEndpointsResponse], error) {
+	if req.Msg.MerchantId == "" {
+		return nil, connectgo.NewError(connectgo.CodeInvalidArgument, errors.New("merchant_id is required"))
+	}
+
+	endpoints, err := s.repo.ListWebhookEndpoints(ctx, req.Msg.MerchantId)
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	resp := &v1.ListWebhookEndpointsResponse{}
+	for i := range endpoints {
+		resp.Endpoints = append(resp.Endpoints, toProtoWebhookEndpoint(&endpoints[i]))
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *transactionHandler) DisableWebhookEndpoint(ctx context.Context, req *connectgo.Request[v1.DisableWebhookEndpointRequest]) (*connectgo.Response[v1.DisableWebhookEndpointResponse], error) {
+	slog.Info("DisableWebhookEndpoint called", "endpoint_id", req.Msg.EndpointId)
+
+	ep, err := s.repo.DisableWebhookEndpoint(ctx, req.Msg.EndpointId)
+	if err != nil {
+		return nil, webhookError(err)
+	}
+	return connectgo.NewResponse(&v1.DisableWebhookEndpointResponse{Endpoint: toProtoWebhookEndpoint(ep)}), nil
+}
+
+func (s *transactionHandler) ListWebhookDeliveries(ctx context.Context, req *connectgo.Request[v1.ListWebhookDeliveriesRequest]) (*connectgo.Response[v1.ListWebhookDeliveriesResponse], error) {
+	if _, err := s.repo.GetWebhookEndpoint(ctx, req.Msg.EndpointId); err != nil {
+		return nil, webhookError(err)
+	}
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	deliveries, err := s.repo.ListWebhookDeliveries(ctx, req.Msg.EndpointId, pageSize)
+	if err != nil {
+		return nil, webhookError(err)
+	}
+
+	resp := &v1.ListWebhookDeliveriesResponse{}
+	for i := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, toProtoWebhookDelivery(&deliveries[i]))
+	}
+	return connectgo.NewResponse(resp), nil
+}
+
+func (s *transactionHandler) ReplayWebhookDelivery(ctx context.Context, req *connectgo.Request[v1.ReplayWebhookDeliveryRequest]) (*connectgo.Response[v1.ReplayWebhookDeliveryResponse], error) {
+	slog.Info("ReplayWebhookDelivery called", "delivery_id", req.Msg.DeliveryId)
+
+	d, err := s.repo.ReplayWebhookDelivery(ctx, req.Msg.DeliveryId)
+	if errors.Is(err, repository.ErrWebhookDeliveryPending) {
+		d, err = s.stalledWebhookDelivery(ctx, req.Msg.DeliveryId)
+	}
+	if err != nil {
+		return nil, webhookError(err)
+	}
+	if err := webhook.StartDelivery(ctx, s.tclient, d.ID, d.Replays); err != nil {
+		slog.Error("failed to start webhook replay", "delivery_id", d.ID, "error", err)
+		return nil, connectgo.NewError(connectgo.CodeInternal, err)
+	}
+	return connectgo.NewResponse(&v1.ReplayWebhookDeliveryResponse{Delivery: toProtoWebhookDelivery(d)}), nil
+}
+
+// stalledWebhookDelivery returns a PENDING delivery whose workflow was never started,
+// such as a replay that failed to start it, so that replaying it starts the workflow
+// again. A delivery still being sent is ErrWebhookDeliveryPending.
+func (s *transactionHandler) stalledWebhookDelivery(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	d, err := s.repo.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.tclient.DescribeWorkflowExecution(ctx, webhook.DeliveryWorkflowID(d.ID, d.Replays), "")
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return d, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe webhook delivery workflow: %w", err)
+	}
+	return nil, repository.ErrWebhookDeliveryPending
+}
+
+// webhookError maps repository errors from webhook operations to connect error codes.
+func webhookError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrWebhookEndpointNotFound), errors.Is(err, repository.ErrWebhookDeliveryNotFound):
+		return connectgo.NewError(connectgo.CodeNotFound, err)
+	case errors.Is(err, repository.ErrWebhookEndpointDisabled), errors.Is(err, repository.ErrWebhookDeliveryPending):
+		return connectgo.NewError(connectgo.CodeFailedPrecondition, err)
+	}
+	slog.Error("webhook request failed", "error", err)
+	return connectgo.NewError(connectgo.CodeInternal, err)
+}
+
+// toProtoWebhookEndpoint converts an endpoint to proto; the secret is never included.
+func toProtoWebhookEndpoint(ep *models.WebhookEndpoint) *v1.WebhookEndpoint {
+	status := v1.WebhookEndpointStatus_WEBHOOK_ENDPOINT_STATUS_UNSPECIFIED
+	switch ep.Status {
+	case "ACTIVE":
+		status = v1.WebhookEndpointStatus_WEBHOOK_ENDPOINT_STATUS_ACTIVE
+	case "DISABLED":
+		status = v1.WebhookEndpointStatus_WEBHOOK_ENDPOINT_STATUS_DISABLED
+	}
+	return &v1.WebhookEndpoint{
+		EndpointId: ep.ID,
+		MerchantId: ep.MerchantID,
+		Url:        ep.URL,
+		EventTypes: ep.EventTypes,
+		Status:     status,
+		CreatedAt:  timestamppb.New(ep.CreatedAt),
+	}
+}
+
+// toProtoWebhookDelivery converts a delivery to proto.
+func toProtoWebhookDelivery(d *models.WebhookDelivery) *v1.WebhookDelivery {
+	status := v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	switch d.Status {
+	case "PENDING":
+		status = v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case "DELIVERED":
+		status = v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED
+	case "FAILED":
+		status = v1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	}
+	out := &v1.WebhookDelivery{
+		DeliveryId:     d.ID,
+		EndpointId:     d.EndpointID,
+		EventId:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        string(d.Payload),
+		Status:         status,
+		Attempts:       int32(d.Attempts),
+		LastStatusCode: int32(d.LastStatusCode),
+		LastError:      d.LastError,
+		Replays:        int32(d.Replays),
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+	if d.DeliveredAt != nil {
+		out.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+	return out
+}
@@ -1,21 +1,35 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"FinTechPorto/services/transaction/handler"
 	"FinTechPorto/services/transaction/repository"
 
+	"FinTechPorto/internal/approval"
 	"FinTechPorto/internal/broker"
 	"FinTechPorto/internal/database"
+	"FinTechPorto/internal/fee"
+	"FinTechPorto/internal/fx"
+	"FinTechPorto/internal/outbox"
+	"FinTechPorto/internal/risk"
+	"FinTechPorto/internal/screening"
+	"FinTechPorto/internal/tracecontext"
+	"FinTechPorto/internal/webhook"
 	"FinTechPorto/internal/workflow"
 	"strings"
 
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/worker"
+	temporalworkflow "go.temporal.io/sdk/workflow"
 )
 
 func main() {
@@ -32,30 +46,82 @@ func main() {
 	var kafkaWriter *broker.KafkaWriter
 	brokersEnv := os.Getenv("KAFKA_BROKERS")
 	topic := os.Getenv("KAFKA_TOPIC")
+	writerCfg, err := broker.LoadWriterConfig()
+	if err != nil {
+		slog.Error("failed to configure kafka writer", "error", err)
+		os.Exit(1)
+	}
 	if brokersEnv != "" && topic != "" {
 		brokers := strings.Split(brokersEnv, ",")
-		kafkaWriter = broker.NewKafkaWriter(brokers, topic)
+		kafkaWriter = broker.NewKafkaWriter(brokers, topic, writerCfg)
 		slog.Info("kafka writer initialized", "brokers", brokers, "topic", topic)
 	} else {
 		slog.Info("kafka not configured; proceeding without broker")
 	}
 
 	// Initialize Temporal client
-	c, err := client.NewClient(client.Options{})
+	c, err := client.NewClient(client.Options{
+		ContextPropagators: []temporalworkflow.ContextPropagator{tracecontext.Propagator{}},
+	})
 	if err != nil {
 		slog.Error("failed to create temporal client", "error", err)
 		os.Exit(1)
 	}
 	defer c.Close()
 
+	// Load the fee schedule shared by the handler and scheduled runs
+	fees, err := loadFeeSchedule()
+	if err != nil {
+		slog.Error("failed to load fee schedule", "error", err)
+		os.Exit(1)
+	}
+
+	// Load the risk rules screened before every transfer debit
+	riskEngine, err := loadRiskEngine()
+	if err != nil {
+		slog.Error("failed to load risk rules", "error", err)
+		os.Exit(1)
+	}
+
+	// Load the sanctions list; it is reloaded in the background when the file changes
+	sanctions, sanctionsReload, err := loadSanctions()
+	if err != nil {
+		slog.Error("failed to load sanctions list", "error", err)
+		os.Exit(1)
+	}
+
+	webhooks, err := loadWebhookSender()
+	if err != nil {
+		slog.Error("failed to configure webhooks", "error", err)
+		os.Exit(1)
+	}
+
+	// Load the maker-checker thresholds shared by the handler and scheduled runs
+	approvals, err := loadApprovalPolicy()
+	if err != nil {
+		slog.Error("failed to load approval policy", "error", err)
+		os.Exit(1)
+	}
+
 	// Start worker
 	w := worker.New(c, "transaction-task-queue", worker.Options{})
 	// register workflow and activities
 	w.RegisterWorkflow(workflow.TransferWorkflow)
+	w.RegisterWorkflow(workflow.RefundWorkflow)
+	w.RegisterWorkflow(workflow.HoldExpiryWorkflow)
+	w.RegisterWorkflow(workflow.CaptureReviewWorkflow)
+	w.RegisterWorkflow(workflow.ScheduledTransferWorkflow)
+	w.RegisterWorkflow(workflow.BatchTransferWorkflow)
+	w.RegisterWorkflow(workflow.BatchItemWorkflow)
+	w.RegisterWorkflow(workflow.OverdraftAccrualWorkflow)
+	w.RegisterWorkflow(workflow.WebhookDeliveryWorkflow)
 	w.RegisterActivity(&workflow.Activities{
-		DB:     database.DB,
-		Broker: kafkaWriter,
-		Topic:  topic,
+		DB:        database.DB,
+		Fees:      fees,
+		Risk:      riskEngine,
+		Sanctions: sanctions,
+		Webhooks:  webhooks,
+		Approvals: approvals,
 	})
 
 	// Start worker in background
@@ -65,9 +131,58 @@ func main() {
 	}
 	defer w.Stop()
 
+	// Accrue overdraft interest daily
+	if err := ensureOverdraftAccrual(context.Background(), c); err != nil {
+		slog.Error("failed to schedule overdraft accrual", "error", err)
+		os.Exit(1)
+	}
+
+	// Relay outbox events to Kafka in the background
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if kafkaWriter != nil {
+		relay := outbox.NewRelay(database.DB, kafkaWriter)
+		if v := os.Getenv("OUTBOX_MAX_ATTEMPTS"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				slog.Error("invalid OUTBOX_MAX_ATTEMPTS", "value", v)
+				os.Exit(1)
+			}
+			relay.MaxAttempts = n
+		}
+		go relay.Run(ctx)
+	}
+	if sanctions != nil {
+		go sanctions.Watch(ctx, sanctionsReload)
+	}
+	// Turn broker events into merchant webhook deliveries
+	if kafkaWriter != nil {
+		group := os.Getenv("WEBHOOK_CONSUMER_GROUP")
+		if group == "" {
+			group = "webhook-dispatcher"
+		}
+		consumerCfg, err := broker.LoadConsumerConfig(strings.Split(brokersEnv, ","), topic, group)
+		if err != nil {
+			slog.Error("failed to configure webhook consumer", "error", err)
+			os.Exit(1)
+		}
+		consumer := broker.NewConsumer(consumerCfg)
+		defer consumer.Close()
+		go func() {
+			if err := webhook.NewDispatcher(database.DB, c).Run(ctx, consumer); err != nil {
+				slog.Error("webhook dispatcher stopped", "error", err)
+			}
+		}()
+	}
+
 	// Initialize repository and handler
-	repo := repository.New(database.DB, kafkaWriter)
-	h := handler.NewHandler(repo, c)
+	repo := repository.New(database.DB)
+	book, err := loadFxBook(context.Background(), repo)
+	if err != nil {
+		slog.Error("failed to load fx rates", "error", err)
+		os.Exit(1)
+	}
+	h := handler.NewHandler(repo, c, book, fees, approvals, sanctions, riskEngine)
 
 	// Use handler's router which includes health and the ConnectRPC service
 	h2cHandler := h.SetupRouter()
@@ -101,3 +216,183 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// loadFxBook builds the FX rate book from FX_SPREAD_BPS, FX_QUOTE_TTL and the optional
+// FX_RATES_FILE. Rates set through SetFxRate are stored and override the file.
+func loadFxBook(ctx context.Context, repo *repository.Repository) (*fx.Book, error) {
+	spreadBps := int64(50)
+	if v := os.Getenv("FX_SPREAD_BPS"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err == nil {
+			err = fx.ValidateSpread(n)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid FX_SPREAD_BPS %q: %w", v, err)
+		}
+		spreadBps = n
+	}
+	quoteTTL := time.Minute
+	if v := os.Getenv("FX_QUOTE_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid FX_QUOTE_TTL %q", v)
+		}
+		quoteTTL = d
+	}
+
+	book := fx.NewBook(spreadBps, quoteTTL)
+	if path := os.Getenv("FX_RATES_FILE"); path != "" {
+		if err := book.LoadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	stored, err := repo.ListFxRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range stored {
+		if err := book.Set(r); err != nil {
+			return nil, err
+		}
+	}
+	slog.Info("fx rate book loaded", "rates", len(book.Rates()), "spread_bps", book.SpreadBps(), "quote_ttl", quoteTTL)
+	return book, nil
+}
+
+// loadFeeSchedule reads the fee schedule from FEE_SCHEDULE_FILE. Without one no fees
+// are charged.
+func loadFeeSchedule() (*fee.Schedule, error) {
+	path := os.Getenv("FEE_SCHEDULE_FILE")
+	if path == "" {
+		slog.Info("fee schedule not configured; transfers are free")
+		return &fee.Schedule{}, nil
+	}
+	return fee.LoadFile(path)
+}
+
+// loadRiskEngine reads the risk rules from RISK_RULES_FILE. Without one every transfer
+// is allowed.
+func loadRiskEngine() (*risk.Engine, error) {
+	path := os.Getenv("RISK_RULES_FILE")
+	if path == "" {
+		slog.Info("risk rules not configured; transfers are not screened")
+		return risk.NewEngine(), nil
+	}
+	return risk.LoadFile(path)
+}
+
+// loadSanctions opens the watchlist named by SANCTIONS_LIST_FILE, matching at
+// SANCTIONS_MATCH_THRESHOLD, and returns how often to check it for changes from
+// SANCTIONS_RELOAD_INTERVAL. Without a file no one is screened.
+func loadSanctions() (*screening.Screener, time.Duration, error) {
+	path := os.Getenv("SANCTIONS_LIST_FILE")
+	if path == "" {
+		slog.Info("sanctions list not configured; counterparties are not screened")
+		return nil, 0, nil
+	}
+	threshold := screening.DefaultThreshold
+	if v := os.Getenv("SANCTIONS_MATCH_THRESHOLD"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f <= 0 || f > 1 {
+			return nil, 0, fmt.Errorf("invalid SANCTIONS_MATCH_THRESHOLD %q", v)
+		}
+		threshold = f
+	}
+	reload := time.Minute
+	if v := os.Getenv("SANCTIONS_RELOAD_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, 0, fmt.Errorf("invalid SANCTIONS_RELOAD_INTERVAL %q", v)
+		}
+		reload = d
+	}
+	s, err := screening.NewScreener(path, threshold)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s, reload, nil
+}
+
+// ensureOverdraftAccrual registers the Temporal schedule that runs OverdraftAccrualWorkflow
+// on OVERDRAFT_ACCRUAL_CRON, UTC, defaulting to 01:00 every day. An existing schedule is
+// updated to the configured cron.
+func ensureOverdraftAccrual(ctx context.Context, c client.Client) error {
+	cron := os.Getenv("OVERDRAFT_ACCRUAL_CRON")
+	if cron == "" {
+		cron = "0 1 * * *"
+	}
+	spec := client.ScheduleSpec{CronExpressions: []string{cron}}
+
+	_, err := c.ScheduleClient().Create(ctx, client.ScheduleOptions{
+		ID:   workflow.OverdraftAccrualScheduleID,
+		Spec: spec,
+		Action: &client.ScheduleWorkflowAction{
+			ID:        workflow.OverdraftAccrualScheduleID,
+			Workflow:  workflow.OverdraftAccrualWorkflow,
+			Args:      []interface{}{workflow.OverdraftAccrualParams{}},
+			TaskQueue: "transaction-task-queue",
+		},
+	})
+	if errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+		err = c.ScheduleClient().GetHandle(ctx, workflow.OverdraftAccrualScheduleID).Update(ctx, client.ScheduleUpdateOptions{
+			DoUpdate: func(in client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+				in.Description.Schedule.Spec = &spec
+				return &client.ScheduleUpdate{Schedule: &in.Description.Schedule}, nil
+			},
+		})
+	}
+	if err != nil {
+		return err
+	}
+	slog.Info("overdraft accrual scheduled", "cron", cron)
+	return nil
+}
+
+// loadWebhookSender builds the webhook sender, timing requests out after
+// WEBHOOK_TIMEOUT (default 10s).
+func loadWebhookSender() (*webhook.Sender, error) {
+	timeout := 10 * time.Second
+	if v := os.Getenv("WEBHOOK_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid WEBHOOK_TIMEOUT %q", v)
+		}
+		timeout = d
+	}
+	return webhook.NewSender(timeout), nil
+}
+
+// loadApprovalPolicy reads APPROVAL_THRESHOLD and APPROVAL_TIMEOUT. The threshold is
+// either a single amount in minor units for every currency or a list such as
+// "USD=1000000,IDR=15000000000"; without one no transfer needs approval.
+func loadApprovalPolicy() (approval.Policy, error) {
+	policy := approval.Policy{
+		Thresholds: make(map[string]int64),
+		Timeout:    24 * time.Hour,
+	}
+	if v := os.Getenv("APPROVAL_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return policy, fmt.Errorf("invalid APPROVAL_TIMEOUT %q", v)
+		}
+		policy.Timeout = d
+	}
+
+	for _, entry := range strings.Split(os.Getenv("APPROVAL_THRESHOLD"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		code, amount := approval.AnyCurrency, entry
+		if k, v, ok := strings.Cut(entry, "="); ok {
+			code, amount = strings.ToUpper(strings.TrimSpace(k)), strings.TrimSpace(v)
+		}
+		n, err := strconv.ParseInt(amount, 10, 64)
+		if err != nil || n < 0 {
+			return policy, fmt.Errorf("invalid APPROVAL_THRESHOLD entry %q", entry)
+		}
+		policy.Thresholds[code] = n
+	}
+	return policy, nil
+}
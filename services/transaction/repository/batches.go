@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"FinTechPorto/internal/ledger"
+	"FinTechPorto/internal/limits"
+	"FinTechPorto/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrBatchNotFound is returned when a batch transfer cannot be found.
+	ErrBatchNotFound = errors.New("batch not found")
+	// ErrInvalidBatch is returned when one or more items of a batch fail validation.
+	ErrInvalidBatch = errors.New("invalid batch")
+)
+
+// ItemError describes why one batch item failed validation.
+type ItemError struct {
+	Seq    int
+	Reason string
+}
+
+// BatchValidationError lists every invalid item of a batch. It matches ErrInvalidBatch.
+type BatchValidationError struct {
+	Items []ItemError
+}
+
+func (e *BatchValidationError) Error() string {
+	parts := make([]string, 0, len(e.Items))
+	for _, it := range e.Items {
+		parts = append(parts, fmt.Sprintf("item %d: %s", it.Seq, it.Reason))
+	}
+	return ErrInvalidBatch.Error() + ": " + strings.Join(parts, "; ")
+}
+
+func (e *BatchValidationError) Unwrap() error {
+	return ErrInvalidBatch
+}
+
+// BatchItemStatus is a batch item together with the status of its transaction.
+type BatchItemStatus struct {
+	models.BatchItem `gorm:"embedded"`
+	Status           string
+	FailureReason    string
+}
+
+// BatchCounts tallies the items of a batch by transaction status.
+type BatchCounts struct {
+	Pending   int
+	Completed int
+	Failed    int
+	Reversed  int
+}
+
+// CreateBatch validates every item of batch against the accounts it touches, then stores
+// the batch, its items and a PENDING transaction per item in one DB transaction. Items
+// are numbered from 1 in the order given. All invalid items are reported together in a
+// *BatchValidationError. If the key was already claimed with the same fingerprint, the
+// original batch is loaded into batch and replayed is true.
+func (r *Repository) CreateBatch(ctx context.Context, batch *models.TransferBatch, items []models.BatchItem, key, fingerprint string) (bool, error) {
+	if _, err := uuid.Parse(batch.SenderID); err != nil {
+		return false, ErrAccountNotFound
+	}
+
+	var replayed bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		batch.ID = uuid.New().String()
+		batch.Status = "PENDING"
+		batch.WorkflowID = "batch-" + batch.ID
+
+		existingID, err := claimIdempotencyKey(tx, "batch:"+batch.SenderID, key, fingerprint, batch.ID)
+		if err != nil {
+			return err
+		}
+		if existingID != "" {
+			replayed = true
+			if err := tx.Where("id = ?", existingID).First(batch).Error; err != nil {
+				return fmt.Errorf("failed to load original batch: %w", err)
+			}
+			return nil
+		}
+
+		var sender models.Account
+		if err := tx.Where("id = ? AND currency = ?", batch.SenderID, batch.Currency).First(&sender).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to query sender: %w", err)
+		}
+
+		if err := ledger.CheckDebit(&sender); err != nil {
+			return err
+		}
+
+		recipients, err := loadRecipients(tx, items)
+		if err != nil {
+			return err
+		}
+
+		var verr BatchValidationError
+		batch.ItemCount = len(items)
+		batch.TotalAmount, batch.TotalFee = 0, 0
+		for i := range items {
+			it := &items[i]
+			it.Seq = i + 1
+			acc, ok := recipients[it.RecipientID]
+			var creditErr error
+			if ok {
+				creditErr = ledger.CheckCredit(&acc)
+			}
+			switch {
+			case it.Amount <= 0:
+				verr.Items = append(verr.Items, ItemError{Seq: it.Seq, Reason: "amount must be positive"})
+			case it.RecipientID == batch.SenderID:
+				verr.Items = append(verr.Items, ItemError{Seq: it.Seq, Reason: "recipient is the sender"})
+			case !ok:
+				verr.Items = append(verr.Items, ItemError{Seq: it.Seq, Reason: "recipient not found"})
+			case acc.Currency != batch.Currency:
+				verr.Items = append(verr.Items, ItemError{Seq: it.Seq, Reason: "recipient account is in " + acc.Currency})
+			case creditErr != nil:
+				verr.Items = append(verr.Items, ItemError{Seq: it.Seq, Reason: creditErr.Error()})
+			case it.Amount > math.MaxInt64-batch.TotalAmount-batch.TotalFee ||
+				it.FeeAmount > math.MaxInt64-batch.TotalAmount-batch.TotalFee-it.Amount:
+				verr.Items = append(verr.Items, ItemError{Seq: it.Seq, Reason: "batch total overflows"})
+			default:
+				batch.TotalAmount += it.Amount
+				batch.TotalFee += it.FeeAmount
+			}
+		}
+		if len(verr.Items) > 0 {
+			return &verr
+		}
+		// The reservation re-checks funds and limits under lock; this only rejects hopeless
+		// batches early.
+		if err := ledger.CheckFunds(&sender, batch.TotalAmount+batch.TotalFee); err != nil {
+			return err
+		}
+		if err := limits.Check(tx, &sender, batch.TotalAmount); err != nil {
+			return err
+		}
+
+		if err := tx.Create(batch).Error; err != nil {
+			return fmt.Errorf("failed to create batch: %w", err)
+		}
+		// a held item is reviewed like a transfer, so its initiator may not release it
+		initiator, err := accountOwner(tx, batch.SenderID)
+		if err != nil {
+			return err
+		}
+		trs := make([]models.Transaction, len(items))
+		for i := range items {
+			it := &items[i]
+			trs[i] = models.Transaction{
+				ID:          uuid.New().String(),
+				SenderID:    batch.SenderID,
+				RecipientID: it.RecipientID,
+				Amount:      it.Amount,
+				Currency:    batch.Currency,
+				Status:      "PENDING",
+				Type:        "TRANSFER",
+				Memo:        it.Reference,
+				FeeAmount:   it.FeeAmount,
+				WorkflowID:  fmt.Sprintf("%s-%d", batch.WorkflowID, it.Seq),
+				InitiatorID: initiator,
+			}
+			it.BatchID = batch.ID
+			it.TransactionID = trs[i].ID
+		}
+		if err := tx.CreateInBatches(trs, 500).Error; err != nil {
+			return fmt.Errorf("failed to create batch transactions: %w", err)
+		}
+		if err := tx.CreateInBatches(items, 500).Error; err != nil {
+			return fmt.Errorf("failed to create batch items: %w", err)
+		}
+		return nil
+	})
+	return replayed, err
+}
+
+// loadRecipients returns the accounts referenced by items, keyed by ID. Malformed IDs
+// are left out and reported as not found.
+func loadRecipients(tx *gorm.DB, items []models.BatchItem) (map[string]models.Account, error) {
+	seen := make(map[string]bool, len(items))
+	ids := make([]string, 0, len(items))
+	for _, it := range items {
+		if seen[it.RecipientID] {
+			continue
+		}
+		seen[it.RecipientID] = true
+		if _, err := uuid.Parse(it.RecipientID); err == nil {
+			ids = append(ids, it.RecipientID)
+		}
+	}
+
+	accounts := make(map[string]models.Account, len(ids))
+	for start := 0; start < len(ids); start += 1000 {
+		end := min(start+1000, len(ids))
+		var page []models.Account
+		if err := tx.Where("id IN ?", ids[start:end]).Find(&page).Error; err != nil {
+			return nil, fmt.Errorf("failed to query recipients: %w", err)
+		}
+		for _, acc := range page {
+			accounts[acc.ID] = acc
+		}
+	}
+	return accounts, nil
+}
+
+// GetBatch retrieves a batch and tallies its items by status.
+func (r *Repository) GetBatch(ctx context.Context, id string) (*models.TransferBatch, *BatchCounts, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, nil, ErrBatchNotFound
+	}
+
+	var batch models.TransferBatch
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&batch).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrBatchNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to query batch: %w", err)
+	}
+
+	var rows []struct {
+		Status string
+		Count  int
+	}
+	if err := r.db.WithContext(ctx).
+		Table("batch_items AS i").
+		Select("t.status, COUNT(*) AS count").
+		Joins("JOIN transactions t ON t.id = i.transaction_id").
+		Where("i.batch_id = ?", id).
+		Group("t.status").
+		Scan(&rows).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to count batch items: %w", err)
+	}
+
+	var counts BatchCounts
+	for _, row := range rows {
+		switch row.Status {
+		case "PENDING":
+			counts.Pending = row.Count
+		case "COMPLETED":
+			counts.Completed = row.Count
+		case "FAILED":
+			counts.Failed = row.Count
+		case "REVERSED":
+			counts.Reversed = row.Count
+		}
+	}
+	return &batch, &counts, nil
+}
+
+// ListBatchItems returns up to limit items of batchID after seq afterSeq, in order.
+func (r *Repository) ListBatchItems(ctx context.Context, batchID string, afterSeq, limit int) ([]BatchItemStatus, error) {
+	var items []BatchItemStatus
+	err := r.db.WithContext(ctx).
+		Table("batch_items AS i").
+		Select("i.*, t.status, t.failure_reason").
+		Joins("JOIN transactions t ON t.id = i.transaction_id").
+		Where("i.batch_id = ? AND i.seq > ?", batchID, afterSeq).
+		Order("i.seq").
+		Limit(limit).
+		Scan(&items).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list batch items: %w", err)
+	}
+	return items, nil
+}
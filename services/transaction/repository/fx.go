@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"FinTechPorto/internal/fx"
+	"FinTechPorto/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrQuoteNotFound is returned when an FX quote cannot be found.
+	ErrQuoteNotFound = errors.New("fx quote not found")
+	// ErrQuoteExpired is returned when an FX quote is used after its expiry.
+	ErrQuoteExpired = errors.New("fx quote has expired")
+	// ErrQuoteUsed is returned when an FX quote already backs another transfer.
+	ErrQuoteUsed = errors.New("fx quote has already been used")
+	// ErrQuoteMismatch is returned when a transfer does not match the quote it references.
+	ErrQuoteMismatch = errors.New("transfer does not match fx quote")
+)
+
+// CreateFxQuote stores a locked FX quote.
+func (r *Repository) CreateFxQuote(ctx context.Context, q *models.FxQuote) error {
+	if err := r.db.WithContext(ctx).Create(q).Error; err != nil {
+		return fmt.Errorf("failed to create fx quote: %w", err)
+	}
+	return nil
+}
+
+// GetFxQuote retrieves an FX quote by its ID.
+func (r *Repository) GetFxQuote(ctx context.Context, id string) (*models.FxQuote, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrQuoteNotFound
+	}
+	var q models.FxQuote
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&q).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrQuoteNotFound
+		}
+		return nil, fmt.Errorf("failed to query fx quote: %w", err)
+	}
+	return &q, nil
+}
+
+// SaveFxRate persists a rate set through the admin API so it survives restarts.
+func (r *Repository) SaveFxRate(ctx context.Context, rate fx.Rate) error {
+	row := models.FxRate{Base: rate.Base, Quote: rate.Quote, Rate: rate.Rate}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "base"}, {Name: "quote"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&row).Error
+	if err != nil {
+		return fmt.Errorf("failed to save fx rate: %w", err)
+	}
+	return nil
+}
+
+// ListFxRates returns the rates set through the admin API.
+func (r *Repository) ListFxRates(ctx context.Context) ([]fx.Rate, error) {
+	var rows []models.FxRate
+	if err := r.db.WithContext(ctx).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list fx rates: %w", err)
+	}
+	rates := make([]fx.Rate, 0, len(rows))
+	for _, row := range rows {
+		rates = append(rates, fx.Rate{Base: row.Base, Quote: row.Quote, Rate: row.Rate})
+	}
+	return rates, nil
+}
+
+// useFxQuote marks quoteID as used by tr and copies the locked conversion onto tr. The
+// quote is row-locked so it can back only one transfer.
+func useFxQuote(tx *gorm.DB, quoteID string, tr *models.Transaction) error {
+	if _, err := uuid.Parse(quoteID); err != nil {
+		return ErrQuoteNotFound
+	}
+
+	var q models.FxQuote
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", quoteID).First(&q).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrQuoteNotFound
+		}
+		return fmt.Errorf("failed to query fx quote: %w", err)
+	}
+	if q.TransactionID != "" {
+		return ErrQuoteUsed
+	}
+	if !time.Now().Before(q.ExpiresAt) {
+		return ErrQuoteExpired
+	}
+	if q.SellCurrency != tr.Currency || q.SellAmount != tr.Amount {
+		return fmt.Errorf("%w: quote sells %d %s", ErrQuoteMismatch, q.SellAmount, q.SellCurrency)
+	}
+
+	if err := tx.Model(&q).Update("transaction_id", tr.ID).Error; err != nil {
+		return fmt.Errorf("failed to mark fx quote used: %w", err)
+	}
+	tr.CounterAmount = q.BuyAmount
+	tr.CounterCurrency = q.BuyCurrency
+	tr.FxRate = q.Rate
+	tr.FxQuoteID = q.ID
+	return nil
+}
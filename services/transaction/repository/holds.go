@@ -0,0 +1,359 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"FinTechPorto/internal/approval"
+	"FinTechPorto/internal/events"
+	"FinTechPorto/internal/ledger"
+	"FinTechPorto/internal/limits"
+	"FinTechPorto/internal/models"
+	"FinTechPorto/internal/risk"
+	"FinTechPorto/internal/screening"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrHoldNotFound is returned when a hold cannot be found.
+	ErrHoldNotFound = errors.New("hold not found")
+	// ErrHoldNotActive is returned when capturing or voiding a hold that is no longer active.
+	ErrHoldNotActive = ledger.ErrHoldNotActive
+	// ErrHoldExpired is returned when capturing a hold after its expiry time.
+	ErrHoldExpired = errors.New("hold has expired")
+	// ErrCaptureExceedsHold is returned when capturing more than the held amount.
+	ErrCaptureExceedsHold = errors.New("capture amount exceeds hold")
+	// ErrCurrencyMismatch is returned when an account is not in the requested currency.
+	ErrCurrencyMismatch = errors.New("currency does not match account")
+	// ErrCaptureUnderReview is returned when capturing or voiding a hold whose capture is
+	// awaiting approval.
+	ErrCaptureUnderReview = errors.New("hold has a capture awaiting approval")
+)
+
+// CaptureChecks are what CaptureHold screens a capture against before its funds move.
+type CaptureChecks struct {
+	// Sanctions matches the holder and the recipient; nil matches nobody.
+	Sanctions *screening.Screener
+	// Approvals decides which capture amounts need a second person's approval.
+	Approvals approval.Policy
+	// Risk judges the capture by the holder's recent transfers; nil allows everything.
+	Risk *risk.Engine
+}
+
+// AuthorizeHold reserves amount on accountID until expiresAt. It returns the hold and the
+// account's available balance afterwards. The hold must fit within the account's transfer
+// limits; the amount counts against them once it is captured.
+func (r *Repository) AuthorizeHold(ctx context.Context, accountID string, amount int64, currency, reference string, expiresAt time.Time) (*models.Hold, int64, error) {
+	if _, err := uuid.Parse(accountID); err != nil {
+		return nil, 0, ErrAccountNotFound
+	}
+
+	var hold models.Hold
+	var available int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var acc models.Account
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", accountID).First(&acc).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to query account: %w", err)
+		}
+		if acc.Currency != currency {
+			return ErrCurrencyMismatch
+		}
+		if err := ledger.CheckDebit(&acc); err != nil {
+			return err
+		}
+		if err := limits.Check(tx, &acc, amount); err != nil {
+			return err
+		}
+
+		hold = models.Hold{
+			Amount:    amount,
+			Reference: reference,
+			ExpiresAt: expiresAt,
+		}
+		if err := ledger.PlaceHold(tx, &acc, &hold); err != nil {
+			return err
+		}
+		available = ledger.AvailableBalance(&acc)
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return &hold, available, nil
+}
+
+// CaptureHold moves amount of an active hold to recipientID and releases the remainder.
+// An amount of zero captures the whole hold. The capture is screened against checks
+// first, like a transfer. A risk DENY records the capture transaction FAILED and leaves
+// the hold untouched. On a risk REVIEW, a sanctions hit, or when the amount needs a second
+// person's approval, nothing moves: the capture transaction is recorded AWAITING_APPROVAL
+// with any hits and the hold stays reserved for it until the review decides.
+func (r *Repository) CaptureHold(ctx context.Context, holdID, recipientID string, amount int64, memo *string, checks CaptureChecks) (*models.Hold, *models.Transaction, error) {
+	if _, err := uuid.Parse(holdID); err != nil {
+		return nil, nil, ErrHoldNotFound
+	}
+	if _, err := uuid.Parse(recipientID); err != nil {
+		return nil, nil, ErrAccountNotFound
+	}
+
+	var hold models.Hold
+	var tr models.Transaction
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockHold(tx, holdID, &hold); err != nil {
+			return err
+		}
+		if hold.Status != "ACTIVE" {
+			return fmt.Errorf("%w: hold is %s", ErrHoldNotActive, hold.Status)
+		}
+		if hold.CaptureTransactionID != "" {
+			return ErrCaptureUnderReview
+		}
+		if !time.Now().Before(hold.ExpiresAt) {
+			return ErrHoldExpired
+		}
+		if amount == 0 {
+			amount = hold.Amount
+		}
+		if amount > hold.Amount {
+			return ErrCaptureExceedsHold
+		}
+
+		// The holder's funds are already guaranteed by the hold, but its status may have
+		// changed since it was placed.
+		var holder models.Account
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", hold.AccountID).First(&holder).Error; err != nil {
+			return fmt.Errorf("failed to query holder: %w", err)
+		}
+		if err := ledger.CheckDebit(&holder); err != nil {
+			return err
+		}
+
+		var recipient models.Account
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", recipientID).First(&recipient).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrAccountNotFound
+			}
+			return fmt.Errorf("failed to query recipient: %w", err)
+		}
+		if recipient.Currency != hold.Currency {
+			return ErrCurrencyMismatch
+		}
+		if err := ledger.CheckCredit(&recipient); err != nil {
+			return err
+		}
+
+		tr = models.Transaction{
+			ID:          uuid.New().String(),
+			SenderID:    hold.AccountID,
+			RecipientID: recipient.ID,
+			Amount:      amount,
+			Currency:    hold.Currency,
+			Status:      "COMPLETED",
+			Type:        "CAPTURE",
+			InitiatorID: holder.UserID,
+		}
+		tr.WorkflowID = "capture-" + tr.ID
+		if memo != nil {
+			tr.Memo = *memo
+		}
+
+		decision, err := screenRisk(tx, checks.Risk, &tr)
+		if err != nil {
+			return err
+		}
+		if decision.Outcome == risk.Deny {
+			tr.Status, tr.FailureReason = "FAILED", "denied by risk screening: "+decision.Hits[0].Reason
+			if err := tx.Create(&tr).Error; err != nil {
+				return fmt.Errorf("failed to create transaction record: %w", err)
+			}
+			if err := tx.Create(decision).Error; err != nil {
+				return fmt.Errorf("failed to record risk decision: %w", err)
+			}
+			return events.TransferFailed(tx, &tr, tr.FailureReason)
+		}
+		hits := screenParties(checks.Sanctions, tr.ID, &holder, &recipient)
+		if decision.Outcome == risk.Review || len(hits) > 0 || checks.Approvals.Requires(tr.Currency, amount) {
+			tr.Status = "AWAITING_APPROVAL"
+		}
+		if err := tx.Create(&tr).Error; err != nil {
+			return fmt.Errorf("failed to create transaction record: %w", err)
+		}
+		if err := tx.Create(decision).Error; err != nil {
+			return fmt.Errorf("failed to record risk decision: %w", err)
+		}
+
+		hold.CapturedAmount = amount
+		hold.CaptureTransactionID = tr.ID
+		if tr.Status == "AWAITING_APPROVAL" {
+			if len(hits) > 0 {
+				if err := tx.Create(&hits).Error; err != nil {
+					return fmt.Errorf("failed to record screening hits: %w", err)
+				}
+			}
+			// CaptureReviewWorkflow completes the capture once it is approved
+			return tx.Model(&hold).Updates(map[string]interface{}{
+				"captured_amount":        hold.CapturedAmount,
+				"capture_transaction_id": hold.CaptureTransactionID,
+			}).Error
+		}
+
+		if err := ledger.ReleaseHold(tx, &hold, "CAPTURED"); err != nil {
+			return err
+		}
+		if _, err := ledger.Post(tx, tr.ID, "hold capture", ledger.Transfer(hold.AccountID, recipient.ID, hold.Currency, amount)...); err != nil {
+			return fmt.Errorf("failed to post journal entry: %w", err)
+		}
+		// the limits were checked when the hold was authorized
+		if err := limits.Record(tx, &holder, amount, tr.ID); err != nil {
+			return err
+		}
+
+		return events.TransferCompleted(tx, &tr, hold.ID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &hold, &tr, nil
+}
+
+// VoidHold releases an active hold without moving funds.
+func (r *Repository) VoidHold(ctx context.Context, holdID string) (*models.Hold, error) {
+	if _, err := uuid.Parse(holdID); err != nil {
+		return nil, ErrHoldNotFound
+	}
+
+	var hold models.Hold
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockHold(tx, holdID, &hold); err != nil {
+			return err
+		}
+		if hold.Status == "ACTIVE" && hold.CaptureTransactionID != "" {
+			return ErrCaptureUnderReview
+		}
+		return ledger.ReleaseHold(tx, &hold, "VOIDED")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &hold, nil
+}
+
+// CancelCapture undoes a capture recorded AWAITING_APPROVAL whose review could not be
+// started: the capture transaction is marked FAILED with reason and the hold is given
+// back to its holder, or released as EXPIRED if it expired in the meantime.
+func (r *Repository) CancelCapture(ctx context.Context, holdID, transactionID, reason string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var hold models.Hold
+		if err := lockHold(tx, holdID, &hold); err != nil {
+			return err
+		}
+		if hold.Status != "ACTIVE" || hold.CaptureTransactionID != transactionID {
+			return nil
+		}
+
+		var tr models.Transaction
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", transactionID).First(&tr).Error; err != nil {
+			return fmt.Errorf("failed to query transaction: %w", err)
+		}
+		if tr.Status == "AWAITING_APPROVAL" {
+			tr.Status, tr.FailureReason = "FAILED", reason
+			if err := tx.Model(&tr).Updates(map[string]interface{}{
+				"status":         tr.Status,
+				"failure_reason": tr.FailureReason,
+			}).Error; err != nil {
+				return fmt.Errorf("failed to update transaction: %w", err)
+			}
+			if err := events.TransferFailed(tx, &tr, reason); err != nil {
+				return err
+			}
+		}
+
+		hold.CapturedAmount, hold.CaptureTransactionID = 0, ""
+		if !time.Now().Before(hold.ExpiresAt) {
+			return ledger.ReleaseHold(tx, &hold, "EXPIRED")
+		}
+		return tx.Model(&hold).Updates(map[string]interface{}{
+			"captured_amount":        hold.CapturedAmount,
+			"capture_transaction_id": hold.CaptureTransactionID,
+		}).Error
+	})
+}
+
+// screenRisk judges tr against engine using the sender's recent transfers and returns
+// the decision to record for it.
+func screenRisk(tx *gorm.DB, engine *risk.Engine, tr *models.Transaction) (*models.RiskDecision, error) {
+	in := risk.Input{
+		SenderID:    tr.SenderID,
+		RecipientID: tr.RecipientID,
+		Amount:      tr.Amount,
+		Currency:    tr.Currency,
+		Now:         time.Now(),
+	}
+	if lookback := engine.Lookback(); lookback > 0 {
+		// failed and rejected transfers never moved money, so they are not history
+		if err := tx.Model(&models.Transaction{}).
+			Select("recipient_id, amount, currency, created_at").
+			Where("sender_id = ? AND type = ? AND status NOT IN ? AND created_at >= ?",
+				tr.SenderID, "TRANSFER", []string{"FAILED", "REJECTED"}, in.Now.Add(-lookback)).
+			Scan(&in.History).Error; err != nil {
+			return nil, fmt.Errorf("failed to load sender history: %w", err)
+		}
+	}
+	var paid int64
+	if err := tx.Model(&models.Transaction{}).
+		Where("sender_id = ? AND recipient_id = ? AND status = ?", tr.SenderID, tr.RecipientID, "COMPLETED").
+		Count(&paid).Error; err != nil {
+		return nil, fmt.Errorf("failed to check recipient history: %w", err)
+	}
+	in.KnownRecipient = paid > 0
+
+	d := engine.Screen(in)
+	return &models.RiskDecision{TransactionID: tr.ID, Outcome: d.Outcome, Hits: d.Hits}, nil
+}
+
+// screenParties matches the holder names of sender and recipient against sanctions and
+// returns a hit per match, recorded against transactionID.
+func screenParties(sanctions *screening.Screener, transactionID string, sender, recipient *models.Account) []models.ScreeningHit {
+	parties := []struct {
+		party string
+		acc   *models.Account
+	}{
+		{"SENDER", sender},
+		{"RECIPIENT", recipient},
+	}
+	var hits []models.ScreeningHit
+	for _, pt := range parties {
+		for _, m := range sanctions.Screen(pt.acc.HolderName) {
+			hits = append(hits, models.ScreeningHit{
+				TransactionID: transactionID,
+				Party:         pt.party,
+				AccountID:     pt.acc.ID,
+				HolderName:    pt.acc.HolderName,
+				EntryUID:      m.Entry.UID,
+				EntryName:     m.Entry.Name,
+				MatchedName:   m.MatchedName,
+				Score:         m.Score,
+			})
+		}
+	}
+	return hits
+}
+
+// lockHold loads a hold with a row lock.
+func lockHold(tx *gorm.DB, holdID string, hold *models.Hold) error {
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", holdID).First(hold).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrHoldNotFound
+		}
+		return fmt.Errorf("failed to query hold: %w", err)
+	}
+	return nil
+}
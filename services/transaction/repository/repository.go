@@ -2,12 +2,18 @@ package repository
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
-	"FinTechPorto/internal/broker"
+	"FinTechPorto/internal/fee"
+	"FinTechPorto/internal/ledger"
+	"FinTechPorto/internal/limits"
 	"FinTechPorto/internal/models"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -15,109 +21,384 @@ import (
 var (
 	// ErrAccountNotFound is returned when an account cannot be found.
 	ErrAccountNotFound = errors.New("account not found")
-	// ErrInsufficientFunds is returned when sender has insufficient balance.
-	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrInsufficientFunds is returned when sender has insufficient available balance.
+	ErrInsufficientFunds = ledger.ErrInsufficientFunds
+	// ErrAccountFrozen, ErrDebitBlocked, ErrCreditBlocked and ErrAccountClosed are
+	// returned when an account's status does not allow the debit or credit.
+	ErrAccountFrozen = ledger.ErrAccountFrozen
+	ErrDebitBlocked  = ledger.ErrDebitBlocked
+	ErrCreditBlocked = ledger.ErrCreditBlocked
+	ErrAccountClosed = ledger.ErrAccountClosed
+	// ErrLimitExceeded is returned when a debit would exceed a transfer limit. The
+	// *limits.Error it wraps carries the remaining allowance.
+	ErrLimitExceeded = limits.ErrLimitExceeded
+	// ErrTransactionNotFound is returned when a transaction cannot be found.
+	ErrTransactionNotFound = errors.New("transaction not found")
+	// ErrInvalidCursor is returned when a page token cannot be decoded.
+	ErrInvalidCursor = errors.New("invalid page token")
+	// ErrNotRefundable is returned when refunding something other than a completed transfer.
+	ErrNotRefundable = errors.New("only completed transfers can be refunded")
+	// ErrAlreadyReversed is returned when nothing remains to refund on a transfer.
+	ErrAlreadyReversed = errors.New("transfer is already fully refunded")
+	// ErrRefundExceedsOriginal is returned when a refund is larger than the refundable amount.
+	ErrRefundExceedsOriginal = errors.New("refund exceeds refundable amount")
+	// ErrIdempotencyKeyConflict is returned when an idempotency key is reused with a different request.
+	ErrIdempotencyKeyConflict = errors.New("idempotency key already used with a different request")
 )
 
 // Repository wraps DB operations for transactions and accounts.
 type Repository struct {
-	db     *gorm.DB
-	Broker *broker.KafkaWriter
+	db *gorm.DB
 }
 
 // New creates a new Repository.
-func New(db *gorm.DB, b *broker.KafkaWriter) *Repository {
-	return &Repository{db: db, Broker: b}
+func New(db *gorm.DB) *Repository {
+	return &Repository{db: db}
 }
 
-// TransferFunds transfers amount from senderID to recipientID within a DB transaction.
-// memo is optional and may be nil.
-func (r *Repository) TransferFunds(ctx context.Context, senderID, recipientID string, amount int64, currency string, memo *string) (*models.Transaction, error) {
-	var createdTx models.Transaction
+// GetTransactionByID retrieves a transaction by its ID. Workflow IDs handed out before
+// transactions were recorded at acceptance are resolved through the workflow_id column.
+func (r *Repository) GetTransactionByID(ctx context.Context, id string) (*models.Transaction, error) {
+	column := "id"
+	if _, err := uuid.Parse(id); err != nil {
+		column = "workflow_id"
+	}
+
+	var tr models.Transaction
+	if err := r.db.WithContext(ctx).Where(column+" = ?", id).First(&tr).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, err
+	}
+	return &tr, nil
+}
+
+// PendingTransfer describes a transfer accepted for asynchronous processing.
+type PendingTransfer struct {
+	SenderID    string
+	RecipientID string
+	Amount      int64
+	Currency    string
+	Memo        *string
+	WorkflowID  string
+	// FxQuoteID is required when the recipient holds a different currency.
+	FxQuoteID string
+	// Fee is charged to the sender on top of Amount; nil means no fee.
+	Fee *fee.Breakdown
+	// RequiresApproval records the transfer as AWAITING_APPROVAL instead of PENDING.
+	RequiresApproval bool
+}
+
+// CreatePendingTransfer claims the idempotency key and records a PENDING transaction for p
+// in one DB transaction. If the key was already claimed with the same fingerprint, the
+// original transaction is returned and replayed is true. A different fingerprint yields
+// ErrIdempotencyKeyConflict.
+func (r *Repository) CreatePendingTransfer(ctx context.Context, p PendingTransfer, key, fingerprint string) (*models.Transaction, bool, error) {
+	var tr models.Transaction
+	var replayed bool
 
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Load sender with row-level lock
-		var sender models.Account
-		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND currency = ?", senderID, currency).First(&sender).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return ErrAccountNotFound
+		tr = models.Transaction{
+			ID:          uuid.New().String(),
+			SenderID:    p.SenderID,
+			RecipientID: p.RecipientID,
+			Amount:      p.Amount,
+			Currency:    p.Currency,
+			Status:      "PENDING",
+			Type:        "TRANSFER",
+			WorkflowID:  p.WorkflowID,
+		}
+		if p.RequiresApproval {
+			tr.Status = "AWAITING_APPROVAL"
+		}
+		if p.Memo != nil {
+			tr.Memo = *p.Memo
+		}
+		if p.Fee != nil {
+			tr.FeeAmount = p.Fee.Total
+			tr.FeeBreakdown = p.Fee
+		}
+
+		existingID, err := claimIdempotencyKey(tx, p.SenderID, key, fingerprint, tr.ID)
+		if err != nil {
+			return err
+		}
+		if existingID != "" {
+			replayed = true
+			if err := tx.Where("id = ?", existingID).First(&tr).Error; err != nil {
+				return fmt.Errorf("failed to load original transaction: %w", err)
 			}
-			return fmt.Errorf("failed to query sender: %w", err)
+			return nil
 		}
 
-		// validate balance
-		if sender.Balance < amount {
-			return ErrInsufficientFunds
+		if p.FxQuoteID != "" {
+			if err := useFxQuote(tx, p.FxQuoteID, &tr); err != nil {
+				return err
+			}
+		}
+		// the initiator is never taken from the caller, who could then approve their own transfer
+		owner, err := accountOwner(tx, p.SenderID)
+		if err != nil {
+			return err
+		}
+		tr.InitiatorID = owner
+		if err := checkLimits(tx, p.SenderID, p.Currency, p.Amount); err != nil {
+			return err
 		}
 
-		// Load recipient and lock
-		var recipient models.Account
-		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND currency = ?", recipientID, currency).First(&recipient).Error; err != nil {
+		if err := tx.Create(&tr).Error; err != nil {
+			return fmt.Errorf("failed to create transaction record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &tr, replayed, nil
+}
+
+// checkLimits rejects a transfer that would already exceed the sender's limits so the
+// caller hears about it up front. The debit checks again under lock and records the usage.
+// An unknown sender is left for the workflow to report.
+func checkLimits(tx *gorm.DB, senderID, currency string, amount int64) error {
+	if _, err := uuid.Parse(senderID); err != nil {
+		return nil
+	}
+	var senders []models.Account
+	if err := tx.Where("id = ? AND currency = ?", senderID, currency).Limit(1).Find(&senders).Error; err != nil {
+		return fmt.Errorf("failed to query sender: %w", err)
+	}
+	if len(senders) == 0 {
+		return nil
+	}
+	return limits.Check(tx, &senders[0], amount)
+}
+
+// accountOwner returns the user that owns accountID, or "" if the account does not exist.
+func accountOwner(tx *gorm.DB, accountID string) (string, error) {
+	if _, err := uuid.Parse(accountID); err != nil {
+		return "", nil
+	}
+	var owners []string
+	if err := tx.Model(&models.Account{}).Where("id = ?", accountID).Pluck("user_id", &owners).Error; err != nil {
+		return "", fmt.Errorf("failed to query sender owner: %w", err)
+	}
+	if len(owners) == 0 {
+		return "", nil
+	}
+	return owners[0], nil
+}
+
+// claimIdempotencyKey records key for senderID with the given request fingerprint and
+// transactionID. If the key was claimed before with the same fingerprint, the original
+// transaction ID is returned; a fresh claim returns an empty string.
+func claimIdempotencyKey(tx *gorm.DB, senderID, key, fingerprint, transactionID string) (string, error) {
+	ik := models.IdempotencyKey{
+		SenderID:      senderID,
+		Key:           key,
+		Fingerprint:   fingerprint,
+		TransactionID: transactionID,
+	}
+	res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&ik)
+	if res.Error != nil {
+		return "", fmt.Errorf("failed to claim idempotency key: %w", res.Error)
+	}
+	if res.RowsAffected == 1 {
+		return "", nil
+	}
+
+	var existing models.IdempotencyKey
+	if err := tx.Where("sender_id = ? AND key = ?", senderID, key).First(&existing).Error; err != nil {
+		return "", fmt.Errorf("failed to load idempotency key: %w", err)
+	}
+	if existing.Fingerprint != fingerprint {
+		return "", ErrIdempotencyKeyConflict
+	}
+	return existing.TransactionID, nil
+}
+
+// TransactionFilter narrows ListTransactions. Zero-valued fields are ignored.
+type TransactionFilter struct {
+	AccountID     string
+	Status        string
+	Currency      string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// Cursor is the position of the last transaction returned by ListTransactions.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// EncodeCursor renders c as an opaque page token.
+func EncodeCursor(c Cursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID))
+}
+
+// DecodeCursor parses a page token produced by EncodeCursor.
+func DecodeCursor(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	ts, id, ok := strings.Cut(string(b), "|")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, ts)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &Cursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+// ListTransactions returns up to limit transactions matching f, newest first, that sort
+// after the cursor. Paging by (created_at, id) keeps pages stable while new transactions
+// are inserted, since those always sort before any cursor already handed out.
+func (r *Repository) ListTransactions(ctx context.Context, f TransactionFilter, after *Cursor, limit int) ([]models.Transaction, error) {
+	q := r.db.WithContext(ctx).Model(&models.Transaction{}).
+		Where("(sender_id = ? OR recipient_id = ?)", f.AccountID, f.AccountID)
+	if f.Status != "" {
+		q = q.Where("status = ?", f.Status)
+	}
+	if f.Currency != "" {
+		q = q.Where("currency = ?", f.Currency)
+	}
+	if !f.CreatedAfter.IsZero() {
+		q = q.Where("created_at >= ?", f.CreatedAfter)
+	}
+	if !f.CreatedBefore.IsZero() {
+		q = q.Where("created_at < ?", f.CreatedBefore)
+	}
+	if after != nil {
+		q = q.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	var txs []models.Transaction
+	if err := q.Order("created_at DESC, id DESC").Limit(limit).Find(&txs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+	return txs, nil
+}
+
+// RefundRequest describes a refund or reversal of a completed transfer.
+type RefundRequest struct {
+	OriginalID string
+	// Amount to refund; zero refunds everything still refundable.
+	Amount int64
+	// Type is REFUND or REVERSAL.
+	Type        string
+	Reason      string
+	Key         string
+	Fingerprint string
+}
+
+// CreateRefund records a PENDING counter-transaction that moves funds from the original
+// recipient back to the original sender. The original transfer is locked while the
+// refundable amount is computed, so concurrent refunds can never exceed it. It returns
+// the counter-transaction, the amount still refundable afterwards, and whether the
+// idempotency key was replayed.
+func (r *Repository) CreateRefund(ctx context.Context, req RefundRequest) (*models.Transaction, int64, bool, error) {
+	var refund models.Transaction
+	var remaining int64
+	var replayed bool
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var orig models.Transaction
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", req.OriginalID).First(&orig).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return ErrAccountNotFound
+				return ErrTransactionNotFound
 			}
-			return fmt.Errorf("failed to query recipient: %w", err)
+			return fmt.Errorf("failed to query original transaction: %w", err)
 		}
 
-		// update balances
-		sender.Balance -= amount
-		recipient.Balance += amount
+		refund = models.Transaction{
+			ID:                    uuid.New().String(),
+			SenderID:              orig.RecipientID,
+			RecipientID:           orig.SenderID,
+			Currency:              orig.Currency,
+			Status:                "PENDING",
+			Memo:                  req.Reason,
+			Type:                  req.Type,
+			OriginalTransactionID: orig.ID,
+		}
+		refund.WorkflowID = "refund-" + refund.ID
 
-		if err := tx.Model(&models.Account{}).Where("id = ?", sender.ID).Update("balance", sender.Balance).Error; err != nil {
-			return fmt.Errorf("failed to update sender balance: %w", err)
+		existingID, err := claimIdempotencyKey(tx, orig.ID, req.Key, req.Fingerprint, refund.ID)
+		if err != nil {
+			return err
 		}
-		if err := tx.Model(&models.Account{}).Where("id = ?", recipient.ID).Update("balance", recipient.Balance).Error; err != nil {
-			return fmt.Errorf("failed to update recipient balance: %w", err)
+		if existingID != "" {
+			replayed = true
+			if err := tx.Where("id = ?", existingID).First(&refund).Error; err != nil {
+				return fmt.Errorf("failed to load original refund: %w", err)
+			}
+			outstanding, err := outstandingRefunds(tx, orig.ID)
+			if err != nil {
+				return err
+			}
+			remaining = orig.Amount - outstanding
+			return nil
 		}
 
-		// create transaction record
-		tr := models.Transaction{
-			SenderID:    sender.ID,
-			RecipientID: recipient.ID,
-			Amount:      amount,
-			Currency:    currency,
-			Status:      "COMPLETED",
+		if orig.Type != "TRANSFER" {
+			return ErrNotRefundable
 		}
-		if memo != nil {
-			tr.Memo = *memo
+		if orig.CounterCurrency != "" && orig.CounterCurrency != orig.Currency {
+			return fmt.Errorf("%w: cross-currency transfers cannot be refunded", ErrNotRefundable)
+		}
+		if orig.Status == "REVERSED" {
+			return ErrAlreadyReversed
+		}
+		if orig.Status != "COMPLETED" {
+			return ErrNotRefundable
 		}
 
-		if err := tx.Create(&tr).Error; err != nil {
-			return fmt.Errorf("failed to create transaction record: %w", err)
+		outstanding, err := outstandingRefunds(tx, orig.ID)
+		if err != nil {
+			return err
+		}
+		refundable := orig.Amount - outstanding
+		if refundable <= 0 {
+			return ErrAlreadyReversed
 		}
 
-		// Publish event to Kafka inside the transaction. If publishing fails, return error to rollback.
-		if r.Broker != nil {
-			event := map[string]interface{}{
-				"transaction_id": tr.ID,
-				"sender_id":      tr.SenderID,
-				"recipient_id":   tr.RecipientID,
-				"amount":         tr.Amount,
-				"status":         "COMPLETED",
-			}
-			if err := r.Broker.PublishTransactionEvent(ctx, event); err != nil {
-				return fmt.Errorf("failed to publish transaction event: %w", err)
-			}
+		refund.Amount = req.Amount
+		if refund.Amount == 0 {
+			refund.Amount = refundable
+		}
+		if refund.Amount > refundable {
+			return fmt.Errorf("%w: %d requested, %d refundable", ErrRefundExceedsOriginal, refund.Amount, refundable)
 		}
+		remaining = refundable - refund.Amount
 
-		createdTx = tr
+		if err := tx.Create(&refund).Error; err != nil {
+			return fmt.Errorf("failed to create refund record: %w", err)
+		}
 		return nil
 	})
-
 	if err != nil {
-		return nil, err
+		return nil, 0, false, err
 	}
-	return &createdTx, nil
+	return &refund, remaining, replayed, nil
 }
 
-// GetTransactionByID retrieves a transaction by its ID.
-func (r *Repository) GetTransactionByID(ctx context.Context, id string) (*models.Transaction, error) {
-	var tr models.Transaction
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&tr).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrAccountNotFound
-		}
-		return nil, err
+// outstandingRefunds sums the refunds of originalID that are pending or completed.
+// Failed refunds release their amount.
+func outstandingRefunds(tx *gorm.DB, originalID string) (int64, error) {
+	var sum int64
+	err := tx.Model(&models.Transaction{}).
+		Where("original_transaction_id = ? AND status IN ?", originalID, []string{"PENDING", "COMPLETED"}).
+		Select("COALESCE(SUM(amount), 0)").
+		Scan(&sum).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum refunds: %w", err)
 	}
-	return &tr, nil
+	return sum, nil
 }
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"FinTechPorto/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrRiskDecisionNotFound is returned when a transaction has not been screened.
+var ErrRiskDecisionNotFound = errors.New("risk decision not found")
+
+// GetRiskDecision retrieves the screening decision recorded for transactionID.
+func (r *Repository) GetRiskDecision(ctx context.Context, transactionID string) (*models.RiskDecision, error) {
+	if _, err := uuid.Parse(transactionID); err != nil {
+		return nil, ErrRiskDecisionNotFound
+	}
+	var d models.RiskDecision
+	if err := r.db.WithContext(ctx).Where("transaction_id = ?", transactionID).First(&d).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRiskDecisionNotFound
+		}
+		return nil, fmt.Errorf("failed to query risk decision: %w", err)
+	}
+	return &d, nil
+}
+
+// ListScreeningHits returns the sanctions hits recorded for transactionID.
+func (r *Repository) ListScreeningHits(ctx context.Context, transactionID string) ([]models.ScreeningHit, error) {
+	if _, err := uuid.Parse(transactionID); err != nil {
+		return nil, ErrTransactionNotFound
+	}
+	var hits []models.ScreeningHit
+	if err := r.db.WithContext(ctx).Where("transaction_id = ?", transactionID).Order("id").Find(&hits).Error; err != nil {
+		return nil, fmt.Errorf("failed to list screening hits: %w", err)
+	}
+	return hits, nil
+}
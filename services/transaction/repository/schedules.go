@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"FinTechPorto/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrScheduleNotFound is returned when a transfer schedule cannot be found.
+	ErrScheduleNotFound = errors.New("schedule not found")
+	// ErrScheduleNotActive is returned when cancelling a schedule that already stopped.
+	ErrScheduleNotActive = errors.New("schedule is not active")
+)
+
+// CreateSchedule claims the idempotency key and stores sched as ACTIVE after checking
+// that both accounts exist in its currency. If the key was already claimed with the same
+// fingerprint, the original schedule is loaded into sched and replayed is true.
+func (r *Repository) CreateSchedule(ctx context.Context, sched *models.TransferSchedule, key, fingerprint string) (bool, error) {
+	if _, err := uuid.Parse(sched.SenderID); err != nil {
+		return false, ErrAccountNotFound
+	}
+	if _, err := uuid.Parse(sched.RecipientID); err != nil {
+		return false, ErrAccountNotFound
+	}
+
+	var replayed bool
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		sched.ID = uuid.New().String()
+		sched.Status = "ACTIVE"
+		sched.TemporalScheduleID = "transfer-schedule-" + sched.ID
+
+		existingID, err := claimIdempotencyKey(tx, "schedule:"+sched.SenderID, key, fingerprint, sched.ID)
+		if err != nil {
+			return err
+		}
+		if existingID != "" {
+			replayed = true
+			if err := tx.Where("id = ?", existingID).First(sched).Error; err != nil {
+				return fmt.Errorf("failed to load original schedule: %w", err)
+			}
+			return nil
+		}
+
+		for _, id := range []string{sched.SenderID, sched.RecipientID} {
+			var acc models.Account
+			if err := tx.Where("id = ?", id).First(&acc).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrAccountNotFound
+				}
+				return fmt.Errorf("failed to query account: %w", err)
+			}
+			if acc.Currency != sched.Currency {
+				return ErrCurrencyMismatch
+			}
+		}
+
+		if err := tx.Create(sched).Error; err != nil {
+			return fmt.Errorf("failed to create schedule: %w", err)
+		}
+		return nil
+	})
+	return replayed, err
+}
+
+// GetSchedule retrieves a transfer schedule by its ID.
+func (r *Repository) GetSchedule(ctx context.Context, id string) (*models.TransferSchedule, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrScheduleNotFound
+	}
+	var sched models.TransferSchedule
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&sched).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to query schedule: %w", err)
+	}
+	return &sched, nil
+}
+
+// ListSchedules returns the schedules of senderID, newest first.
+func (r *Repository) ListSchedules(ctx context.Context, senderID string) ([]models.TransferSchedule, error) {
+	if _, err := uuid.Parse(senderID); err != nil {
+		return nil, nil
+	}
+	var schedules []models.TransferSchedule
+	if err := r.db.WithContext(ctx).Where("sender_id = ?", senderID).Order("created_at DESC").Find(&schedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// CancelSchedule marks an ACTIVE schedule CANCELLED. Runs that fire afterwards are skipped.
+func (r *Repository) CancelSchedule(ctx context.Context, id string) (*models.TransferSchedule, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrScheduleNotFound
+	}
+
+	var sched models.TransferSchedule
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&sched).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrScheduleNotFound
+			}
+			return fmt.Errorf("failed to query schedule: %w", err)
+		}
+		if sched.Status != "ACTIVE" {
+			return ErrScheduleNotActive
+		}
+		if err := tx.Model(&sched).Update("status", "CANCELLED").Error; err != nil {
+			return fmt.Errorf("failed to cancel schedule: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sched, nil
+}
+
+// ListScheduleRuns returns up to limit runs of scheduleID, newest first.
+func (r *Repository) ListScheduleRuns(ctx context.Context, scheduleID string, limit int) ([]models.ScheduleRun, error) {
+	if _, err := uuid.Parse(scheduleID); err != nil {
+		return nil, ErrScheduleNotFound
+	}
+	var runs []models.ScheduleRun
+	if err := r.db.WithContext(ctx).
+		Where("schedule_id = ?", scheduleID).
+		Order("created_at DESC").Order("id DESC").
+		Limit(limit).
+		Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list schedule runs: %w", err)
+	}
+	return runs, nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"FinTechPorto/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrWebhookEndpointNotFound is returned when a webhook endpoint cannot be found.
+	ErrWebhookEndpointNotFound = errors.New("webhook endpoint not found")
+	// ErrWebhookEndpointDisabled is returned when replaying to a disabled endpoint.
+	ErrWebhookEndpointDisabled = errors.New("webhook endpoint is disabled")
+	// ErrWebhookDeliveryNotFound is returned when a webhook delivery cannot be found.
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	// ErrWebhookDeliveryPending is returned when replaying a delivery still being sent.
+	ErrWebhookDeliveryPending = errors.New("webhook delivery is still pending")
+)
+
+// CreateWebhookEndpoint stores a new ACTIVE endpoint.
+func (r *Repository) CreateWebhookEndpoint(ctx context.Context, ep *models.WebhookEndpoint) error {
+	ep.Status = "ACTIVE"
+	if err := r.db.WithContext(ctx).Create(ep).Error; err != nil {
+		return fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// ListWebhookEndpoints returns the endpoints of merchantID, oldest first.
+func (r *Repository) ListWebhookEndpoints(ctx context.Context, merchantID string) ([]models.WebhookEndpoint, error) {
+	var endpoints []models.WebhookEndpoint
+	if err := r.db.WithContext(ctx).Where("merchant_id = ?", merchantID).Order("created_at").Order("id").Find(&endpoints).Error; err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+	return endpoints, nil
+}
+
+// GetWebhookEndpoint retrieves an endpoint by ID.
+func (r *Repository) GetWebhookEndpoint(ctx context.Context, id string) (*models.WebhookEndpoint, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrWebhookEndpointNotFound
+	}
+	var ep models.WebhookEndpoint
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&ep).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookEndpointNotFound
+		}
+		return nil, fmt.Errorf("failed to query webhook endpoint: %w", err)
+	}
+	return &ep, nil
+}
+
+// DisableWebhookEndpoint marks an endpoint DISABLED. Deliveries still being retried fail
+// at their next attempt.
+func (r *Repository) DisableWebhookEndpoint(ctx context.Context, id string) (*models.WebhookEndpoint, error) {
+	ep, err := r.GetWebhookEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ep.Status = "DISABLED"
+	if err := r.db.WithContext(ctx).Model(ep).Update("status", ep.Status).Error; err != nil {
+		return nil, fmt.Errorf("failed to disable webhook endpoint: %w", err)
+	}
+	return ep, nil
+}
+
+// ListWebhookDeliveries returns up to limit deliveries of endpointID, newest first.
+func (r *Repository) ListWebhookDeliveries(ctx context.Context, endpointID string, limit int) ([]models.WebhookDelivery, error) {
+	if _, err := uuid.Parse(endpointID); err != nil {
+		return nil, ErrWebhookEndpointNotFound
+	}
+	var deliveries []models.WebhookDelivery
+	if err := r.db.WithContext(ctx).
+		Where("endpoint_id = ?", endpointID).
+		Order("created_at DESC").Order("id DESC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// GetWebhookDelivery retrieves a delivery by ID.
+func (r *Repository) GetWebhookDelivery(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	var delivery models.WebhookDelivery
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&delivery).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to query webhook delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+// ReplayWebhookDelivery moves a DELIVERED or FAILED delivery back to PENDING and counts
+// the replay. The caller starts the delivery workflow for the new replay count.
+func (r *Repository) ReplayWebhookDelivery(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	var delivery models.WebhookDelivery
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&delivery).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrWebhookDeliveryNotFound
+			}
+			return fmt.Errorf("failed to query webhook delivery: %w", err)
+		}
+		if delivery.Status == "PENDING" {
+			return ErrWebhookDeliveryPending
+		}
+		var ep models.WebhookEndpoint
+		if err := tx.Where("id = ?", delivery.EndpointID).First(&ep).Error; err != nil {
+			return fmt.Errorf("failed to query webhook endpoint: %w", err)
+		}
+		if ep.Status != "ACTIVE" {
+			return ErrWebhookEndpointDisabled
+		}
+
+		delivery.Status = "PENDING"
+		delivery.Replays++
+		delivery.LastError = ""
+		delivery.DeliveredAt = nil
+		return tx.Model(&delivery).Updates(map[string]interface{}{
+			"status":       delivery.Status,
+			"replays":      delivery.Replays,
+			"last_error":   delivery.LastError,
+			"delivered_at": nil,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}